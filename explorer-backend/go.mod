@@ -220,3 +220,6 @@ require (
 // Needed for cosmos-sdk based chains.  See
 // https://github.com/cosmos/cosmos-sdk/issues/10925 for more details.
 replace github.com/gogo/protobuf => github.com/regen-network/protobuf v1.3.3-alpha.regen.1
+
+// The explorer is built against the node module of the same tree.
+replace github.com/alephium/wormhole-fork/node => ../node
@@ -78,6 +78,7 @@ var (
 	nodeKeyPath              *string
 	guardianGrpcUrl          *string
 	ethRpcUrl                *string
+	evmRpcUrls               *map[string]string
 	network                  *string
 	logLevel                 *string
 	fetchMissingVaasInterval *uint
@@ -103,6 +104,7 @@ func init() {
 	nodeKeyPath = rootCmd.Flags().String("nodeKey", "", "Path to node key (will be generated if it doesn't exist)")
 	guardianGrpcUrl = rootCmd.Flags().String("guardianGrpcUrl", "127.0.0.1:7070", "Guardian grpc url")
 	ethRpcUrl = rootCmd.Flags().String("ethRpcUrl", "http://127.0.0.1:8545", "ETH rpc url")
+	evmRpcUrls = rootCmd.Flags().StringToString("evmRpcUrl", map[string]string{}, "EVM chain rpc urls by network name, e.g. eth=http://127.0.0.1:8545 (overrides --ethRpcUrl and --bscRpcUrl)")
 	network = rootCmd.Flags().String("network", "devnet", "Network type(devnet, testnet, mainnet)")
 	logLevel = rootCmd.Flags().String("logLevel", "debug", "Log level")
 	fetchMissingVaasInterval = rootCmd.Flags().Uint("fetchMissingVaasInterval", 300, "Fetch missing vaas interval")
@@ -195,8 +197,14 @@ func run(cmd *cobra.Command, args []string) {
 	// Heartbeat updates
 	heartbeatC := make(chan *gossipv1.Heartbeat, 50)
 
-	ethGovernanceAddress := eth_common.HexToAddress(bridgeConfig.Ethereum.CoreEmitterAddress)
-	guardianSetList, err := guardiansets.GetGuardianSetsFromChain(rootCtx, *ethRpcUrl, ethGovernanceAddress, 0)
+	// The guardian sets are the same on every chain, they are read from the first EVM chain of the registry.
+	if len(bridgeConfig.EvmChains) == 0 {
+		logger.Fatal("no EVM chain in the bridge config")
+	}
+	guardianSetChain := bridgeConfig.EvmChains[0]
+	guardianSetRpcUrl := evmRpcUrl(logger, guardianSetChain)
+	governanceAddress := eth_common.HexToAddress(guardianSetChain.CoreEmitterAddress)
+	guardianSetList, err := guardiansets.GetGuardianSetsFromChain(rootCtx, guardianSetRpcUrl, governanceAddress, 0)
 	if err != nil {
 		logger.Fatal("failed to get guardian sets from chain", zap.Error(err))
 	}
@@ -204,10 +212,10 @@ func run(cmd *cobra.Command, args []string) {
 	guardianSetC := make(chan *common.GuardianSet, 1)
 	guardianSets := guardiansets.NewGuardianSets(
 		guardianSetList,
-		*ethRpcUrl,
+		guardianSetRpcUrl,
 		logger,
 		time.Duration(*fetchGuardianSetInterval)*time.Second,
-		ethGovernanceAddress,
+		governanceAddress,
 		guardianSetC,
 	)
 	guardianSets.UpdateGuardianSet(rootCtx) // Update guardian set periodically
@@ -346,38 +354,23 @@ func run(cmd *cobra.Command, args []string) {
 			return err
 		}
 
-		ethWatcher, err := transactions.NewEVMWatcher(
-			logger,
-			ctx,
-			*ethRpcUrl,
-			vaa.ChainIDEthereum,
-			bridgeConfig.Ethereum,
-			watcher.GetLatestEventIndexEth,
-			blockTxsC,
-		)
-		if err != nil {
-			logger.Error("failed to create eth watcher", zap.Error(err))
-			return err
-		}
-		if err := supervisor.Run(ctx, "eth-watcher", ethWatcher.Run()); err != nil {
-			return err
-		}
-
-		bscWatcher, err := transactions.NewEVMWatcher(
-			logger,
-			ctx,
-			*bscRpcUrl,
-			vaa.ChainIDBSC,
-			bridgeConfig.Bsc,
-			watcher.GetLatestEventIndexBsc,
-			blockTxsC,
-		)
-		if err != nil {
-			logger.Error("failed to create bsc watcher", zap.Error(err))
-			return err
-		}
-		if err := supervisor.Run(ctx, "bsc-watcher", bscWatcher.Run()); err != nil {
-			return err
+		for _, chain := range bridgeConfig.EvmChains {
+			evmWatcher, err := transactions.NewEVMWatcher(
+				logger,
+				ctx,
+				evmRpcUrl(logger, chain),
+				chain.WormholeChainId(),
+				chain.ChainConfig,
+				watcher.GetLatestEventIndexEvmFunc(chain.WormholeChainId()),
+				blockTxsC,
+			)
+			if err != nil {
+				logger.Error("failed to create evm watcher", zap.String("network", chain.NetworkName), zap.Error(err))
+				return err
+			}
+			if err := supervisor.Run(ctx, fmt.Sprintf("%s-watcher", chain.NetworkName), evmWatcher.Run()); err != nil {
+				return err
+			}
 		}
 
 		if err := supervisor.Run(ctx, "watcher", watcher.Run()); err != nil {
@@ -397,6 +390,24 @@ func run(cmd *cobra.Command, args []string) {
 	server.Stop()
 }
 
+// evmRpcUrl returns the rpc url of an EVM chain of the registry, from --evmRpcUrl, the legacy --ethRpcUrl and
+// --bscRpcUrl flags, or the guardian config.
+func evmRpcUrl(logger *zap.Logger, chain *common.EvmChainConfig) string {
+	if url, ok := (*evmRpcUrls)[chain.NetworkName]; ok {
+		return url
+	}
+	switch chain.WormholeChainId() {
+	case vaa.ChainIDEthereum:
+		return *ethRpcUrl
+	case vaa.ChainIDBSC:
+		return *bscRpcUrl
+	}
+	if chain.Rpc == "" {
+		logger.Fatal("Please specify the rpc url with --evmRpcUrl", zap.String("network", chain.NetworkName))
+	}
+	return chain.Rpc
+}
+
 func verifyObservation(logger *zap.Logger, obs *gossipv1.SignedObservation, gs *common.GuardianSet) bool {
 	pk, err := crypto2.Ecrecover(obs.GetHash(), obs.GetSignature())
 	if err != nil {
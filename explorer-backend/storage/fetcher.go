@@ -20,10 +20,9 @@ type BridgeChain struct {
 func loadEmitterIds(config *common.BridgeConfig) ([]*emitterId, error) {
 	chains := []BridgeChain{
 		{vaa.ChainIDAlephium, config.Alephium},
-		{vaa.ChainIDEthereum, config.Ethereum},
 	}
-	if config.Bsc != nil {
-		chains = append(chains, BridgeChain{vaa.ChainIDBSC, config.Bsc})
+	for _, chain := range config.EvmChains {
+		chains = append(chains, BridgeChain{chain.WormholeChainId(), chain.ChainConfig})
 	}
 	emitterIds := make([]*emitterId, 0)
 	for i := 0; i < len(chains); i++ {
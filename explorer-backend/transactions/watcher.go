@@ -31,12 +31,23 @@ func (w *Watcher) GetLatestEventIndexAlephium(ctx context.Context) (*uint32, err
 	return w.GetLatestEventIndex(ctx, vaa.ChainIDAlephium)
 }
 
-func (w *Watcher) GetLatestEventIndexEth(ctx context.Context) (*uint32, error) {
-	return w.GetLatestEventIndexEvm(ctx, vaa.ChainIDEthereum, 64)
+// evmConfirmations is the number of blocks an EVM watcher re-scans on startup, by chain.
+var evmConfirmations = map[vaa.ChainID]uint32{
+	vaa.ChainIDEthereum: 64,
+	vaa.ChainIDBSC:      15,
 }
 
-func (w *Watcher) GetLatestEventIndexBsc(ctx context.Context) (*uint32, error) {
-	return w.GetLatestEventIndexEvm(ctx, vaa.ChainIDBSC, 15)
+const defaultEvmConfirmations = 64
+
+// GetLatestEventIndexEvmFunc returns the function loading the block height the watcher of an EVM chain resumes from.
+func (w *Watcher) GetLatestEventIndexEvmFunc(chainId vaa.ChainID) func(context.Context) (*uint32, error) {
+	confirmations, ok := evmConfirmations[chainId]
+	if !ok {
+		confirmations = defaultEvmConfirmations
+	}
+	return func(ctx context.Context) (*uint32, error) {
+		return w.GetLatestEventIndexEvm(ctx, chainId, confirmations)
+	}
 }
 
 func (w *Watcher) GetLatestEventIndexEvm(ctx context.Context, chainId vaa.ChainID, confirmations uint32) (*uint32, error) {
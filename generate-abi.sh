@@ -10,13 +10,15 @@ set -euo pipefail
 function gen() {
   local name=$1
   local pkg=$2
+  local dir=${3:-$pkg}
 
   kubectl exec -c tests eth-devnet-0 -- npx truffle@5.4.1 run abigen $name
 
   kubectl exec -c tests eth-devnet-0 -- cat abigenBindings/abi/${name}.abi | \
     docker run --rm -i localhost/certusone/wormhole-abigen:latest /bin/abigen --abi - --pkg ${pkg} > \
-    node/pkg/ethereum/${pkg}/abi.go
+    node/pkg/ethereum/${dir}/abi.go
 }
 
 gen Wormhole abi
 gen ERC20 erc20
+gen BridgeGetters tokenbridge abi/tokenbridge
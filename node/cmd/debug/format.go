@@ -0,0 +1,341 @@
+package debug
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/alephium/wormhole-fork/node/pkg/alephium"
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/mr-tron/base58"
+)
+
+// Kinds of emitters, as detected from the bridge config.
+const (
+	emitterGovernance  = "governance"
+	emitterTokenBridge = "token bridge"
+	emitterUnknown     = "unknown"
+)
+
+// field is a named value of a decoded payload. Values are either a string or a list of strings.
+type field struct {
+	Name  string
+	Value interface{}
+}
+
+// fields is a list of fields which keeps its order when encoded as a JSON object.
+type fields []field
+
+func (fs fields) MarshalJSON() ([]byte, error) {
+	buf := new(bytes.Buffer)
+	buf.WriteByte('{')
+	for i, f := range fs {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		name, err := json.Marshal(f.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(f.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// decodedPayload is the human-readable representation of a governance or token bridge payload.
+type decodedPayload struct {
+	Type   string `json:"type"`
+	Fields fields `json:"fields"`
+}
+
+// classifyEmitter returns the kind of the emitter according to the bridge config, or emitterUnknown if the
+// config is nil or the emitter is not a governance or token bridge emitter of the network.
+func classifyEmitter(config *common.BridgeConfig, chainId vaa.ChainID, address vaa.Address) string {
+	if config == nil {
+		return emitterUnknown
+	}
+	if config.Guardian != nil && vaa.ChainID(config.Guardian.GovernanceChainId) == chainId &&
+		sameAddress(config.Guardian.GovernanceEmitterAddress, address) {
+		return emitterGovernance
+	}
+	chains := make([]*common.ChainConfig, 0, len(config.EvmChains)+1)
+	if config.Alephium != nil {
+		chains = append(chains, config.Alephium)
+	}
+	for _, chain := range config.EvmChains {
+		chains = append(chains, chain.ChainConfig)
+	}
+	for _, chain := range chains {
+		if vaa.ChainID(chain.ChainId) == chainId && sameAddress(chain.TokenBridgeEmitterAddress, address) {
+			return emitterTokenBridge
+		}
+	}
+	return emitterUnknown
+}
+
+func sameAddress(configured string, address vaa.Address) bool {
+	expected, err := vaa.StringToAddress(strings.TrimPrefix(configured, "0x"))
+	return err == nil && expected == address
+}
+
+// decodePayload decodes the payload of a VAA according to the kind of its emitter. Payloads of unknown emitters
+// are decoded on a best-effort basis and nil is returned if they can't be decoded.
+func decodePayload(v *vaa.VAA, emitter string, decimals tokenDecimals) (*decodedPayload, error) {
+	payload, err := vaa.DecodePayload(v.Payload)
+	if err != nil {
+		if emitter == emitterUnknown {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to decode %s payload: %w", emitter, err)
+	}
+
+	switch p := payload.(type) {
+	case *vaa.Transfer, *vaa.TransferWithPayload, *vaa.AssetMeta:
+		if emitter == emitterGovernance {
+			return nil, fmt.Errorf("unexpected token bridge message %T from the governance emitter", p)
+		}
+	default:
+		if emitter == emitterTokenBridge {
+			return nil, fmt.Errorf("unexpected governance message %T from a token bridge emitter", p)
+		}
+	}
+
+	switch p := payload.(type) {
+	case *vaa.Transfer:
+		token := tokenKey{p.OriginChain, p.OriginAddress}
+		return &decodedPayload{Type: "Transfer", Fields: fields{
+			{"amount", decimals.formatAmount(token, p.Amount)},
+			{"tokenChain", p.OriginChain.String()},
+			{"tokenAddress", formatAddress(p.OriginChain, p.OriginAddress)},
+			{"recipient", formatNativeAddress(v.TargetChain, p.TargetAddress)},
+			{"fee", decimals.formatAmount(token, p.Fee)},
+		}}, nil
+	case *vaa.TransferWithPayload:
+		return &decodedPayload{Type: "TransferWithPayload", Fields: fields{
+			{"amount", decimals.formatAmount(tokenKey{p.OriginChain, p.OriginAddress}, p.Amount)},
+			{"tokenChain", p.OriginChain.String()},
+			{"tokenAddress", formatAddress(p.OriginChain, p.OriginAddress)},
+			{"targetChain", p.TargetChain.String()},
+			{"recipient", formatAddress(p.TargetChain, p.TargetAddress)},
+			{"sender", formatAddress(v.EmitterChain, p.FromAddress)},
+			{"payload", hex.EncodeToString(p.Payload)},
+		}}, nil
+	case *vaa.AssetMeta:
+		return &decodedPayload{Type: "AssetMeta", Fields: fields{
+			{"tokenChain", p.TokenChain.String()},
+			{"tokenAddress", formatAddress(p.TokenChain, p.TokenAddress)},
+			{"decimals", fmt.Sprint(p.Decimals)},
+			{"symbol", p.Symbol},
+			{"name", p.Name},
+		}}, nil
+	case *vaa.BodyContractUpgrade:
+		return &decodedPayload{Type: "ContractUpgrade", Fields: fields{
+			upgradeField(v.TargetChain, p.Payload),
+		}}, nil
+	case *vaa.BodyGuardianSetUpgrade:
+		keys := make([]string, len(p.Keys))
+		for i, key := range p.Keys {
+			keys[i] = key.Hex()
+		}
+		return &decodedPayload{Type: "GuardianSetUpgrade", Fields: fields{
+			{"newIndex", fmt.Sprint(p.NewIndex)},
+			{"keys", keys},
+		}}, nil
+	case *vaa.BodyUpdateMessageFee:
+		return &decodedPayload{Type: "UpdateMessageFee", Fields: fields{
+			{"newMessageFee", new(big.Int).SetBytes(p.NewMessageFee).String()},
+		}}, nil
+	case *vaa.BodyTransferFee:
+		return &decodedPayload{Type: "TransferFee", Fields: fields{
+			{"amount", new(big.Int).SetBytes(p.Amount).String()},
+			{"recipient", formatNativeAddress(v.TargetChain, p.Recipient)},
+		}}, nil
+	case *vaa.BodyTokenBridgeRegisterChain:
+		return &decodedPayload{Type: "RegisterChain", Fields: fields{
+			{"module", p.Module},
+			{"chain", p.ChainID.String()},
+			{"emitterAddress", formatAddress(p.ChainID, p.EmitterAddress)},
+		}}, nil
+	case *vaa.BodyTokenBridgeUpgradeContract:
+		return &decodedPayload{Type: "TokenBridgeUpgradeContract", Fields: fields{
+			{"module", p.Module},
+			upgradeField(v.TargetChain, p.Payload),
+		}}, nil
+	case *vaa.BodyTokenBridgeDestroyContracts:
+		sequences := make([]string, len(p.Sequences))
+		for i, seq := range p.Sequences {
+			sequences[i] = fmt.Sprint(seq)
+		}
+		return &decodedPayload{Type: "DestroyUnexecutedSequenceContracts", Fields: fields{
+			{"emitterChain", p.EmitterChain.String()},
+			{"sequences", sequences},
+		}}, nil
+	case *vaa.BodyTokenBridgeUpdateMinimalConsistencyLevel:
+		return &decodedPayload{Type: "UpdateMinimalConsistencyLevel", Fields: fields{
+			{"newConsistencyLevel", fmt.Sprint(p.NewConsistencyLevel)},
+		}}, nil
+	case *vaa.BodyTokenBridgeUpdateRefundAddress:
+		return &decodedPayload{Type: "UpdateRefundAddress", Fields: fields{
+			{"newRefundAddress", formatNativeAddress(v.TargetChain, p.NewRefundAddress)},
+		}}, nil
+	}
+	return nil, fmt.Errorf("unsupported payload %T", payload)
+}
+
+// upgradeField returns the new implementation address of an EVM contract upgrade, other chains upgrade the
+// contract code and the payload is returned as hex.
+func upgradeField(chainId vaa.ChainID, payload []byte) field {
+	if isEvmChain(chainId) && len(payload) == 32 && bytes.Equal(payload[:12], make([]byte, 12)) {
+		return field{"newContract", ethCommon.BytesToAddress(payload[12:]).Hex()}
+	}
+	return field{"payload", hex.EncodeToString(payload)}
+}
+
+// formatAmount formats a token bridge amount with the given number of decimals, followed by the raw amount.
+func formatAmount(amount *big.Int, decimals uint8) string {
+	s := amount.String()
+	if decimals == 0 {
+		return s
+	}
+	if len(s) <= int(decimals) {
+		s = strings.Repeat("0", int(decimals)-len(s)+1) + s
+	}
+	integer, fraction := s[:len(s)-int(decimals)], strings.TrimRight(s[len(s)-int(decimals):], "0")
+	if fraction == "" {
+		return fmt.Sprintf("%s (%s)", integer, amount)
+	}
+	return fmt.Sprintf("%s.%s (%s)", integer, fraction, amount)
+}
+
+// maxNormalizedDecimals is the maximum number of decimals of token bridge amounts, which are normalized.
+const maxNormalizedDecimals = 8
+
+// tokenKey identifies a token by its origin chain and address.
+type tokenKey struct {
+	chain   vaa.ChainID
+	address vaa.Address
+}
+
+// tokenDecimals holds the decimals of the normalized amounts of each token.
+type tokenDecimals map[tokenKey]uint8
+
+// parseTokenDecimals parses overrides of the form chain:address=decimals, where chain is a chain name or ID and
+// address the hex-encoded token address on its origin chain.
+func parseTokenDecimals(overrides []string) (tokenDecimals, error) {
+	decimals := tokenDecimals{}
+	for _, override := range overrides {
+		token, value, ok := strings.Cut(override, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid token decimals %s, expected chain:address=decimals", override)
+		}
+		chain, address, ok := strings.Cut(token, ":")
+		if !ok {
+			return nil, fmt.Errorf("invalid token decimals %s, expected chain:address=decimals", override)
+		}
+		chainId, err := vaa.ChainIDFromString(chain)
+		if err != nil {
+			id, err := strconv.ParseUint(chain, 10, 16)
+			if err != nil {
+				return nil, fmt.Errorf("invalid chain %s", chain)
+			}
+			chainId = vaa.ChainID(id)
+		}
+		tokenAddress, err := vaa.StringToAddress(address)
+		if err != nil {
+			return nil, fmt.Errorf("invalid token address %s: %w", address, err)
+		}
+		d, err := strconv.ParseUint(value, 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid decimals %s: %w", value, err)
+		}
+		decimals.set(tokenKey{chainId, tokenAddress}, uint8(d))
+	}
+	return decimals, nil
+}
+
+// set records the decimals of a token, amounts of tokens with more than maxNormalizedDecimals are truncated.
+func (td tokenDecimals) set(token tokenKey, decimals uint8) {
+	if decimals > maxNormalizedDecimals {
+		decimals = maxNormalizedDecimals
+	}
+	td[token] = decimals
+}
+
+// addAssetMeta records the decimals of the token attested by an AssetMeta VAA, unless they are already known.
+func (td tokenDecimals) addAssetMeta(v *vaa.VAA, emitter string) {
+	if emitter == emitterGovernance {
+		return
+	}
+	payload, err := vaa.DecodePayload(v.Payload)
+	if err != nil {
+		return
+	}
+	if meta, ok := payload.(*vaa.AssetMeta); ok {
+		token := tokenKey{meta.TokenChain, meta.TokenAddress}
+		if _, ok := td[token]; !ok {
+			td.set(token, meta.Decimals)
+		}
+	}
+}
+
+// formatAmount formats an amount of the token with its decimals, or as the raw amount if they are unknown.
+func (td tokenDecimals) formatAmount(token tokenKey, amount *big.Int) string {
+	decimals, ok := td[token]
+	if !ok {
+		return amount.String()
+	}
+	return formatAmount(amount, decimals)
+}
+
+func isEvmChain(chainId vaa.ChainID) bool {
+	switch chainId {
+	case vaa.ChainIDEthereum, vaa.ChainIDBSC, vaa.ChainIDPolygon, vaa.ChainIDAvalanche, vaa.ChainIDOasis,
+		vaa.ChainIDAurora, vaa.ChainIDFantom, vaa.ChainIDKarura, vaa.ChainIDAcala, vaa.ChainIDKlaytn,
+		vaa.ChainIDCelo, vaa.ChainIDMoonbeam, vaa.ChainIDNeon, vaa.ChainIDEthereumRopsten:
+		return true
+	}
+	return false
+}
+
+// formatAddress formats a 32 byte address in the native format of the chain: a base58 contract address for
+// Alephium and a checksummed hex address for EVM chains.
+func formatAddress(chainId vaa.ChainID, address vaa.Address) string {
+	switch {
+	case chainId == vaa.ChainIDAlephium:
+		contractAddress, err := alephium.ToContractAddress(address.String())
+		if err != nil {
+			return address.String()
+		}
+		return *contractAddress
+	case isEvmChain(chainId) && bytes.Equal(address[:12], make([]byte, 12)):
+		return ethCommon.BytesToAddress(address[12:]).Hex()
+	}
+	return address.String()
+}
+
+// formatNativeAddress formats a variable length address in the native format of the chain. Alephium addresses
+// are serialized lockup scripts, which are base58 encoded.
+func formatNativeAddress(chainId vaa.ChainID, address []byte) string {
+	switch {
+	case chainId == vaa.ChainIDAlephium && len(address) > 0:
+		return base58.Encode(address)
+	case isEvmChain(chainId) && len(address) == 20:
+		return ethCommon.BytesToAddress(address).Hex()
+	case isEvmChain(chainId) && len(address) == 32 && bytes.Equal(address[:12], make([]byte, 12)):
+		return ethCommon.BytesToAddress(address[12:]).Hex()
+	}
+	return hex.EncodeToString(address)
+}
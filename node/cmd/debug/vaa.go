@@ -1,32 +1,408 @@
 package debug
 
 import (
+	"bufio"
+	"context"
+	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
 	"log"
+	"os"
 	"strings"
+	"time"
 
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/processor"
+	publicrpcv1 "github.com/alephium/wormhole-fork/node/pkg/proto/publicrpc/v1"
 	"github.com/alephium/wormhole-fork/node/pkg/vaa"
-	"github.com/davecgh/go-spew/spew"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
+// Encodings of the VAAs passed to decode-vaa.
+const (
+	formatAuto   = "auto"
+	formatHex    = "hex"
+	formatBase64 = "base64"
+)
+
+var (
+	decodeNetwork          *string
+	decodeFormat           *string
+	decodeFiles            *[]string
+	decodeJSON             *bool
+	decodeTokenDecimals    *[]string
+	decodeGuardianSet      *[]string
+	decodeGuardianSetIndex *int64
+	decodePublicRPC        *string
+)
+
+func init() {
+	decodeNetwork = decodeVaaCmd.Flags().String("network", "", "Network (mainnet, testnet or devnet) used to detect governance and token bridge emitters")
+	decodeFormat = decodeVaaCmd.Flags().String("format", formatAuto, "Encoding of the VAAs (auto, hex or base64)")
+	decodeFiles = decodeVaaCmd.Flags().StringSlice("file", nil, "Files containing whitespace-separated VAAs")
+	decodeJSON = decodeVaaCmd.Flags().Bool("json", false, "Print one JSON object per VAA")
+	decodeTokenDecimals = decodeVaaCmd.Flags().StringSlice("tokenDecimals", nil, "Decimals of tokens (comma-separated chain:address=decimals) used to display transfer amounts, in addition to the ones of the AssetMeta VAAs decoded")
+	decodeGuardianSet = decodeVaaCmd.Flags().StringSlice("guardianSet", nil, "Guardian addresses (comma-separated) used to verify the signatures")
+	decodeGuardianSetIndex = decodeVaaCmd.Flags().Int64("guardianSetIndex", -1, "Index of the guardian set passed with --guardianSet, not checked if negative")
+	decodePublicRPC = decodeVaaCmd.Flags().String("publicRPC", "", "Guardian public gRPC address to fetch the current guardian set from")
+}
+
 var decodeVaaCmd = &cobra.Command{
-	Use:   "decode-vaa [DATA]",
-	Short: "Decode a hex-encoded VAA",
-	Run: func(cmd *cobra.Command, args []string) {
-		for _, arg := range args {
-			arg = strings.TrimPrefix(arg, "0x")
-			b, err := hex.DecodeString(arg)
-			if err != nil {
-				log.Fatal(err)
+	Use:   "decode-vaa [DATA|-]...",
+	Short: "Decode hex or base64-encoded VAAs",
+	Long: "Decode hex or base64-encoded VAAs passed as arguments, in files or on stdin (with - or if no VAA is " +
+		"passed). Payloads of governance and token bridge emitters of the network are decoded, and signatures are " +
+		"verified if a guardian set is passed or fetched from a guardian.",
+	Run: runDecodeVaa,
+}
+
+// signatureInfo is a signature of a decoded VAA, with its signer if it can be recovered.
+type signatureInfo struct {
+	Index    uint8  `json:"index"`
+	Signer   string `json:"signer,omitempty"`
+	Guardian string `json:"guardian,omitempty"`
+	Valid    *bool  `json:"valid,omitempty"`
+}
+
+// verification is the result of the verification of a VAA against a guardian set.
+type verification struct {
+	GuardianSetIndex uint32 `json:"guardianSetIndex"`
+	Quorum           int    `json:"quorum"`
+	Valid            bool   `json:"valid"`
+	Error            string `json:"error,omitempty"`
+}
+
+// decodedVAA is the human-readable representation of a VAA.
+type decodedVAA struct {
+	Digest           string          `json:"digest"`
+	ID               string          `json:"id"`
+	Version          uint8           `json:"version"`
+	GuardianSetIndex uint32          `json:"guardianSetIndex"`
+	Timestamp        time.Time       `json:"timestamp"`
+	Nonce            uint32          `json:"nonce"`
+	Sequence         uint64          `json:"sequence"`
+	ConsistencyLevel uint8           `json:"consistencyLevel"`
+	EmitterChain     string          `json:"emitterChain"`
+	EmitterAddress   string          `json:"emitterAddress"`
+	TargetChain      string          `json:"targetChain"`
+	Emitter          string          `json:"emitter"`
+	Signatures       []signatureInfo `json:"signatures"`
+	Verification     *verification   `json:"verification,omitempty"`
+	Payload          *decodedPayload `json:"payload,omitempty"`
+	PayloadError     string          `json:"payloadError,omitempty"`
+	RawPayload       string          `json:"rawPayload"`
+}
+
+func runDecodeVaa(cmd *cobra.Command, args []string) {
+	var config *common.BridgeConfig
+	if *decodeNetwork != "" {
+		var err error
+		if config, err = common.ReadConfigsByNetwork(*decodeNetwork); err != nil {
+			log.Fatalf("failed to read configs: %v", err)
+		}
+	}
+
+	gs, err := guardianSetFromFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	inputs, err := readInputs(args, *decodeFiles, os.Stdin)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	decimals, err := parseTokenDecimals(*decodeTokenDecimals)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	failed := false
+	vaas := make([]*vaa.VAA, 0, len(inputs))
+	for _, input := range inputs {
+		v, err := decodeInput(input, *decodeFormat)
+		if err != nil {
+			log.Printf("failed to decode %s: %v", input, err)
+			failed = true
+			continue
+		}
+		vaas = append(vaas, v)
+	}
+
+	// Amounts are normalized according to the decimals of their token, which are attested by AssetMeta VAAs.
+	for _, v := range vaas {
+		decimals.addAssetMeta(v, classifyEmitter(config, v.EmitterChain, v.EmitterAddress))
+	}
+
+	for _, v := range vaas {
+		d := decodeVAA(v, config, decimals)
+		if gs != nil {
+			d.verify(v, gs, *decodeGuardianSetIndex >= 0 || *decodePublicRPC != "")
+			if !d.Verification.Valid {
+				failed = true
 			}
+		}
+		if *decodeJSON {
+			if err := json.NewEncoder(os.Stdout).Encode(d); err != nil {
+				log.Fatalf("failed to encode VAA: %v", err)
+			}
+		} else {
+			d.print(os.Stdout)
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
 
-			v, err := vaa.Unmarshal(b)
-			if err != nil {
-				log.Fatal(err)
+// guardianSetFromFlags returns the guardian set passed with --guardianSet or fetched from --publicRPC, or nil
+// if signatures should not be verified.
+func guardianSetFromFlags() (*common.GuardianSet, error) {
+	if len(*decodeGuardianSet) != 0 && *decodePublicRPC != "" {
+		return nil, fmt.Errorf("--guardianSet and --publicRPC are mutually exclusive")
+	}
+
+	if len(*decodeGuardianSet) != 0 {
+		gs := &common.GuardianSet{Keys: make([]ethCommon.Address, len(*decodeGuardianSet))}
+		for i, addr := range *decodeGuardianSet {
+			if !ethCommon.IsHexAddress(addr) {
+				return nil, fmt.Errorf("invalid guardian address %s", addr)
+			}
+			gs.Keys[i] = ethCommon.HexToAddress(addr)
+		}
+		if *decodeGuardianSetIndex >= 0 {
+			gs.Index = uint32(*decodeGuardianSetIndex)
+		}
+		return gs, nil
+	}
+
+	if *decodePublicRPC != "" {
+		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		defer cancel()
+		conn, err := grpc.DialContext(ctx, *decodePublicRPC, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to %s: %w", *decodePublicRPC, err)
+		}
+		defer conn.Close()
+		resp, err := publicrpcv1.NewPublicRPCServiceClient(conn).GetCurrentGuardianSet(ctx, &publicrpcv1.GetCurrentGuardianSetRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the current guardian set: %w", err)
+		}
+		gs := &common.GuardianSet{Keys: make([]ethCommon.Address, len(resp.GuardianSet.Addresses)), Index: resp.GuardianSet.Index}
+		for i, addr := range resp.GuardianSet.Addresses {
+			if !ethCommon.IsHexAddress(addr) {
+				return nil, fmt.Errorf("invalid guardian address %s", addr)
 			}
+			gs.Keys[i] = ethCommon.HexToAddress(addr)
+		}
+		return gs, nil
+	}
 
-			spew.Dump(v)
+	return nil, nil
+}
+
+// readInputs returns the whitespace-separated VAAs of the arguments and files. stdin is read if an argument is
+// "-", or if there are no arguments and files.
+func readInputs(args []string, files []string, stdin io.Reader) ([]string, error) {
+	var inputs []string
+	readAll := func(r io.Reader) error {
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+		scanner.Split(bufio.ScanWords)
+		for scanner.Scan() {
+			inputs = append(inputs, scanner.Text())
+		}
+		return scanner.Err()
+	}
+
+	if len(args) == 0 && len(files) == 0 {
+		args = []string{"-"}
+	}
+	for _, arg := range args {
+		if arg != "-" {
+			inputs = append(inputs, arg)
+			continue
+		}
+		if err := readAll(stdin); err != nil {
+			return nil, fmt.Errorf("failed to read stdin: %w", err)
+		}
+	}
+	for _, path := range files {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		err = readAll(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+	}
+	return inputs, nil
+}
+
+// decodeInput decodes a hex or base64-encoded VAA. With formatAuto, the input is decoded with each encoding it is
+// valid in, and it must be a VAA in exactly one of them.
+func decodeInput(input string, format string) (*vaa.VAA, error) {
+	decoders := map[string]func(string) ([]byte, error){
+		formatHex: func(input string) ([]byte, error) {
+			return hex.DecodeString(strings.TrimPrefix(input, "0x"))
+		},
+		formatBase64: base64.StdEncoding.DecodeString,
+	}
+
+	if format != formatAuto {
+		decode, ok := decoders[format]
+		if !ok {
+			return nil, fmt.Errorf("invalid format %s", format)
+		}
+		b, err := decode(input)
+		if err != nil {
+			return nil, err
+		}
+		return vaa.Unmarshal(b)
+	}
+
+	var decoded *vaa.VAA
+	for _, f := range []string{formatHex, formatBase64} {
+		b, err := decoders[f](input)
+		if err != nil {
+			continue
+		}
+		v, err := vaa.Unmarshal(b)
+		if err != nil {
+			continue
+		}
+		if decoded != nil {
+			return nil, fmt.Errorf("ambiguous encoding, use --format")
+		}
+		decoded = v
+	}
+	if decoded == nil {
+		return nil, fmt.Errorf("neither a hex nor a base64-encoded VAA")
+	}
+	return decoded, nil
+}
+
+func decodeVAA(v *vaa.VAA, config *common.BridgeConfig, decimals tokenDecimals) *decodedVAA {
+	d := &decodedVAA{
+		Digest:           v.HexDigest(),
+		ID:               v.MessageID(),
+		Version:          v.Version,
+		GuardianSetIndex: v.GuardianSetIndex,
+		Timestamp:        v.Timestamp.UTC(),
+		Nonce:            v.Nonce,
+		Sequence:         v.Sequence,
+		ConsistencyLevel: v.ConsistencyLevel,
+		EmitterChain:     v.EmitterChain.String(),
+		EmitterAddress:   formatAddress(v.EmitterChain, v.EmitterAddress),
+		TargetChain:      v.TargetChain.String(),
+		Emitter:          classifyEmitter(config, v.EmitterChain, v.EmitterAddress),
+		Signatures:       make([]signatureInfo, len(v.Signatures)),
+		RawPayload:       hex.EncodeToString(v.Payload),
+	}
+
+	digest := v.SigningMsg()
+	for i, sig := range v.Signatures {
+		d.Signatures[i].Index = sig.Index
+		if pubKey, err := crypto.Ecrecover(digest.Bytes(), sig.Signature[:]); err == nil {
+			d.Signatures[i].Signer = ethCommon.BytesToAddress(crypto.Keccak256(pubKey[1:])[12:]).Hex()
+		}
+	}
+
+	payload, err := decodePayload(v, d.Emitter, decimals)
+	if err != nil {
+		d.PayloadError = err.Error()
+	}
+	d.Payload = payload
+	return d
+}
+
+// verify checks the signatures of the VAA against the guardian set. The guardian set index is only checked
+// if checkIndex is true.
+func (d *decodedVAA) verify(v *vaa.VAA, gs *common.GuardianSet, checkIndex bool) {
+	d.Verification = &verification{
+		GuardianSetIndex: gs.Index,
+		Quorum:           processor.CalculateQuorum(len(gs.Keys)),
+	}
+	if !checkIndex {
+		d.Verification.GuardianSetIndex = v.GuardianSetIndex
+	}
+	for i := range d.Signatures {
+		sig := &d.Signatures[i]
+		valid := false
+		if int(sig.Index) < len(gs.Keys) {
+			sig.Guardian = gs.Keys[sig.Index].Hex()
+			valid = sig.Signer == sig.Guardian
+		}
+		sig.Valid = &valid
+	}
+
+	switch {
+	case checkIndex && v.GuardianSetIndex != gs.Index:
+		d.Verification.Error = fmt.Sprintf("VAA guardian set index %d does not match the guardian set index %d", v.GuardianSetIndex, gs.Index)
+	case len(v.Signatures) < d.Verification.Quorum:
+		d.Verification.Error = fmt.Sprintf("VAA has %d signatures, wanted %d", len(v.Signatures), d.Verification.Quorum)
+	case !v.VerifySignatures(gs.Keys):
+		d.Verification.Error = "invalid VAA signatures"
+	default:
+		d.Verification.Valid = true
+	}
+}
+
+func (d *decodedVAA) print(w io.Writer) {
+	fmt.Fprintf(w, "VAA %s\n", d.ID)
+	fmt.Fprintf(w, "  digest:            %s\n", d.Digest)
+	fmt.Fprintf(w, "  version:           %d\n", d.Version)
+	fmt.Fprintf(w, "  guardian set:      %d\n", d.GuardianSetIndex)
+	fmt.Fprintf(w, "  timestamp:         %s\n", d.Timestamp.Format(time.RFC3339))
+	fmt.Fprintf(w, "  nonce:             %d\n", d.Nonce)
+	fmt.Fprintf(w, "  sequence:          %d\n", d.Sequence)
+	fmt.Fprintf(w, "  consistency level: %d\n", d.ConsistencyLevel)
+	fmt.Fprintf(w, "  emitter chain:     %s\n", d.EmitterChain)
+	fmt.Fprintf(w, "  emitter address:   %s (%s)\n", d.EmitterAddress, d.Emitter)
+	fmt.Fprintf(w, "  target chain:      %s\n", d.TargetChain)
+
+	fmt.Fprintf(w, "  signatures:        %d\n", len(d.Signatures))
+	for _, sig := range d.Signatures {
+		status := ""
+		if sig.Valid != nil && *sig.Valid {
+			status = " valid"
+		} else if sig.Valid != nil {
+			status = fmt.Sprintf(" INVALID, expected %s", sig.Guardian)
+		}
+		fmt.Fprintf(w, "    %3d: %s%s\n", sig.Index, sig.Signer, status)
+	}
+	if d.Verification != nil {
+		if d.Verification.Valid {
+			fmt.Fprintf(w, "  verification:      valid (quorum %d)\n", d.Verification.Quorum)
+		} else {
+			fmt.Fprintf(w, "  verification:      INVALID: %s\n", d.Verification.Error)
+		}
+	}
+
+	if d.Payload != nil {
+		fmt.Fprintf(w, "  payload:           %s\n", d.Payload.Type)
+		for _, f := range d.Payload.Fields {
+			switch value := f.Value.(type) {
+			case []string:
+				fmt.Fprintf(w, "    %s:\n", f.Name)
+				for _, v := range value {
+					fmt.Fprintf(w, "      %s\n", v)
+				}
+			default:
+				fmt.Fprintf(w, "    %s: %v\n", f.Name, value)
+			}
 		}
-	},
+	}
+	if d.PayloadError != "" {
+		fmt.Fprintf(w, "  payload error:     %s\n", d.PayloadError)
+	}
+	fmt.Fprintf(w, "  raw payload:       %s\n\n", d.RawPayload)
 }
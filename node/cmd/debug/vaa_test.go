@@ -0,0 +1,194 @@
+package debug
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeInput(t *testing.T) {
+	v := &vaa.VAA{
+		Version:        1,
+		Timestamp:      time.Unix(1000, 0),
+		EmitterChain:   vaa.ChainIDEthereum,
+		TargetChain:    vaa.ChainIDAlephium,
+		EmitterAddress: vaa.Address{31: 2},
+		Payload:        []byte{1, 2, 3},
+	}
+	data, err := v.Marshal()
+	require.NoError(t, err)
+
+	for _, format := range []string{formatAuto, formatHex} {
+		decoded, err := decodeInput("0x"+hex.EncodeToString(data), format)
+		require.NoError(t, err)
+		assert.Equal(t, v.SigningMsg(), decoded.SigningMsg())
+	}
+	for _, format := range []string{formatAuto, formatBase64} {
+		decoded, err := decodeInput(base64.StdEncoding.EncodeToString(data), format)
+		require.NoError(t, err)
+		assert.Equal(t, v.SigningMsg(), decoded.SigningMsg())
+	}
+
+	// Inputs are rejected unless they are a VAA in one of the encodings
+	_, err = decodeInput("0102", formatAuto)
+	assert.Error(t, err)
+	_, err = decodeInput("not a vaa!", formatAuto)
+	assert.Error(t, err)
+	_, err = decodeInput(base64.StdEncoding.EncodeToString(data), formatHex)
+	assert.Error(t, err)
+	_, err = decodeInput("010203", "binary")
+	assert.Error(t, err)
+}
+
+func TestReadInputs(t *testing.T) {
+	inputs, err := readInputs([]string{"aa", "-", "bb"}, nil, strings.NewReader("cc\ndd ee\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"aa", "cc", "dd", "ee", "bb"}, inputs)
+
+	inputs, err = readInputs(nil, nil, strings.NewReader("ff\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ff"}, inputs)
+
+	_, err = readInputs(nil, []string{"/nonexistent/vaas"}, strings.NewReader(""))
+	assert.Error(t, err)
+}
+
+func TestFormatAmount(t *testing.T) {
+	assert.Equal(t, "1.5 (150000000)", formatAmount(big.NewInt(150000000), 8))
+	assert.Equal(t, "0.00000001 (1)", formatAmount(big.NewInt(1), 8))
+	assert.Equal(t, "2 (200000000)", formatAmount(big.NewInt(200000000), 8))
+	assert.Equal(t, "0 (0)", formatAmount(big.NewInt(0), 8))
+	assert.Equal(t, "42", formatAmount(big.NewInt(42), 0))
+}
+
+func TestTokenDecimals(t *testing.T) {
+	decimals, err := parseTokenDecimals([]string{"ethereum:0x01=6", "255:" + strings.Repeat("02", 32) + "=18"})
+	require.NoError(t, err)
+	usdc := tokenKey{vaa.ChainIDEthereum, vaa.Address{31: 1}}
+	other := tokenKey{vaa.ChainID(255), vaa.Address{}}
+	copy(other.address[:], bytes.Repeat([]byte{2}, 32))
+	assert.Equal(t, tokenDecimals{usdc: 6, other: 8}, decimals)
+
+	for _, override := range []string{"ethereum:0x01", "0x01=6", "unknown:0x01=6", "ethereum:zz=6", "ethereum:0x01=256"} {
+		_, err := parseTokenDecimals([]string{override})
+		assert.Error(t, err, override)
+	}
+
+	// AssetMeta VAAs don't override the configured decimals
+	meta := func(address vaa.Address, d uint8) *vaa.VAA {
+		payload := vaa.AssetMeta{TokenAddress: address, TokenChain: vaa.ChainIDEthereum, Decimals: d, Symbol: "T", Name: "Token"}
+		return &vaa.VAA{Payload: payload.Serialize()}
+	}
+	weth := tokenKey{vaa.ChainIDEthereum, vaa.Address{31: 3}}
+	decimals.addAssetMeta(meta(usdc.address, 18), emitterTokenBridge)
+	decimals.addAssetMeta(meta(weth.address, 18), emitterUnknown)
+	assert.Equal(t, uint8(6), decimals[usdc])
+	assert.Equal(t, uint8(8), decimals[weth])
+
+	assert.Equal(t, "1.5 (1500000)", decimals.formatAmount(usdc, big.NewInt(1500000)))
+	assert.Equal(t, "42", decimals.formatAmount(tokenKey{}, big.NewInt(42)))
+}
+
+func TestFormatAddress(t *testing.T) {
+	ethAddress := ethCommon.HexToAddress("0x5aAeb6053F3E94C9b9A09f33669435E7Ef1BeAed")
+	var address vaa.Address
+	copy(address[12:], ethAddress[:])
+	assert.Equal(t, ethAddress.Hex(), formatAddress(vaa.ChainIDEthereum, address))
+	assert.Equal(t, ethAddress.Hex(), formatNativeAddress(vaa.ChainIDBSC, ethAddress[:]))
+
+	// Contract addresses on Alephium are the base58 encoding of 0x03 followed by the contract id
+	assert.Equal(t, "tgx7VNFoP9DJiFMFgXXtafQZkUvyEdDHT9ryamHJYrjq", formatAddress(vaa.ChainIDAlephium, vaa.Address{}))
+	assert.Equal(t, strings.Repeat("1", 33), formatNativeAddress(vaa.ChainIDAlephium, make([]byte, 33)))
+
+	assert.Equal(t, address.String(), formatAddress(vaa.ChainIDSolana, address))
+}
+
+func TestClassifyEmitter(t *testing.T) {
+	config := &common.BridgeConfig{
+		Alephium: &common.ChainConfig{
+			ChainId:                   uint16(vaa.ChainIDAlephium),
+			TokenBridgeEmitterAddress: strings.Repeat("01", 32),
+		},
+		EvmChains: []*common.EvmChainConfig{{
+			ChainConfig: &common.ChainConfig{
+				ChainId:                   uint16(vaa.ChainIDEthereum),
+				TokenBridgeEmitterAddress: strings.Repeat("02", 32),
+			},
+		}},
+		Guardian: &common.GuardianConfig{
+			GovernanceChainId:        0,
+			GovernanceEmitterAddress: strings.Repeat("00", 31) + "04",
+		},
+	}
+
+	var alphTokenBridge vaa.Address
+	copy(alphTokenBridge[:], bytes.Repeat([]byte{1}, 32))
+
+	assert.Equal(t, emitterGovernance, classifyEmitter(config, vaa.ChainIDUnset, vaa.Address{31: 4}))
+	assert.Equal(t, emitterTokenBridge, classifyEmitter(config, vaa.ChainIDAlephium, alphTokenBridge))
+	assert.Equal(t, emitterUnknown, classifyEmitter(config, vaa.ChainIDEthereum, alphTokenBridge))
+	assert.Equal(t, emitterUnknown, classifyEmitter(nil, vaa.ChainIDUnset, vaa.Address{31: 4}))
+}
+
+func TestDecodeVAA(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+
+	transfer := vaa.Transfer{
+		Amount:        big.NewInt(150000000),
+		OriginAddress: vaa.Address{31: 1},
+		OriginChain:   vaa.ChainIDEthereum,
+		TargetAddress: make([]byte, 33),
+		Fee:           big.NewInt(0),
+	}
+	v := &vaa.VAA{
+		Version:          1,
+		GuardianSetIndex: 1,
+		Timestamp:        time.Unix(1000, 0),
+		Nonce:            1,
+		Sequence:         10,
+		ConsistencyLevel: 1,
+		EmitterChain:     vaa.ChainIDEthereum,
+		TargetChain:      vaa.ChainIDAlephium,
+		EmitterAddress:   vaa.Address{31: 2},
+		Payload:          transfer.Serialize(),
+	}
+	v.AddSignature(key, 0)
+
+	d := decodeVAA(v, nil, tokenDecimals{{vaa.ChainIDEthereum, vaa.Address{31: 1}}: 8})
+	assert.Equal(t, emitterUnknown, d.Emitter)
+	require.NotNil(t, d.Payload)
+	assert.Equal(t, "Transfer", d.Payload.Type)
+	assert.Equal(t, field{"amount", "1.5 (150000000)"}, d.Payload.Fields[0])
+	assert.Equal(t, field{"recipient", strings.Repeat("1", 33)}, d.Payload.Fields[3])
+	assert.Equal(t, signer.Hex(), d.Signatures[0].Signer)
+
+	d.verify(v, &common.GuardianSet{Keys: []ethCommon.Address{signer}, Index: 1}, true)
+	assert.True(t, d.Verification.Valid)
+	assert.True(t, *d.Signatures[0].Valid)
+
+	d.verify(v, &common.GuardianSet{Keys: []ethCommon.Address{signer}, Index: 2}, true)
+	assert.False(t, d.Verification.Valid)
+	d.verify(v, &common.GuardianSet{Keys: []ethCommon.Address{signer}}, false)
+	assert.True(t, d.Verification.Valid)
+
+	d.verify(v, &common.GuardianSet{Keys: []ethCommon.Address{{1}}, Index: 1}, true)
+	assert.False(t, d.Verification.Valid)
+	assert.False(t, *d.Signatures[0].Valid)
+
+	// Token bridge messages are rejected from the governance emitter
+	_, err = decodePayload(v, emitterGovernance, tokenDecimals{})
+	assert.Error(t, err)
+}
@@ -0,0 +1,70 @@
+package guardiand
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/spf13/cobra"
+)
+
+var AdminClientAccountantBalancesCmd = &cobra.Command{
+	Use:   "accountant-balances",
+	Short: "Displays the amount of each origin token locked on its origin chain and released on the other chains",
+	Run:   runAccountantBalances,
+	Args:  cobra.NoArgs,
+}
+
+var AdminClientAccountantRebuildCmd = &cobra.Command{
+	Use:   "accountant-rebuild",
+	Short: "Rebuilds the ledger of the token accountant from the VAAs stored in the database",
+	Run:   runAccountantRebuild,
+	Args:  cobra.NoArgs,
+}
+
+func runAccountantBalances(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err, c := getAdminClient(ctx, *clientSocketPath)
+	defer conn.Close()
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+
+	resp, err := c.AccountantGetBalances(ctx, &nodev1.AccountantGetBalancesRequest{})
+	if err != nil {
+		log.Fatalf("failed to get accountant balances: %v", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Origin chain\tOrigin address\tChain\tAmount")
+	for _, b := range resp.Balances {
+		fmt.Fprintf(w, "%v\t%s\t%v\t%s\n",
+			vaa.ChainID(b.OriginChain), b.OriginAddress, vaa.ChainID(b.Chain), b.Amount)
+	}
+	w.Flush()
+}
+
+func runAccountantRebuild(cmd *cobra.Command, args []string) {
+	// Rebuilding reads all the VAAs of the token bridges, which takes a while on large databases.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	conn, err, c := getAdminClient(ctx, *clientSocketPath)
+	defer conn.Close()
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+
+	resp, err := c.AccountantRebuild(ctx, &nodev1.AccountantRebuildRequest{})
+	if err != nil {
+		log.Fatalf("failed to rebuild accountant ledger: %v", err)
+	}
+	log.Printf("rebuilt accountant ledger from %d transfer VAAs", resp.NumVaas)
+}
@@ -28,8 +28,9 @@ import (
 )
 
 var (
-	clientSocketPath *string
-	shouldBackfill   *bool
+	clientSocketPath      *string
+	shouldBackfill        *bool
+	registrationTemplates *bool
 )
 
 func init() {
@@ -43,12 +44,28 @@ func init() {
 
 	shouldBackfill = AdminClientFindMissingMessagesCmd.Flags().Bool(
 		"backfill", false, "backfill missing VAAs from public RPC")
+	registrationTemplates = AdminClientCheckRegistrationsCmd.Flags().Bool(
+		"templates", false, "print token-bridge-register-chain templates for the missing registrations")
 
 	AdminClientInjectGovernanceVAACmd.Flags().AddFlagSet(pf)
 	AdminClientFindMissingMessagesCmd.Flags().AddFlagSet(pf)
 	AdminClientListNodes.Flags().AddFlagSet(pf)
 	DumpVAAByMessageID.Flags().AddFlagSet(pf)
 	SendObservationRequest.Flags().AddFlagSet(pf)
+	AdminClientGovernorStatusCmd.Flags().AddFlagSet(pf)
+	AdminClientGovernorReleasePendingCmd.Flags().AddFlagSet(pf)
+	AdminClientGovernorDropPendingCmd.Flags().AddFlagSet(pf)
+	AdminClientEquivocationEvidenceCmd.Flags().AddFlagSet(pf)
+	AdminClientAccountantBalancesCmd.Flags().AddFlagSet(pf)
+	AdminClientAccountantRebuildCmd.Flags().AddFlagSet(pf)
+	AdminClientPauseSigningCmd.Flags().AddFlagSet(pf)
+	AdminClientResumeSigningCmd.Flags().AddFlagSet(pf)
+	AdminClientListSigningPausesCmd.Flags().AddFlagSet(pf)
+	AdminClientSigningPolicyRejectionsCmd.Flags().AddFlagSet(pf)
+	AdminClientGuardianSetTransitionCmd.Flags().AddFlagSet(pf)
+	AdminClientGuardianSetConsistencyCmd.Flags().AddFlagSet(pf)
+	AdminClientCheckRegistrationsCmd.Flags().AddFlagSet(pf)
+	AdminClientGovernanceStatusCmd.Flags().AddFlagSet(pf)
 
 	AdminCmd.AddCommand(AdminClientInjectGovernanceVAACmd)
 	AdminCmd.AddCommand(AdminClientFindMissingMessagesCmd)
@@ -56,6 +73,22 @@ func init() {
 	AdminCmd.AddCommand(AdminClientListNodes)
 	AdminCmd.AddCommand(DumpVAAByMessageID)
 	AdminCmd.AddCommand(SendObservationRequest)
+	AdminCmd.AddCommand(AdminClientGovernorStatusCmd)
+	AdminCmd.AddCommand(AdminClientGovernorReleasePendingCmd)
+	AdminCmd.AddCommand(AdminClientGovernorDropPendingCmd)
+	AdminCmd.AddCommand(AdminClientEquivocationEvidenceCmd)
+	AdminCmd.AddCommand(AdminClientAccountantBalancesCmd)
+	AdminCmd.AddCommand(AdminClientAccountantRebuildCmd)
+	AdminCmd.AddCommand(AdminClientPauseSigningCmd)
+	AdminCmd.AddCommand(AdminClientResumeSigningCmd)
+	AdminCmd.AddCommand(AdminClientListSigningPausesCmd)
+	AdminCmd.AddCommand(AdminClientGovernanceSignCmd)
+	AdminCmd.AddCommand(AdminClientGovernanceCombineCmd)
+	AdminCmd.AddCommand(AdminClientSigningPolicyRejectionsCmd)
+	AdminCmd.AddCommand(AdminClientGuardianSetTransitionCmd)
+	AdminCmd.AddCommand(AdminClientGuardianSetConsistencyCmd)
+	AdminCmd.AddCommand(AdminClientCheckRegistrationsCmd)
+	AdminCmd.AddCommand(AdminClientGovernanceStatusCmd)
 }
 
 var AdminCmd = &cobra.Command{
@@ -0,0 +1,47 @@
+package guardiand
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"time"
+
+	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
+	"github.com/spf13/cobra"
+)
+
+var AdminClientEquivocationEvidenceCmd = &cobra.Command{
+	Use:   "equivocation-evidence",
+	Short: "Dumps the conflicting observations signed by the same guardian for the same message",
+	Run:   runEquivocationEvidence,
+	Args:  cobra.NoArgs,
+}
+
+func runEquivocationEvidence(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err, c := getAdminClient(ctx, *clientSocketPath)
+	defer conn.Close()
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+
+	resp, err := c.GetEquivocationEvidence(ctx, &nodev1.GetEquivocationEvidenceRequest{})
+	if err != nil {
+		log.Fatalf("failed to get equivocation evidence: %v", err)
+	}
+
+	// Each VAA only holds the signature of the guardian, they can be checked with `guardiand debug decode-vaa`.
+	for _, e := range resp.Evidence {
+		fmt.Printf("message %s signed by %s, detected at %s\n",
+			e.MessageId, e.GuardianAddr, time.Unix(e.DetectedAt, 0).Format(time.RFC3339))
+		for _, b := range e.SignedObservations {
+			fmt.Printf("  observation %s\n", hex.EncodeToString(b))
+		}
+		for _, b := range e.SignedVaas {
+			fmt.Printf("  vaa %s\n", hex.EncodeToString(b))
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package guardiand
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/processor"
+	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+var (
+	governanceSignGuardianKey  *string
+	governanceSignPassphraseFd *int
+	governanceSignOutput       *string
+
+	governanceCombineGuardianSet      *[]string
+	governanceCombineGuardianSetIndex *uint32
+	governanceCombineOutput           *string
+)
+
+func init() {
+	governanceSignGuardianKey = AdminClientGovernanceSignCmd.Flags().String("guardianKey", "", "Path to guardian key (required)")
+	governanceSignPassphraseFd = AdminClientGovernanceSignCmd.Flags().Int("guardianKeyPassphraseFd", -1, "File descriptor to read the passphrase of an encrypted guardian key from (defaults to $"+GuardianKeyPassphraseEnv+" or the terminal)")
+	governanceSignOutput = AdminClientGovernanceSignCmd.Flags().String("output", "", "Path of the signature file (defaults to stdout)")
+	if err := AdminClientGovernanceSignCmd.MarkFlagRequired("guardianKey"); err != nil {
+		panic(err)
+	}
+
+	governanceCombineGuardianSet = AdminClientGovernanceCombineCmd.Flags().StringSlice("guardianSet", nil, "Addresses (comma-separated) of the guardian set of the template, in guardian set order (required)")
+	governanceCombineGuardianSetIndex = AdminClientGovernanceCombineCmd.Flags().Uint32("guardianSetIndex", 0, "Index of the guardian set passed with --guardianSet, which must match the current set index of the template (required)")
+	governanceCombineOutput = AdminClientGovernanceCombineCmd.Flags().String("output", "", "Path of the file to write the hex-encoded VAAs to, one per line (defaults to stdout)")
+	if err := AdminClientGovernanceCombineCmd.MarkFlagRequired("guardianSet"); err != nil {
+		panic(err)
+	}
+	if err := AdminClientGovernanceCombineCmd.MarkFlagRequired("guardianSetIndex"); err != nil {
+		panic(err)
+	}
+}
+
+var AdminClientGovernanceSignCmd = &cobra.Command{
+	Use:   "governance-sign [NETWORK] [TEMPLATE]",
+	Short: "Sign the governance VAAs of a prototxt template with the guardian key (offline)",
+	Run:   runGovernanceSign,
+	Args:  cobra.ExactArgs(2),
+}
+
+var AdminClientGovernanceCombineCmd = &cobra.Command{
+	Use:   "governance-combine [NETWORK] [TEMPLATE] [SIGNATURES...]",
+	Short: "Combine the signature files of governance-sign into signed governance VAAs (offline)",
+	Run:   runGovernanceCombine,
+	Args:  cobra.MinimumNArgs(3),
+}
+
+func runGovernanceSign(cmd *cobra.Command, args []string) {
+	common.LockMemory()
+	common.SetRestrictiveUmask()
+
+	vaas, err := loadGovernanceTemplate(args[0], args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	gk, err := loadGuardianKey(*governanceSignGuardianKey, guardianKeyPassphrase(*governanceSignPassphraseFd, "Enter guardian key passphrase: "))
+	if err != nil {
+		log.Fatalf("failed to load guardian key: %v", err)
+	}
+
+	for _, v := range vaas {
+		log.Printf("Signing VAA %s with digest %s", v.MessageID(), v.HexDigest())
+	}
+	signatures := signGovernanceVAAs(vaas, gk)
+
+	b, err := prototext.MarshalOptions{Multiline: true}.Marshal(signatures)
+	if err != nil {
+		panic(err)
+	}
+	if err := writeOutput(*governanceSignOutput, b); err != nil {
+		log.Fatalf("failed to write signatures: %v", err)
+	}
+	log.Printf("Signed %d VAAs with guardian %s", len(vaas), signatures.GuardianAddress)
+}
+
+func runGovernanceCombine(cmd *cobra.Command, args []string) {
+	vaas, err := loadGovernanceTemplate(args[0], args[1])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	gs := &common.GuardianSet{
+		Keys:  make([]ethcommon.Address, len(*governanceCombineGuardianSet)),
+		Index: *governanceCombineGuardianSetIndex,
+	}
+	for i, addr := range *governanceCombineGuardianSet {
+		if !ethcommon.IsHexAddress(addr) {
+			log.Fatalf("invalid guardian address %s", addr)
+		}
+		gs.Keys[i] = ethcommon.HexToAddress(addr)
+	}
+
+	var signatures []*nodev1.GovernanceSignatures
+	for _, path := range args[2:] {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			log.Fatalf("failed to read file: %v", err)
+		}
+		var s nodev1.GovernanceSignatures
+		if err := prototext.Unmarshal(b, &s); err != nil {
+			log.Fatalf("failed to deserialize %s: %v", path, err)
+		}
+		signatures = append(signatures, &s)
+	}
+
+	if err := combineGovernanceSignatures(vaas, gs, signatures); err != nil {
+		log.Fatal(err)
+	}
+
+	var out []byte
+	for _, v := range vaas {
+		b, err := v.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		log.Printf("VAA %s with digest %s signed by %d/%d guardians", v.MessageID(), v.HexDigest(), len(v.Signatures), len(gs.Keys))
+		out = append(out, hex.EncodeToString(b)+"\n"...)
+	}
+	if err := writeOutput(*governanceCombineOutput, out); err != nil {
+		log.Fatalf("failed to write VAAs: %v", err)
+	}
+}
+
+// loadGovernanceTemplate reads a governance template in prototxt format, and returns its unsigned VAAs.
+func loadGovernanceTemplate(network string, path string) ([]*vaa.VAA, error) {
+	guardianConfig, err := common.ReadGuardianConfig(network)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read configs: %w", err)
+	}
+	governanceChainId := vaa.ChainID(guardianConfig.GovernanceChainId)
+	governanceEmitterAddress, err := vaa.StringToAddress(guardianConfig.GovernanceEmitterAddress)
+	if err != nil {
+		return nil, fmt.Errorf("invalid governance emitter address %s: %w", guardianConfig.GovernanceEmitterAddress, err)
+	}
+
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	var req nodev1.InjectGovernanceVAARequest
+	if err := prototext.Unmarshal(b, &req); err != nil {
+		return nil, fmt.Errorf("failed to deserialize: %w", err)
+	}
+	if len(req.Messages) == 0 {
+		return nil, fmt.Errorf("no governance messages in %s", path)
+	}
+
+	timestamp := time.Unix(int64(req.Timestamp), 0)
+	vaas := make([]*vaa.VAA, len(req.Messages))
+	for i, message := range req.Messages {
+		vaas[i], err = governanceMessageToVAA(governanceChainId, governanceEmitterAddress, message, timestamp, req.CurrentSetIndex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid governance message %d: %w", i, err)
+		}
+	}
+	return vaas, nil
+}
+
+// signGovernanceVAAs signs the digest of each VAA with the guardian key.
+func signGovernanceVAAs(vaas []*vaa.VAA, gk *ecdsa.PrivateKey) *nodev1.GovernanceSignatures {
+	signatures := &nodev1.GovernanceSignatures{
+		GuardianAddress: ethcrypto.PubkeyToAddress(gk.PublicKey).Hex(),
+	}
+	for _, v := range vaas {
+		digest := v.SigningMsg()
+		s, err := ethcrypto.Sign(digest.Bytes(), gk)
+		if err != nil {
+			panic(err)
+		}
+		signatures.Signatures = append(signatures.Signatures, &nodev1.GovernanceSignature{
+			Digest:    hex.EncodeToString(digest.Bytes()),
+			Signature: hex.EncodeToString(s),
+		})
+	}
+	return signatures
+}
+
+// combineGovernanceSignatures adds the signatures of the guardians to the VAAs. Every VAA must be for the guardian
+// set, every signature must be a valid signature of a VAA by a member of the guardian set, and every VAA needs to be
+// signed by a quorum of the guardian set.
+func combineGovernanceSignatures(vaas []*vaa.VAA, gs *common.GuardianSet, signatures []*nodev1.GovernanceSignatures) error {
+	guardians := gs.Keys
+	if len(guardians) == 0 || len(guardians) > common.MaxGuardianCount {
+		return fmt.Errorf("invalid guardian set size %d", len(guardians))
+	}
+
+	byDigest := make(map[string]*vaa.VAA, len(vaas))
+	for _, v := range vaas {
+		if v.GuardianSetIndex != gs.Index {
+			return fmt.Errorf("VAA %s is for guardian set %d, not guardian set %d", v.MessageID(), v.GuardianSetIndex, gs.Index)
+		}
+		v.Signatures = nil
+		byDigest[v.HexDigest()] = v
+	}
+
+	signers := make(map[ethcommon.Address]bool, len(signatures))
+	for _, s := range signatures {
+		if !ethcommon.IsHexAddress(s.GuardianAddress) {
+			return fmt.Errorf("invalid guardian address %s", s.GuardianAddress)
+		}
+		addr := ethcommon.HexToAddress(s.GuardianAddress)
+		index := -1
+		for i, g := range guardians {
+			if g == addr {
+				index = i
+			}
+		}
+		if index < 0 {
+			return fmt.Errorf("guardian %s is not in the guardian set", addr)
+		}
+		if signers[addr] {
+			return fmt.Errorf("duplicate signatures of guardian %s", addr)
+		}
+		signers[addr] = true
+
+		for _, sig := range s.Signatures {
+			v, ok := byDigest[sig.Digest]
+			if !ok {
+				return fmt.Errorf("guardian %s signed unknown digest %s, was another template signed?", addr, sig.Digest)
+			}
+			b, err := hex.DecodeString(sig.Signature)
+			if err != nil || len(b) != 65 {
+				return fmt.Errorf("invalid signature of guardian %s for digest %s", addr, sig.Digest)
+			}
+			pubKey, err := ethcrypto.Ecrecover(v.SigningMsg().Bytes(), b)
+			if err != nil || ethcommon.BytesToAddress(ethcrypto.Keccak256(pubKey[1:])[12:]) != addr {
+				return fmt.Errorf("invalid signature of guardian %s for digest %s", addr, sig.Digest)
+			}
+			for _, existing := range v.Signatures {
+				if int(existing.Index) == index {
+					return fmt.Errorf("duplicate signature of guardian %s for digest %s", addr, sig.Digest)
+				}
+			}
+			signature := &vaa.Signature{Index: uint8(index)}
+			copy(signature.Signature[:], b)
+			v.Signatures = append(v.Signatures, signature)
+		}
+	}
+
+	quorum := processor.CalculateQuorum(len(guardians))
+	for _, v := range vaas {
+		if len(v.Signatures) < quorum {
+			return fmt.Errorf("VAA %s with digest %s has %d signatures, quorum is %d", v.MessageID(), v.HexDigest(), len(v.Signatures), quorum)
+		}
+		sort.Slice(v.Signatures, func(i, j int) bool { return v.Signatures[i].Index < v.Signatures[j].Index })
+		if !v.VerifySignatures(guardians) {
+			return fmt.Errorf("failed to verify signatures of VAA %s", v.MessageID())
+		}
+	}
+	return nil
+}
+
+// writeOutput writes b to the file at path, or to stdout if path is empty.
+func writeOutput(path string, b []byte) error {
+	if path == "" {
+		_, err := os.Stdout.Write(b)
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(b); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,104 @@
+package guardiand
+
+import (
+	"crypto/ecdsa"
+	"testing"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/devnet"
+	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+func governanceTestVAAs(t *testing.T) []*vaa.VAA {
+	var vaas []*vaa.VAA
+	for i, message := range []*nodev1.GovernanceMessage{
+		{Sequence: 1, Nonce: 1, Payload: &nodev1.GovernanceMessage_UpdateMinimalConsistencyLevel{
+			UpdateMinimalConsistencyLevel: &nodev1.TokenBridgeUpdateMinimalConsistencyLevel{NewConsistencyLevel: 10},
+		}},
+		{Sequence: 2, Nonce: 2, TargetChainId: uint32(vaa.ChainIDAlephium), Payload: &nodev1.GovernanceMessage_BridgeRegisterChain{
+			BridgeRegisterChain: &nodev1.BridgeRegisterChain{Module: "TokenBridge", ChainId: uint32(vaa.ChainIDEthereum), EmitterAddress: "0000000000000000000000000290fb167208af455bb137780163b7b7a9a10c16"},
+		}},
+	} {
+		v, err := governanceMessageToVAA(vaa.ChainIDUnset, vaa.Address{0x04}, message, time.Unix(1000, 0), 0)
+		require.NoError(t, err, "message %d", i)
+		vaas = append(vaas, v)
+	}
+	return vaas
+}
+
+// signGovernanceTestVAAs signs the VAAs with the given guardians, and round trips the signatures through prototext.
+func signGovernanceTestVAAs(t *testing.T, vaas []*vaa.VAA, keys ...*ecdsa.PrivateKey) []*nodev1.GovernanceSignatures {
+	var signatures []*nodev1.GovernanceSignatures
+	for _, k := range keys {
+		b, err := prototext.Marshal(signGovernanceVAAs(vaas, k))
+		require.NoError(t, err)
+		var s nodev1.GovernanceSignatures
+		require.NoError(t, prototext.Unmarshal(b, &s))
+		signatures = append(signatures, &s)
+	}
+	return signatures
+}
+
+func TestCombineGovernanceSignatures(t *testing.T) {
+	gs, keys := devnet.InsecureDeterministicGuardianSet(0, 4)
+	vaas := governanceTestVAAs(t)
+
+	// Quorum of 4 guardians is 3, signature files can be combined in any order
+	signatures := signGovernanceTestVAAs(t, vaas, keys[3], keys[0], keys[2])
+	require.NoError(t, combineGovernanceSignatures(vaas, gs, signatures))
+	for _, v := range vaas {
+		require.Len(t, v.Signatures, 3)
+		assert.Equal(t, []uint8{0, 2, 3}, []uint8{v.Signatures[0].Index, v.Signatures[1].Index, v.Signatures[2].Index})
+		assert.True(t, v.VerifySignatures(gs.Keys))
+
+		b, err := v.Marshal()
+		require.NoError(t, err)
+		parsed, err := vaa.Unmarshal(b)
+		require.NoError(t, err)
+		assert.True(t, parsed.VerifySignatures(gs.Keys))
+	}
+}
+
+func TestGovernanceMessageToVAAInvalidPayload(t *testing.T) {
+	_, err := governanceMessageToVAA(vaa.ChainIDUnset, vaa.Address{0x04}, &nodev1.GovernanceMessage{Sequence: 1}, time.Unix(1000, 0), 0)
+	assert.ErrorContains(t, err, "missing governance message payload")
+}
+
+func TestCombineGovernanceSignaturesErrors(t *testing.T) {
+	gs, keys := devnet.InsecureDeterministicGuardianSet(0, 4)
+	vaas := governanceTestVAAs(t)
+	_, outsider := devnet.InsecureDeterministicGuardianSet(1, 1)
+
+	// No quorum
+	err := combineGovernanceSignatures(vaas, gs, signGovernanceTestVAAs(t, vaas, keys[0], keys[1]))
+	assert.ErrorContains(t, err, "quorum is 3")
+
+	// Signer not in the guardian set
+	err = combineGovernanceSignatures(vaas, gs, signGovernanceTestVAAs(t, vaas, keys[0], keys[1], outsider[0]))
+	assert.ErrorContains(t, err, "not in the guardian set")
+
+	// Same guardian twice
+	err = combineGovernanceSignatures(vaas, gs, signGovernanceTestVAAs(t, vaas, keys[0], keys[1], keys[1]))
+	assert.ErrorContains(t, err, "duplicate")
+
+	// Signature of another template
+	other := governanceTestVAAs(t)
+	other[0].Nonce = 42
+	err = combineGovernanceSignatures(vaas, gs, append(signGovernanceTestVAAs(t, vaas, keys[0], keys[1]), signGovernanceTestVAAs(t, other, keys[2])...))
+	assert.ErrorContains(t, err, "unknown digest")
+
+	// Template for another guardian set
+	err = combineGovernanceSignatures(vaas, &common.GuardianSet{Keys: gs.Keys, Index: 1}, signGovernanceTestVAAs(t, vaas, keys[0], keys[1], keys[2]))
+	assert.ErrorContains(t, err, "not guardian set 1")
+
+	// Signature file claiming to be from another guardian
+	signatures := signGovernanceTestVAAs(t, vaas, keys[0], keys[1], keys[2])
+	signatures[2].GuardianAddress = gs.Keys[3].Hex()
+	err = combineGovernanceSignatures(vaas, gs, signatures)
+	assert.ErrorContains(t, err, "invalid signature")
+}
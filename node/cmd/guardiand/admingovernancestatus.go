@@ -0,0 +1,99 @@
+package guardiand
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/spf13/cobra"
+)
+
+var AdminClientGovernanceStatusCmd = &cobra.Command{
+	Use:   "governance-status",
+	Short: "Shows whether the governance VAAs stored by the node were executed on their target chains",
+	Run:   runGovernanceStatus,
+	Args:  cobra.NoArgs,
+}
+
+func runGovernanceStatus(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err, c := getAdminClient(ctx, *clientSocketPath)
+	defer conn.Close()
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+
+	resp, err := c.GetGovernanceStatus(ctx, &nodev1.GetGovernanceStatusRequest{})
+	if err != nil {
+		log.Fatalf("failed to get governance status: %v", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Chain\tGuardian set\tMessage fee\tCore sequence\tToken bridge sequence\tChecked at\tStatus")
+	for _, chain := range resp.Chains {
+		index, fee, coreSequence, tokenBridgeSequence := "-", "-", "-", "-"
+		if chain.State != nil {
+			index = fmt.Sprint(chain.State.GuardianSetIndex)
+			fee = chain.State.MessageFee
+			// EVM chains record executed governance VAAs by hash.
+			if chain.State.CoreSequence != 0 || chain.State.TokenBridgeSequence != 0 {
+				coreSequence = fmt.Sprint(chain.State.CoreSequence)
+				tokenBridgeSequence = fmt.Sprint(chain.State.TokenBridgeSequence)
+			}
+		}
+		checkedAt := "never"
+		if chain.CheckedAt != 0 {
+			checkedAt = time.Unix(chain.CheckedAt, 0).Format(time.RFC3339)
+		}
+		status := "ok"
+		if chain.Error != "" {
+			status = "query failed: " + chain.Error
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			vaa.ChainID(chain.ChainId), index, fee, coreSequence, tokenBridgeSequence, checkedAt, status)
+	}
+	w.Flush()
+	fmt.Print("\n")
+
+	// Execution of each VAA per chain, "-" for chains the VAA doesn't target.
+	fmt.Fprint(w, "Target\tSequence\tAction\tDigest")
+	for _, chain := range resp.Chains {
+		fmt.Fprintf(w, "\t%s", vaa.ChainID(chain.ChainId))
+	}
+	fmt.Fprintln(w)
+	for _, v := range resp.Vaas {
+		target := "all"
+		if v.TargetChainId != uint32(vaa.ChainIDUnset) {
+			target = vaa.ChainID(v.TargetChainId).String()
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s", target, v.Sequence, v.Action, v.Digest)
+		for _, chain := range resp.Chains {
+			fmt.Fprintf(w, "\t%s", executionStatus(v.Executions, chain.ChainId))
+		}
+		fmt.Fprintln(w)
+	}
+	w.Flush()
+}
+
+func executionStatus(executions []*nodev1.GovernanceVAAExecution, chainId uint32) string {
+	for _, e := range executions {
+		if e.ChainId != chainId {
+			continue
+		}
+		if e.Executed {
+			return "executed"
+		}
+		if e.Error != "" {
+			return "unknown"
+		}
+		return "PENDING"
+	}
+	return "-"
+}
@@ -0,0 +1,114 @@
+package guardiand
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/spf13/cobra"
+)
+
+var AdminClientGovernorStatusCmd = &cobra.Command{
+	Use:   "governor-status",
+	Short: "Displays the limits and current usage of the chain governor, and the list of held transfers",
+	Run:   runGovernorStatus,
+	Args:  cobra.NoArgs,
+}
+
+var AdminClientGovernorReleasePendingCmd = &cobra.Command{
+	Use:   "governor-release-pending [MESSAGE_ID]",
+	Short: "Releases a transfer held by the chain governor (emitterChainId/emitterAddress/targetChainId/sequence)",
+	Run:   runGovernorReleasePending,
+	Args:  cobra.ExactArgs(1),
+}
+
+var AdminClientGovernorDropPendingCmd = &cobra.Command{
+	Use:   "governor-drop-pending [MESSAGE_ID]",
+	Short: "Drops a transfer held by the chain governor (emitterChainId/emitterAddress/targetChainId/sequence)",
+	Run:   runGovernorDropPending,
+	Args:  cobra.ExactArgs(1),
+}
+
+func runGovernorStatus(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err, c := getAdminClient(ctx, *clientSocketPath)
+	defer conn.Close()
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+
+	resp, err := c.GovernorGetStatus(ctx, &nodev1.GovernorGetStatusRequest{})
+	if err != nil {
+		log.Fatalf("failed to get governor status: %v", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Emitter chain\tDaily limit\tBig transaction size\tNotional usage (24h)\tPending")
+	for _, c := range resp.Chains {
+		fmt.Fprintf(w, "%v\t%d\t%d\t%d\t%d\n",
+			vaa.ChainID(c.EmitterChain), c.DailyLimit, c.BigTransactionSize, c.NotionalUsage, c.PendingCount)
+	}
+	w.Flush()
+
+	fmt.Println()
+	log.Printf("%d pending transfers", len(resp.PendingTransfers))
+
+	w = tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Message ID\tTarget chain\tValue\tRelease time\tTx hash")
+	for _, p := range resp.PendingTransfers {
+		fmt.Fprintf(w, "%s\t%v\t%d\t%s\t%s\n",
+			p.MessageId, vaa.ChainID(p.TargetChain), p.Value, time.Unix(int64(p.ReleaseTime), 0).Format(time.RFC3339), p.TxHash)
+	}
+	w.Flush()
+}
+
+func runGovernorReleasePending(cmd *cobra.Command, args []string) {
+	if _, err := vaa.VaaIDFromString(args[0]); err != nil {
+		log.Fatalf("invalid message ID: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err, c := getAdminClient(ctx, *clientSocketPath)
+	defer conn.Close()
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+
+	_, err = c.GovernorReleasePendingTransfer(ctx, &nodev1.GovernorReleasePendingTransferRequest{MessageId: args[0]})
+	if err != nil {
+		log.Fatalf("failed to release pending transfer: %v", err)
+	}
+
+	log.Printf("transfer %s will be released on the next governor check", args[0])
+}
+
+func runGovernorDropPending(cmd *cobra.Command, args []string) {
+	if _, err := vaa.VaaIDFromString(args[0]); err != nil {
+		log.Fatalf("invalid message ID: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err, c := getAdminClient(ctx, *clientSocketPath)
+	defer conn.Close()
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+
+	_, err = c.GovernorDropPendingTransfer(ctx, &nodev1.GovernorDropPendingTransferRequest{MessageId: args[0]})
+	if err != nil {
+		log.Fatalf("failed to drop pending transfer: %v", err)
+	}
+
+	log.Printf("transfer %s dropped", args[0])
+}
@@ -0,0 +1,93 @@
+package guardiand
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/spf13/cobra"
+)
+
+var AdminClientGuardianSetConsistencyCmd = &cobra.Command{
+	Use:   "guardian-set-consistency",
+	Short: "Shows the guardian set of the core contract of each chain, and whether they are consistent",
+	Run:   runGuardianSetConsistency,
+	Args:  cobra.NoArgs,
+}
+
+func runGuardianSetConsistency(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err, c := getAdminClient(ctx, *clientSocketPath)
+	defer conn.Close()
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+
+	resp, err := c.GetGuardianSetConsistency(ctx, &nodev1.GetGuardianSetConsistencyRequest{})
+	if err != nil {
+		log.Fatalf("failed to get guardian set consistency: %v", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Chain\tIndex\tGuardians\tChecked at\tStatus")
+	// Guardians of all chains, in order of appearance.
+	var guardians []string
+	seen := make(map[string]bool)
+	members := make(map[vaa.ChainID]map[string]bool)
+	for _, chain := range resp.Chains {
+		chainId := vaa.ChainID(chain.ChainId)
+		index, size := "-", "-"
+		if chain.GuardianSet != nil {
+			index = fmt.Sprint(chain.GuardianSet.Index)
+			size = fmt.Sprint(len(chain.GuardianSet.Addresses))
+			members[chainId] = make(map[string]bool)
+			for _, addr := range chain.GuardianSet.Addresses {
+				if !seen[addr] {
+					seen[addr] = true
+					guardians = append(guardians, addr)
+				}
+				members[chainId][addr] = true
+			}
+		}
+		checkedAt := "never"
+		if chain.CheckedAt != 0 {
+			checkedAt = time.Unix(chain.CheckedAt, 0).Format(time.RFC3339)
+		}
+		status := "ok"
+		if chain.Mismatch != "" {
+			status = "MISMATCH: " + chain.Mismatch
+		}
+		if chain.Error != "" {
+			status += " (query failed: " + chain.Error + ")"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", chainId, index, size, checkedAt, status)
+	}
+	w.Flush()
+	fmt.Print("\n")
+
+	// Guardian membership per chain, as in list-nodes.
+	fmt.Fprint(w, "Guardian")
+	for _, chain := range resp.Chains {
+		fmt.Fprintf(w, "\t%s", vaa.ChainID(chain.ChainId))
+	}
+	fmt.Fprintln(w)
+	for _, addr := range guardians {
+		fmt.Fprint(w, addr)
+		for _, chain := range resp.Chains {
+			if members[vaa.ChainID(chain.ChainId)][addr] {
+				fmt.Fprint(w, "\tx")
+			} else {
+				fmt.Fprint(w, "\t-")
+			}
+		}
+		fmt.Fprintln(w)
+	}
+	w.Flush()
+}
@@ -0,0 +1,139 @@
+package guardiand
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	gossipv1 "github.com/alephium/wormhole-fork/node/pkg/proto/gossip/v1"
+	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/spf13/cobra"
+)
+
+var pauseReason *string
+
+func init() {
+	pauseReason = AdminClientPauseSigningCmd.Flags().String("reason", "", "Reason for the pause, broadcast in heartbeats")
+}
+
+var AdminClientPauseSigningCmd = &cobra.Command{
+	Use:   "pause-signing [emitter-chain|target-chain|emitter] [CHAIN] [EMITTER_ADDRESS]",
+	Short: "Stops signing the messages of an emitter chain, a target chain or an emitter",
+	Run:   runPauseSigning,
+	Args:  cobra.RangeArgs(2, 3),
+}
+
+var AdminClientResumeSigningCmd = &cobra.Command{
+	Use:   "resume-signing [emitter-chain|target-chain|emitter] [CHAIN] [EMITTER_ADDRESS]",
+	Short: "Resumes signing the messages paused by pause-signing",
+	Run:   runResumeSigning,
+	Args:  cobra.RangeArgs(2, 3),
+}
+
+var AdminClientListSigningPausesCmd = &cobra.Command{
+	Use:   "signing-pauses",
+	Short: "Lists the emitter chains, target chains and emitters whose messages are not signed",
+	Run:   runListSigningPauses,
+	Args:  cobra.NoArgs,
+}
+
+// parseSigningPause parses the scope, chain and emitter address arguments of pause-signing and resume-signing.
+func parseSigningPause(args []string) (*gossipv1.SigningPause, error) {
+	chainId, err := parseChainID(args[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid chain: %v", err)
+	}
+	pause := &gossipv1.SigningPause{ChainId: uint32(chainId)}
+
+	switch args[0] {
+	case "emitter-chain":
+		pause.Scope = gossipv1.SigningPause_SCOPE_EMITTER_CHAIN
+	case "target-chain":
+		pause.Scope = gossipv1.SigningPause_SCOPE_TARGET_CHAIN
+	case "emitter":
+		pause.Scope = gossipv1.SigningPause_SCOPE_EMITTER
+	default:
+		return nil, fmt.Errorf("invalid scope %s, expected emitter-chain, target-chain or emitter", args[0])
+	}
+
+	if pause.Scope == gossipv1.SigningPause_SCOPE_EMITTER {
+		if len(args) != 3 {
+			return nil, fmt.Errorf("missing emitter address")
+		}
+		pause.EmitterAddress = args[2]
+	} else if len(args) != 2 {
+		return nil, fmt.Errorf("emitter address is only valid for the emitter scope")
+	}
+	return pause, nil
+}
+
+func runPauseSigning(cmd *cobra.Command, args []string) {
+	pause, err := parseSigningPause(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+	pause.Reason = *pauseReason
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err, c := getAdminClient(ctx, *clientSocketPath)
+	defer conn.Close()
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+
+	if _, err := c.PauseSigning(ctx, &nodev1.PauseSigningRequest{Pause: pause}); err != nil {
+		log.Fatalf("failed to pause signing: %v", err)
+	}
+	log.Printf("paused signing for %s %v", args[0], args[1:])
+}
+
+func runResumeSigning(cmd *cobra.Command, args []string) {
+	pause, err := parseSigningPause(args)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err, c := getAdminClient(ctx, *clientSocketPath)
+	defer conn.Close()
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+
+	if _, err := c.ResumeSigning(ctx, &nodev1.ResumeSigningRequest{Pause: pause}); err != nil {
+		log.Fatalf("failed to resume signing: %v", err)
+	}
+	log.Printf("resumed signing for %s %v", args[0], args[1:])
+}
+
+func runListSigningPauses(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err, c := getAdminClient(ctx, *clientSocketPath)
+	defer conn.Close()
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+
+	resp, err := c.ListSigningPauses(ctx, &nodev1.ListSigningPausesRequest{})
+	if err != nil {
+		log.Fatalf("failed to list signing pauses: %v", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Scope\tChain\tEmitter address\tPaused at\tReason")
+	for _, p := range resp.Pauses {
+		fmt.Fprintf(w, "%v\t%v\t%s\t%s\t%s\n",
+			p.Scope, vaa.ChainID(p.ChainId), p.EmitterAddress, time.Unix(p.PausedAt, 0).Format(time.RFC3339), p.Reason)
+	}
+	w.Flush()
+}
@@ -0,0 +1,36 @@
+package guardiand
+
+import (
+	"testing"
+
+	gossipv1 "github.com/alephium/wormhole-fork/node/pkg/proto/gossip/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSigningPause(t *testing.T) {
+	pause, err := parseSigningPause([]string{"emitter-chain", "ethereum"})
+	assert.Nil(t, err)
+	assert.Equal(t, gossipv1.SigningPause_SCOPE_EMITTER_CHAIN, pause.Scope)
+	assert.Equal(t, uint32(vaa.ChainIDEthereum), pause.ChainId)
+
+	pause, err = parseSigningPause([]string{"target-chain", "4"})
+	assert.Nil(t, err)
+	assert.Equal(t, gossipv1.SigningPause_SCOPE_TARGET_CHAIN, pause.Scope)
+	assert.Equal(t, uint32(vaa.ChainIDBSC), pause.ChainId)
+
+	pause, err = parseSigningPause([]string{"emitter", "alephium", "0001"})
+	assert.Nil(t, err)
+	assert.Equal(t, gossipv1.SigningPause_SCOPE_EMITTER, pause.Scope)
+	assert.Equal(t, "0001", pause.EmitterAddress)
+
+	for _, args := range [][]string{
+		{"chain", "ethereum"},
+		{"emitter-chain", "foo"},
+		{"emitter", "ethereum"},
+		{"target-chain", "ethereum", "0001"},
+	} {
+		_, err = parseSigningPause(args)
+		assert.NotNil(t, err, args)
+	}
+}
@@ -0,0 +1,43 @@
+package guardiand
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
+	"github.com/spf13/cobra"
+)
+
+var AdminClientSigningPolicyRejectionsCmd = &cobra.Command{
+	Use:   "signing-policy-rejections",
+	Short: "Lists the messages most recently rejected by the signing policy",
+	Run:   runListSigningPolicyRejections,
+	Args:  cobra.NoArgs,
+}
+
+func runListSigningPolicyRejections(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err, c := getAdminClient(ctx, *clientSocketPath)
+	defer conn.Close()
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+
+	resp, err := c.ListSigningPolicyRejections(ctx, &nodev1.ListSigningPolicyRejectionsRequest{})
+	if err != nil {
+		log.Fatalf("failed to list signing policy rejections: %v", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "Rejected at\tReason\tMessage ID\tTx hash")
+	for _, r := range resp.Rejections {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", time.Unix(r.RejectedAt, 0).Format(time.RFC3339), r.Reason, r.MessageId, r.TxHash)
+	}
+	w.Flush()
+}
@@ -0,0 +1,142 @@
+package guardiand
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/registrations"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/rand"
+)
+
+var AdminClientCheckRegistrationsCmd = &cobra.Command{
+	Use:   "check-registrations",
+	Short: "Shows the token bridge emitters registered on the token bridge of each chain, and whether they match the bridge config",
+	Run:   runCheckRegistrations,
+	Args:  cobra.NoArgs,
+}
+
+func runCheckRegistrations(cmd *cobra.Command, args []string) {
+	// The guardian reads the registrations from every chain if they weren't checked yet.
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	conn, err, c := getAdminClient(ctx, *clientSocketPath)
+	defer conn.Close()
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+
+	resp, err := c.GetTokenBridgeRegistrations(ctx, &nodev1.GetTokenBridgeRegistrationsRequest{})
+	if err != nil {
+		log.Fatalf("failed to get token bridge registrations: %v", err)
+	}
+
+	if *registrationTemplates {
+		gs, err := c.GetGuardianSetTransition(ctx, &nodev1.GetGuardianSetTransitionRequest{})
+		if err != nil {
+			log.Fatalf("failed to get current guardian set: %v", err)
+		}
+		printRegisterChainTemplates(resp.Registrations, gs.Current.Index)
+		return
+	}
+
+	checkedAt := "never"
+	if resp.CheckedAt != 0 {
+		checkedAt = time.Unix(resp.CheckedAt, 0).Format(time.RFC3339)
+	}
+	fmt.Printf("Checked at: %s\n\n", checkedAt)
+
+	// Chains in order of appearance, the rows are the chains the emitters are registered on.
+	var chains []vaa.ChainID
+	seen := make(map[vaa.ChainID]bool)
+	statuses := make(map[[2]vaa.ChainID]string)
+	for _, r := range resp.Registrations {
+		for _, chainId := range []vaa.ChainID{vaa.ChainID(r.ChainId), vaa.ChainID(r.EmitterChainId)} {
+			if !seen[chainId] {
+				seen[chainId] = true
+				chains = append(chains, chainId)
+			}
+		}
+		statuses[[2]vaa.ChainID{vaa.ChainID(r.ChainId), vaa.ChainID(r.EmitterChainId)}] = r.Status
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprint(w, "Registered on \\ emitter")
+	for _, chainId := range chains {
+		fmt.Fprintf(w, "\t%s", chainId)
+	}
+	fmt.Fprintln(w)
+	for _, chainId := range chains {
+		fmt.Fprint(w, chainId)
+		for _, emitterChainId := range chains {
+			status, ok := statuses[[2]vaa.ChainID{chainId, emitterChainId}]
+			if !ok {
+				status = "-"
+			}
+			fmt.Fprintf(w, "\t%s", status)
+		}
+		fmt.Fprintln(w)
+	}
+	w.Flush()
+
+	var problems []*nodev1.TokenBridgeRegistration
+	for _, r := range resp.Registrations {
+		if r.Status != string(registrations.StatusOK) || r.Error != "" {
+			problems = append(problems, r)
+		}
+	}
+	if len(problems) == 0 {
+		return
+	}
+
+	fmt.Print("\n")
+	fmt.Fprintln(w, "Chain\tEmitter chain\tStatus\tExpected emitter\tRegistered emitter\tError")
+	for _, r := range problems {
+		registered := r.RegisteredEmitter
+		if registered == "" {
+			registered = "-"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			vaa.ChainID(r.ChainId), vaa.ChainID(r.EmitterChainId), r.Status, r.ExpectedEmitter, registered, r.Error)
+	}
+	w.Flush()
+}
+
+// printRegisterChainTemplates prints a token-bridge-register-chain template fixing the missing registrations, each
+// message targets the chain the registration is missing on.
+func printRegisterChainTemplates(registrationList []*nodev1.TokenBridgeRegistration, guardianSetIndex uint32) {
+	m := &nodev1.InjectGovernanceVAARequest{CurrentSetIndex: guardianSetIndex}
+	for _, r := range registrationList {
+		switch registrations.Status(r.Status) {
+		case registrations.StatusMissing:
+			m.Messages = append(m.Messages, &nodev1.GovernanceMessage{
+				Sequence:      rand.Uint64(),
+				Nonce:         rand.Uint32(),
+				TargetChainId: r.ChainId,
+				Payload: &nodev1.GovernanceMessage_BridgeRegisterChain{
+					BridgeRegisterChain: &nodev1.BridgeRegisterChain{
+						Module:         "TokenBridge",
+						ChainId:        r.EmitterChainId,
+						EmitterAddress: r.ExpectedEmitter,
+					},
+				},
+			})
+		case registrations.StatusWrong:
+			// The token bridges reject the registration of an already registered chain.
+			fmt.Fprintf(os.Stderr, "%s has the wrong emitter %s registered for %s, it can't be fixed by a chain registration\n",
+				vaa.ChainID(r.ChainId), r.RegisteredEmitter, vaa.ChainID(r.EmitterChainId))
+		}
+	}
+	if len(m.Messages) == 0 {
+		fmt.Fprintln(os.Stderr, "no missing registrations")
+		return
+	}
+	marshalTemplate(m)
+}
@@ -15,14 +15,21 @@ import (
 	"time"
 
 	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/governor"
+	"github.com/alephium/wormhole-fork/node/pkg/govtracker"
+	"github.com/alephium/wormhole-fork/node/pkg/gsmonitor"
+	"github.com/alephium/wormhole-fork/node/pkg/pause"
+	"github.com/alephium/wormhole-fork/node/pkg/policy"
 	gossipv1 "github.com/alephium/wormhole-fork/node/pkg/proto/gossip/v1"
 	publicrpcv1 "github.com/alephium/wormhole-fork/node/pkg/proto/publicrpc/v1"
 	"github.com/alephium/wormhole-fork/node/pkg/publicrpc"
+	"github.com/alephium/wormhole-fork/node/pkg/registrations"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/alephium/wormhole-fork/node/pkg/accountant"
 	"github.com/alephium/wormhole-fork/node/pkg/common"
 	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
 	"github.com/alephium/wormhole-fork/node/pkg/supervisor"
@@ -36,9 +43,18 @@ type nodePrivilegedService struct {
 	obsvReqSendC chan *gossipv1.ObservationRequest
 	logger       *zap.Logger
 	signedInC    chan *gossipv1.SignedVAAWithQuorum
+	gst          *common.GuardianSetState
 
 	governanceChainId        vaa.ChainID
 	governanceEmitterAddress vaa.Address
+
+	governor      *governor.ChainGovernor
+	accountant    *accountant.Accountant
+	pauses        *pause.Controller
+	policy        *policy.Policy
+	gsMonitor     *gsmonitor.Monitor
+	registrations *registrations.Checker
+	govTracker    *govtracker.Tracker
 }
 
 // adminGuardianSetUpgradeToVAA converts a nodev1.GuardianSetUpgrade message to its canonical VAA representation.
@@ -290,6 +306,45 @@ func tokenBridgeUpdateRefundAddress(
 	return v, nil
 }
 
+// governanceMessageToVAA converts a nodev1.GovernanceMessage of a governance template to its canonical VAA
+// representation. Returns an error if the data is invalid.
+func governanceMessageToVAA(
+	governanceChainId vaa.ChainID,
+	governanceEmitterAddress vaa.Address,
+	message *nodev1.GovernanceMessage,
+	timestamp time.Time,
+	guardianSetIndex uint32,
+) (*vaa.VAA, error) {
+	if message.TargetChainId > math.MaxUint16 {
+		return nil, fmt.Errorf("invalid target chain id: %d", message.TargetChainId)
+	}
+	targetChainId := vaa.ChainID(message.TargetChainId)
+	switch payload := message.Payload.(type) {
+	case *nodev1.GovernanceMessage_UpdateMessageFee:
+		return adminUpdateMessageFeeToVAA(governanceChainId, governanceEmitterAddress, payload.UpdateMessageFee, timestamp, guardianSetIndex, message.Nonce, message.Sequence, targetChainId)
+	case *nodev1.GovernanceMessage_TransferFee:
+		return adminTransferFeeToVAA(governanceChainId, governanceEmitterAddress, payload.TransferFee, timestamp, guardianSetIndex, message.Nonce, message.Sequence, targetChainId)
+	case *nodev1.GovernanceMessage_GuardianSet:
+		return adminGuardianSetUpgradeToVAA(governanceChainId, governanceEmitterAddress, payload.GuardianSet, timestamp, guardianSetIndex, message.Nonce, message.Sequence, targetChainId)
+	case *nodev1.GovernanceMessage_ContractUpgrade:
+		return adminContractUpgradeToVAA(governanceChainId, governanceEmitterAddress, payload.ContractUpgrade, timestamp, guardianSetIndex, message.Nonce, message.Sequence, targetChainId)
+	case *nodev1.GovernanceMessage_BridgeRegisterChain:
+		return tokenBridgeRegisterChain(governanceChainId, governanceEmitterAddress, payload.BridgeRegisterChain, timestamp, guardianSetIndex, message.Nonce, message.Sequence, targetChainId)
+	case *nodev1.GovernanceMessage_BridgeContractUpgrade:
+		return tokenBridgeUpgradeContract(governanceChainId, governanceEmitterAddress, payload.BridgeContractUpgrade, timestamp, guardianSetIndex, message.Nonce, message.Sequence, targetChainId)
+	case *nodev1.GovernanceMessage_DestroyUnexecutedSequenceContracts:
+		return tokenBridgeDestroyUnexecutedSequenceContracts(governanceChainId, governanceEmitterAddress, payload.DestroyUnexecutedSequenceContracts, timestamp, guardianSetIndex, message.Nonce, message.Sequence, targetChainId)
+	case *nodev1.GovernanceMessage_UpdateMinimalConsistencyLevel:
+		return tokenBridgeUpdateMinimalConsistencyLevel(governanceChainId, governanceEmitterAddress, payload.UpdateMinimalConsistencyLevel, timestamp, guardianSetIndex, message.Nonce, message.Sequence, targetChainId)
+	case *nodev1.GovernanceMessage_UpdateRefundAddress:
+		return tokenBridgeUpdateRefundAddress(governanceChainId, governanceEmitterAddress, payload.UpdateRefundAddress, timestamp, guardianSetIndex, message.Nonce, message.Sequence, targetChainId)
+	case nil:
+		return nil, errors.New("missing governance message payload")
+	default:
+		return nil, fmt.Errorf("unsupported governance message type: %T", payload)
+	}
+}
+
 func (s *nodePrivilegedService) InjectGovernanceVAA(ctx context.Context, req *nodev1.InjectGovernanceVAARequest) (*nodev1.InjectGovernanceVAAResponse, error) {
 	s.logger.Info("governance VAA injected via admin socket", zap.String("request", req.String()))
 
@@ -303,32 +358,7 @@ func (s *nodePrivilegedService) InjectGovernanceVAA(ctx context.Context, req *no
 	digests := make([][]byte, len(req.Messages))
 
 	for i, message := range req.Messages {
-		if message.TargetChainId > math.MaxUint16 {
-			return nil, fmt.Errorf("invalid target chain id: %d", message.TargetChainId)
-		}
-		targetChainId := vaa.ChainID(message.TargetChainId)
-		switch payload := message.Payload.(type) {
-		case *nodev1.GovernanceMessage_UpdateMessageFee:
-			v, err = adminUpdateMessageFeeToVAA(s.governanceChainId, s.governanceEmitterAddress, payload.UpdateMessageFee, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_TransferFee:
-			v, err = adminTransferFeeToVAA(s.governanceChainId, s.governanceEmitterAddress, payload.TransferFee, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_GuardianSet:
-			v, err = adminGuardianSetUpgradeToVAA(s.governanceChainId, s.governanceEmitterAddress, payload.GuardianSet, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_ContractUpgrade:
-			v, err = adminContractUpgradeToVAA(s.governanceChainId, s.governanceEmitterAddress, payload.ContractUpgrade, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_BridgeRegisterChain:
-			v, err = tokenBridgeRegisterChain(s.governanceChainId, s.governanceEmitterAddress, payload.BridgeRegisterChain, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_BridgeContractUpgrade:
-			v, err = tokenBridgeUpgradeContract(s.governanceChainId, s.governanceEmitterAddress, payload.BridgeContractUpgrade, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_DestroyUnexecutedSequenceContracts:
-			v, err = tokenBridgeDestroyUnexecutedSequenceContracts(s.governanceChainId, s.governanceEmitterAddress, payload.DestroyUnexecutedSequenceContracts, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_UpdateMinimalConsistencyLevel:
-			v, err = tokenBridgeUpdateMinimalConsistencyLevel(s.governanceChainId, s.governanceEmitterAddress, payload.UpdateMinimalConsistencyLevel, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_UpdateRefundAddress:
-			v, err = tokenBridgeUpdateRefundAddress(s.governanceChainId, s.governanceEmitterAddress, payload.UpdateRefundAddress, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		default:
-			panic(fmt.Sprintf("unsupported VAA type: %T", payload))
-		}
+		v, err = governanceMessageToVAA(s.governanceChainId, s.governanceEmitterAddress, message, timestamp, req.CurrentSetIndex)
 		if err != nil {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
@@ -503,6 +533,13 @@ func adminServiceRunnable(
 	gst *common.GuardianSetState,
 	governanceChainId vaa.ChainID,
 	governanceEmitterAddress vaa.Address,
+	governor *governor.ChainGovernor,
+	accountant *accountant.Accountant,
+	pauses *pause.Controller,
+	policy *policy.Policy,
+	gsMonitor *gsmonitor.Monitor,
+	registrationChecker *registrations.Checker,
+	govTracker *govtracker.Tracker,
 ) (supervisor.Runnable, error) {
 	// Delete existing UNIX socket, if present.
 	fi, err := os.Stat(socketPath)
@@ -541,12 +578,21 @@ func adminServiceRunnable(
 		db:           db,
 		logger:       logger.Named("adminservice"),
 		signedInC:    signedInC,
+		gst:          gst,
 
 		governanceChainId:        governanceChainId,
 		governanceEmitterAddress: governanceEmitterAddress,
+
+		governor:      governor,
+		accountant:    accountant,
+		pauses:        pauses,
+		policy:        policy,
+		gsMonitor:     gsMonitor,
+		registrations: registrationChecker,
+		govTracker:    govTracker,
 	}
 
-	publicrpcService := publicrpc.NewPublicrpcServer(logger, db, gst, governanceChainId, governanceEmitterAddress)
+	publicrpcService := publicrpc.NewPublicrpcServer(logger, db, gst, governanceChainId, governanceEmitterAddress, nil)
 
 	grpcServer := common.NewInstrumentedGRPCServer(logger)
 	nodev1.RegisterNodePrivilegedServiceServer(grpcServer, nodeService)
@@ -562,3 +608,282 @@ func (s *nodePrivilegedService) SendObservationRequest(ctx context.Context, req
 	s.logger.Info("sent observation request", zap.Any("request", req.ObservationRequest))
 	return &nodev1.SendObservationRequestResponse{}, nil
 }
+
+func (s *nodePrivilegedService) GovernorGetStatus(ctx context.Context, req *nodev1.GovernorGetStatusRequest) (*nodev1.GovernorGetStatusResponse, error) {
+	if s.governor == nil {
+		return nil, status.Error(codes.Unavailable, "chain governor is not enabled")
+	}
+
+	chains := make([]*nodev1.GovernorChainStatus, 0)
+	for _, c := range s.governor.GetChainStatus() {
+		chains = append(chains, &nodev1.GovernorChainStatus{
+			EmitterChain:       uint32(c.EmitterChain),
+			DailyLimit:         c.DailyLimit,
+			BigTransactionSize: c.BigTransactionSize,
+			NotionalUsage:      c.NotionalUsage,
+			PendingCount:       uint32(c.PendingCount),
+		})
+	}
+
+	pending := make([]*nodev1.GovernorPendingTransfer, 0)
+	for _, p := range s.governor.GetPendingTransfers() {
+		pending = append(pending, &nodev1.GovernorPendingTransfer{
+			MessageId:    p.MsgID,
+			EmitterChain: uint32(p.EmitterChain),
+			TargetChain:  uint32(p.TargetChain),
+			TxHash:       p.TxHash,
+			Value:        p.Value,
+			ReleaseTime:  uint32(p.ReleaseTime.Unix()),
+		})
+	}
+
+	return &nodev1.GovernorGetStatusResponse{
+		Chains:           chains,
+		PendingTransfers: pending,
+	}, nil
+}
+
+func (s *nodePrivilegedService) GovernorReleasePendingTransfer(ctx context.Context, req *nodev1.GovernorReleasePendingTransferRequest) (*nodev1.GovernorReleasePendingTransferResponse, error) {
+	if s.governor == nil {
+		return nil, status.Error(codes.Unavailable, "chain governor is not enabled")
+	}
+
+	if err := s.governor.ReleasePendingTransfer(req.MessageId); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	s.logger.Info("released pending transfer", zap.String("messageId", req.MessageId))
+	return &nodev1.GovernorReleasePendingTransferResponse{}, nil
+}
+
+func (s *nodePrivilegedService) GovernorDropPendingTransfer(ctx context.Context, req *nodev1.GovernorDropPendingTransferRequest) (*nodev1.GovernorDropPendingTransferResponse, error) {
+	if s.governor == nil {
+		return nil, status.Error(codes.Unavailable, "chain governor is not enabled")
+	}
+
+	if err := s.governor.DropPendingTransfer(req.MessageId); err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	s.logger.Info("dropped pending transfer", zap.String("messageId", req.MessageId))
+	return &nodev1.GovernorDropPendingTransferResponse{}, nil
+}
+
+func (s *nodePrivilegedService) GetEquivocationEvidence(ctx context.Context, req *nodev1.GetEquivocationEvidenceRequest) (*nodev1.GetEquivocationEvidenceResponse, error) {
+	stored, err := s.db.GetEvidence()
+	if err != nil {
+		s.logger.Error("failed to get evidence", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get evidence")
+	}
+
+	resp := &nodev1.GetEquivocationEvidenceResponse{
+		Evidence: make([]*nodev1.EquivocationEvidence, 0, len(stored)),
+	}
+	for _, e := range stored {
+		resp.Evidence = append(resp.Evidence, &nodev1.EquivocationEvidence{
+			MessageId:          e.MessageID,
+			GuardianAddr:       e.Guardian,
+			DetectedAt:         e.DetectedAt.Unix(),
+			SignedVaas:         e.VAAs,
+			SignedObservations: e.Observations,
+		})
+	}
+	return resp, nil
+}
+
+func (s *nodePrivilegedService) AccountantGetBalances(ctx context.Context, req *nodev1.AccountantGetBalancesRequest) (*nodev1.AccountantGetBalancesResponse, error) {
+	if s.accountant == nil {
+		return nil, status.Error(codes.Unavailable, "token accountant is not enabled")
+	}
+
+	balances := make([]*nodev1.AccountantBalance, 0)
+	for _, b := range s.accountant.GetBalances() {
+		balances = append(balances, &nodev1.AccountantBalance{
+			OriginChain:   uint32(b.OriginChain),
+			OriginAddress: b.OriginAddress.String(),
+			Chain:         uint32(b.Chain),
+			Amount:        b.Amount.String(),
+		})
+	}
+	return &nodev1.AccountantGetBalancesResponse{Balances: balances}, nil
+}
+
+func (s *nodePrivilegedService) AccountantRebuild(ctx context.Context, req *nodev1.AccountantRebuildRequest) (*nodev1.AccountantRebuildResponse, error) {
+	if s.accountant == nil {
+		return nil, status.Error(codes.Unavailable, "token accountant is not enabled")
+	}
+
+	count, err := s.accountant.Rebuild()
+	if err != nil {
+		s.logger.Error("failed to rebuild accountant ledger", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to rebuild accountant ledger")
+	}
+	s.logger.Info("rebuilt accountant ledger", zap.Int("vaas", count))
+	return &nodev1.AccountantRebuildResponse{NumVaas: uint32(count)}, nil
+}
+
+func (s *nodePrivilegedService) PauseSigning(ctx context.Context, req *nodev1.PauseSigningRequest) (*nodev1.PauseSigningResponse, error) {
+	p, err := pause.FromProto(req.Pause)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.pauses.Pause(p); err != nil {
+		s.logger.Error("failed to pause signing", zap.String("pause", p.ID()), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to pause signing")
+	}
+	return &nodev1.PauseSigningResponse{}, nil
+}
+
+func (s *nodePrivilegedService) ResumeSigning(ctx context.Context, req *nodev1.ResumeSigningRequest) (*nodev1.ResumeSigningResponse, error) {
+	p, err := pause.FromProto(req.Pause)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.pauses.Resume(p); err != nil {
+		if err == pause.ErrNotPaused {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		s.logger.Error("failed to resume signing", zap.String("pause", p.ID()), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to resume signing")
+	}
+	return &nodev1.ResumeSigningResponse{}, nil
+}
+
+func (s *nodePrivilegedService) ListSigningPauses(ctx context.Context, req *nodev1.ListSigningPausesRequest) (*nodev1.ListSigningPausesResponse, error) {
+	pauses := make([]*gossipv1.SigningPause, 0)
+	for _, p := range s.pauses.List() {
+		pauses = append(pauses, pause.ToProto(p))
+	}
+	return &nodev1.ListSigningPausesResponse{Pauses: pauses}, nil
+}
+
+func (s *nodePrivilegedService) ListSigningPolicyRejections(ctx context.Context, req *nodev1.ListSigningPolicyRejectionsRequest) (*nodev1.ListSigningPolicyRejectionsResponse, error) {
+	if s.policy == nil {
+		return nil, status.Error(codes.Unavailable, "signing policy is not enabled")
+	}
+
+	rejections := make([]*nodev1.SigningPolicyRejection, 0)
+	for _, r := range s.policy.Rejections() {
+		rejections = append(rejections, &nodev1.SigningPolicyRejection{
+			MessageId:  r.MessageID,
+			TxHash:     r.TxHash,
+			Reason:     r.Reason,
+			RejectedAt: r.RejectedAt.Unix(),
+		})
+	}
+	return &nodev1.ListSigningPolicyRejectionsResponse{Rejections: rejections}, nil
+}
+
+func (s *nodePrivilegedService) GetGuardianSetTransition(ctx context.Context, req *nodev1.GetGuardianSetTransitionRequest) (*nodev1.GetGuardianSetTransitionResponse, error) {
+	current := s.gst.Get()
+	if current == nil {
+		return nil, status.Error(codes.Unavailable, "guardian set not initialized yet")
+	}
+
+	resp := &nodev1.GetGuardianSetTransitionResponse{
+		Current: &nodev1.GuardianSetInfo{Index: current.Index, Addresses: current.KeysAsHexStrings()},
+	}
+	if previous, expiry := s.gst.GetPrevious(); previous != nil {
+		resp.Previous = &nodev1.GuardianSetInfo{Index: previous.Index, Addresses: previous.KeysAsHexStrings()}
+		resp.PreviousExpiry = expiry.Unix()
+	}
+	return resp, nil
+}
+
+func (s *nodePrivilegedService) GetGuardianSetConsistency(ctx context.Context, req *nodev1.GetGuardianSetConsistencyRequest) (*nodev1.GetGuardianSetConsistencyResponse, error) {
+	if s.gsMonitor == nil {
+		return nil, status.Error(codes.Unavailable, "guardian set monitor is not enabled")
+	}
+
+	chains := make([]*nodev1.ChainGuardianSet, 0)
+	for _, st := range s.gsMonitor.Statuses() {
+		chain := &nodev1.ChainGuardianSet{
+			ChainId:  uint32(st.ChainID),
+			Error:    st.Error,
+			Mismatch: st.Mismatch,
+		}
+		if st.GuardianSet != nil {
+			chain.GuardianSet = &nodev1.GuardianSetInfo{Index: st.GuardianSet.Index, Addresses: st.GuardianSet.KeysAsHexStrings()}
+		}
+		if !st.CheckedAt.IsZero() {
+			chain.CheckedAt = st.CheckedAt.Unix()
+		}
+		chains = append(chains, chain)
+	}
+	return &nodev1.GetGuardianSetConsistencyResponse{Chains: chains}, nil
+}
+
+func (s *nodePrivilegedService) GetTokenBridgeRegistrations(ctx context.Context, req *nodev1.GetTokenBridgeRegistrationsRequest) (*nodev1.GetTokenBridgeRegistrationsResponse, error) {
+	if s.registrations == nil {
+		return nil, status.Error(codes.Unavailable, "token bridge registration checker is not enabled")
+	}
+
+	registrations, checkedAt := s.registrations.Registrations()
+	if checkedAt.IsZero() {
+		// The periodic check is disabled or hasn't completed yet.
+		registrations, checkedAt = s.registrations.Check(ctx)
+	}
+	resp := &nodev1.GetTokenBridgeRegistrationsResponse{Registrations: make([]*nodev1.TokenBridgeRegistration, 0, len(registrations))}
+	for _, r := range registrations {
+		registration := &nodev1.TokenBridgeRegistration{
+			ChainId:         uint32(r.ChainID),
+			EmitterChainId:  uint32(r.EmitterChainID),
+			ExpectedEmitter: r.Expected.String(),
+			Status:          string(r.Status),
+			Error:           r.Error,
+		}
+		if r.Registered != nil {
+			registration.RegisteredEmitter = r.Registered.String()
+		}
+		resp.Registrations = append(resp.Registrations, registration)
+	}
+	if !checkedAt.IsZero() {
+		resp.CheckedAt = checkedAt.Unix()
+	}
+	return resp, nil
+}
+
+func (s *nodePrivilegedService) GetGovernanceStatus(ctx context.Context, req *nodev1.GetGovernanceStatusRequest) (*nodev1.GetGovernanceStatusResponse, error) {
+	if s.govTracker == nil {
+		return nil, status.Error(codes.Unavailable, "governance tracker is not enabled")
+	}
+
+	chains, vaas := s.govTracker.Statuses()
+	resp := &nodev1.GetGovernanceStatusResponse{
+		Chains: make([]*nodev1.ChainGovernanceStatus, 0, len(chains)),
+		Vaas:   make([]*nodev1.GovernanceVAAStatus, 0, len(vaas)),
+	}
+	for _, c := range chains {
+		chain := &nodev1.ChainGovernanceStatus{ChainId: uint32(c.ChainID), Error: c.Error}
+		if c.State != nil {
+			chain.State = &nodev1.GovernanceContractState{
+				GuardianSetIndex:    c.State.GuardianSetIndex,
+				MessageFee:          c.State.MessageFee.String(),
+				CoreSequence:        c.State.CoreSequence,
+				TokenBridgeSequence: c.State.TokenBridgeSequence,
+			}
+		}
+		if !c.CheckedAt.IsZero() {
+			chain.CheckedAt = c.CheckedAt.Unix()
+		}
+		resp.Chains = append(resp.Chains, chain)
+	}
+	for _, v := range vaas {
+		executions := make([]*nodev1.GovernanceVAAExecution, 0, len(v.Executions))
+		for _, e := range v.Executions {
+			executions = append(executions, &nodev1.GovernanceVAAExecution{
+				ChainId:  uint32(e.ChainID),
+				Executed: e.Executed,
+				Error:    e.Error,
+			})
+		}
+		resp.Vaas = append(resp.Vaas, &nodev1.GovernanceVAAStatus{
+			Sequence:      v.Sequence,
+			TargetChainId: uint32(v.TargetChain),
+			Digest:        v.Digest,
+			Action:        v.Action,
+			Executions:    executions,
+		})
+	}
+	return resp, nil
+}
@@ -437,7 +437,7 @@ func runShutdownProofTemplate(cmd *cobra.Command, args []string) {
 		guardianKey, keyErr = crypto.HexToECDSA(*shutdownGuardianKey)
 	} else {
 		// the supplied guardian key is not hex, must be a file path to load
-		guardianKey, keyErr = loadGuardianKey(*shutdownGuardianKey)
+		guardianKey, keyErr = loadGuardianKey(*shutdownGuardianKey, guardianKeyPassphrase(-1, "Enter guardian key passphrase: "))
 	}
 	if keyErr != nil {
 		log.Fatal("failed fetching guardian key.", keyErr)
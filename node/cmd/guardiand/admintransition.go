@@ -0,0 +1,44 @@
+package guardiand
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
+	"github.com/spf13/cobra"
+)
+
+var AdminClientGuardianSetTransitionCmd = &cobra.Command{
+	Use:   "guardian-set-transition",
+	Short: "Shows the current guardian set and the previous one while it can still sign VAAs",
+	Run:   runGuardianSetTransition,
+	Args:  cobra.NoArgs,
+}
+
+func runGuardianSetTransition(cmd *cobra.Command, args []string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err, c := getAdminClient(ctx, *clientSocketPath)
+	defer conn.Close()
+	if err != nil {
+		log.Fatalf("failed to get admin client: %v", err)
+	}
+
+	resp, err := c.GetGuardianSetTransition(ctx, &nodev1.GetGuardianSetTransitionRequest{})
+	if err != nil {
+		log.Fatalf("failed to get guardian set transition: %v", err)
+	}
+
+	fmt.Printf("Current guardian set: %d (%s)\n", resp.Current.Index, strings.Join(resp.Current.Addresses, ", "))
+	if resp.Previous == nil {
+		fmt.Println("No guardian set transition in progress")
+		return
+	}
+	expiry := time.Unix(resp.PreviousExpiry, 0)
+	fmt.Printf("Previous guardian set: %d (%s)\n", resp.Previous.Index, strings.Join(resp.Previous.Addresses, ", "))
+	fmt.Printf("Previous guardian set expires at %s (in %s)\n", expiry.Format(time.RFC3339), time.Until(expiry).Round(time.Second))
+}
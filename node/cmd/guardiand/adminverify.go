@@ -2,10 +2,8 @@ package guardiand
 
 import (
 	"encoding/hex"
-	"fmt"
 	"io/ioutil"
 	"log"
-	"math"
 	"time"
 
 	"github.com/alephium/wormhole-fork/node/pkg/common"
@@ -54,35 +52,7 @@ func runGovernanceVAAVerify(cmd *cobra.Command, args []string) {
 	timestamp := time.Unix(int64(req.Timestamp), 0)
 
 	for _, message := range req.Messages {
-		var (
-			v *vaa.VAA
-		)
-		if message.TargetChainId > math.MaxUint16 {
-			panic(fmt.Sprintf("invalid target chain id: %d", message.TargetChainId))
-		}
-		targetChainId := vaa.ChainID(message.TargetChainId)
-		switch payload := message.Payload.(type) {
-		case *nodev1.GovernanceMessage_UpdateMessageFee:
-			v, err = adminUpdateMessageFeeToVAA(governanceChainId, governanceEmitterAddress, payload.UpdateMessageFee, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_TransferFee:
-			v, err = adminTransferFeeToVAA(governanceChainId, governanceEmitterAddress, payload.TransferFee, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_GuardianSet:
-			v, err = adminGuardianSetUpgradeToVAA(governanceChainId, governanceEmitterAddress, payload.GuardianSet, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_ContractUpgrade:
-			v, err = adminContractUpgradeToVAA(governanceChainId, governanceEmitterAddress, payload.ContractUpgrade, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_BridgeRegisterChain:
-			v, err = tokenBridgeRegisterChain(governanceChainId, governanceEmitterAddress, payload.BridgeRegisterChain, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_BridgeContractUpgrade:
-			v, err = tokenBridgeUpgradeContract(governanceChainId, governanceEmitterAddress, payload.BridgeContractUpgrade, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_DestroyUnexecutedSequenceContracts:
-			v, err = tokenBridgeDestroyUnexecutedSequenceContracts(governanceChainId, governanceEmitterAddress, payload.DestroyUnexecutedSequenceContracts, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_UpdateMinimalConsistencyLevel:
-			v, err = tokenBridgeUpdateMinimalConsistencyLevel(governanceChainId, governanceEmitterAddress, payload.UpdateMinimalConsistencyLevel, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		case *nodev1.GovernanceMessage_UpdateRefundAddress:
-			v, err = tokenBridgeUpdateRefundAddress(governanceChainId, governanceEmitterAddress, payload.UpdateRefundAddress, timestamp, req.CurrentSetIndex, message.Nonce, message.Sequence, targetChainId)
-		default:
-			panic(fmt.Sprintf("unsupported VAA type: %T", payload))
-		}
+		v, err := governanceMessageToVAA(governanceChainId, governanceEmitterAddress, message, timestamp, req.CurrentSetIndex)
 		if err != nil {
 			log.Fatalf("invalid update: %v", err)
 		}
@@ -100,5 +70,11 @@ func runGovernanceVAAVerify(cmd *cobra.Command, args []string) {
 		log.Printf("Serialized: %v", hex.EncodeToString(b))
 
 		log.Printf("VAA with digest %s: %+v", digest.Hex(), spew.Sdump(v))
+
+		payload, err := vaa.DecodePayload(v.Payload)
+		if err != nil {
+			log.Fatalf("failed to decode governance payload: %v", err)
+		}
+		log.Printf("Decoded payload: %s", spew.Sdump(payload))
 	}
 }
@@ -8,26 +8,53 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
 
 	"github.com/alephium/wormhole-fork/node/pkg/common"
 
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 	"golang.org/x/crypto/openpgp/armor" //nolint
+	"golang.org/x/crypto/scrypt"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/alephium/wormhole-fork/node/pkg/devnet"
 	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
 )
 
-var keyDescription *string
+var (
+	keyDescription  *string
+	keyEncrypt      *bool
+	keyPassphraseFd *int
+)
+
+const (
+	GuardianKeyArmoredBlock          = "WORMHOLE GUARDIAN PRIVATE KEY"
+	EncryptedGuardianKeyArmoredBlock = "WORMHOLE GUARDIAN ENCRYPTED PRIVATE KEY"
+)
+
+// Cost parameters of the scrypt key derivation function for new encrypted keys, which uses 128 MiB of memory.
+var (
+	guardianKeyScryptN uint32 = 1 << 17
+	guardianKeyScryptR uint32 = 8
+	guardianKeyScryptP uint32 = 1
+)
 
+// Bounds of the scrypt cost parameters of encrypted keys, so that a crafted key file can't exhaust the memory or the
+// CPU of the node: at most 1 GiB of memory, mixed at most 16 times.
 const (
-	GuardianKeyArmoredBlock = "WORMHOLE GUARDIAN PRIVATE KEY"
+	maxGuardianKeyScryptMemory = 1 << 30
+	maxGuardianKeyScryptP      = 16
 )
 
 func init() {
 	keyDescription = KeygenCmd.Flags().String("desc", "", "Human-readable key description (optional)")
+	keyEncrypt = KeygenCmd.Flags().Bool("encrypt", false, "Encrypt the key with a passphrase")
+	keyPassphraseFd = KeygenCmd.Flags().Int("passphraseFd", -1, "File descriptor to read the passphrase from (defaults to $"+GuardianKeyPassphraseEnv+" or the terminal)")
+
+	changePassphraseFd = ChangePassphraseCmd.Flags().Int("passphraseFd", -1, "File descriptor to read the current passphrase from, if the key is encrypted (defaults to the terminal)")
+	newPassphraseFd = ChangePassphraseCmd.Flags().Int("newPassphraseFd", -1, "File descriptor to read the new passphrase from (defaults to the terminal)")
+	KeyCmd.AddCommand(ChangePassphraseCmd)
 }
 
 var KeygenCmd = &cobra.Command{
@@ -48,37 +75,104 @@ func runKeygen(cmd *cobra.Command, args []string) {
 		log.Fatalf("failed to generate key: %v", err)
 	}
 
-	err = writeGuardianKey(gk, *keyDescription, args[0], false)
+	var passphrase []byte
+	if *keyEncrypt {
+		passphrase, err = newGuardianKeyPassphrase(*keyPassphraseFd, "Enter passphrase: ")()
+		if err != nil {
+			log.Fatalf("failed to read passphrase: %v", err)
+		}
+	}
+
+	err = writeGuardianKey(gk, *keyDescription, args[0], false, passphrase)
 	if err != nil {
 		log.Fatalf("failed to write key: %v", err)
 	}
 }
 
-// loadGuardianKey loads a serialized guardian key from disk.
-func loadGuardianKey(filename string) (*ecdsa.PrivateKey, error) {
-	f, err := os.Open(filename)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open file: %w", err)
+var (
+	changePassphraseFd *int
+	newPassphraseFd    *int
+)
+
+var KeyCmd = &cobra.Command{
+	Use:   "key",
+	Short: "Guardian key management",
+}
+
+var ChangePassphraseCmd = &cobra.Command{
+	Use:   "change-passphrase [KEYFILE]",
+	Short: "Change the passphrase of a guardian key, or encrypt an unencrypted key",
+	Run:   runChangePassphrase,
+	Args:  cobra.ExactArgs(1),
+}
+
+func runChangePassphrase(cmd *cobra.Command, args []string) {
+	common.LockMemory()
+	common.SetRestrictiveUmask()
+
+	// The current passphrase is never read from the environment, which is reserved for the new one.
+	current := func() ([]byte, error) { return readPassphraseTerminal("Enter current passphrase: ") }
+	if *changePassphraseFd >= 0 {
+		current = func() ([]byte, error) { return readPassphraseFd(*changePassphraseFd) }
 	}
+	if err := changeGuardianKeyPassphrase(args[0], current, newGuardianKeyPassphrase(*newPassphraseFd, "Enter new passphrase: ")); err != nil {
+		log.Fatalf("failed to change passphrase: %v", err)
+	}
+	log.Print("Changed passphrase of ", args[0])
+}
 
-	p, err := armor.Decode(f)
+// changeGuardianKeyPassphrase encrypts the guardian key at filename with a new passphrase. The key is written to a
+// temporary file first, which then replaces the original one.
+func changeGuardianKeyPassphrase(filename string, current passphraseFunc, next passphraseFunc) error {
+	m, description, err := readGuardianKey(filename, current)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read armored file: %w", err)
+		return err
+	}
+	if m.UnsafeDeterministicKey {
+		return errors.New("refusing to encrypt a deterministic key")
+	}
+	gk, err := ethcrypto.ToECDSA(m.Data)
+	if err != nil {
+		return fmt.Errorf("failed to deserialize raw key data: %w", err)
+	}
+	passphrase, err := next()
+	if err != nil {
+		return fmt.Errorf("failed to read new passphrase: %w", err)
 	}
 
-	if p.Type != GuardianKeyArmoredBlock {
-		return nil, fmt.Errorf("invalid block type: %s", p.Type)
+	// A temporary file left by an interrupted change is incomplete, the original key is still in place.
+	tmp := filename + ".tmp"
+	if err := os.Remove(tmp); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove stale temporary key: %w", err)
 	}
+	if err := writeGuardianKey(gk, description, tmp, false, passphrase); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, filename); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf("failed to replace key: %w", err)
+	}
+	return syncDir(filepath.Dir(filename))
+}
 
-	b, err := ioutil.ReadAll(p.Body)
+// syncDir flushes the entries of a directory to disk, so that a renamed file survives a crash.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read file: %w", err)
+		return fmt.Errorf("failed to open directory: %w", err)
+	}
+	defer d.Close()
+	if err := d.Sync(); err != nil {
+		return fmt.Errorf("failed to sync directory: %w", err)
 	}
+	return nil
+}
 
-	var m nodev1.GuardianKey
-	err = proto.Unmarshal(b, &m)
+// loadGuardianKey loads a serialized guardian key from disk. passphrase is only called if the key is encrypted.
+func loadGuardianKey(filename string, passphrase passphraseFunc) (*ecdsa.PrivateKey, error) {
+	m, _, err := readGuardianKey(filename, passphrase)
 	if err != nil {
-		return nil, fmt.Errorf("failed to deserialize protobuf: %w", err)
+		return nil, err
 	}
 
 	if *network != "devnet" && m.UnsafeDeterministicKey {
@@ -93,8 +187,61 @@ func loadGuardianKey(filename string) (*ecdsa.PrivateKey, error) {
 	return gk, nil
 }
 
-// writeGuardianKey serializes a guardian key and writes it to disk.
-func writeGuardianKey(key *ecdsa.PrivateKey, description string, filename string, unsafe bool) error {
+// readGuardianKey reads a plain or encrypted guardian key file, and returns the key and its description.
+func readGuardianKey(filename string, passphrase passphraseFunc) (*nodev1.GuardianKey, string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	p, err := armor.Decode(f)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read armored file: %w", err)
+	}
+
+	if p.Type != GuardianKeyArmoredBlock && p.Type != EncryptedGuardianKeyArmoredBlock {
+		return nil, "", fmt.Errorf("invalid block type: %s", p.Type)
+	}
+
+	b, err := ioutil.ReadAll(p.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read file: %w", err)
+	}
+
+	if p.Type == EncryptedGuardianKeyArmoredBlock {
+		var e nodev1.EncryptedGuardianKey
+		if err := proto.Unmarshal(b, &e); err != nil {
+			return nil, "", fmt.Errorf("failed to deserialize protobuf: %w", err)
+		}
+		if e.ScryptN == 0 || e.ScryptR == 0 || e.ScryptP == 0 || e.ScryptP > maxGuardianKeyScryptP ||
+			128*uint64(e.ScryptN)*uint64(e.ScryptR) > maxGuardianKeyScryptMemory {
+			return nil, "", fmt.Errorf("unsupported scrypt parameters N=%d r=%d p=%d", e.ScryptN, e.ScryptR, e.ScryptP)
+		}
+		pass, err := passphrase()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to read passphrase: %w", err)
+		}
+		key, err := scrypt.Key(pass, e.Salt, int(e.ScryptN), int(e.ScryptR), int(e.ScryptP), 32)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to derive key: %w", err)
+		}
+		if b, err = common.DecryptAESGCM(e.Ciphertext, key); err != nil {
+			return nil, "", errors.New("failed to decrypt guardian key, wrong passphrase?")
+		}
+	}
+
+	var m nodev1.GuardianKey
+	err = proto.Unmarshal(b, &m)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to deserialize protobuf: %w", err)
+	}
+
+	return &m, p.Header["Description"], nil
+}
+
+// writeGuardianKey serializes a guardian key and writes it to disk. The key is encrypted if passphrase is not empty.
+func writeGuardianKey(key *ecdsa.PrivateKey, description string, filename string, unsafe bool, passphrase []byte) error {
 	if _, err := os.Stat(filename); err == nil { // file exists
 		if unsafe {
 			return nil // skip to write because the key is deterministic in devnet
@@ -114,6 +261,14 @@ func writeGuardianKey(key *ecdsa.PrivateKey, description string, filename string
 		panic(err)
 	}
 
+	blockType := GuardianKeyArmoredBlock
+	if len(passphrase) != 0 {
+		blockType = EncryptedGuardianKeyArmoredBlock
+		if b, err = encryptGuardianKey(b, passphrase); err != nil {
+			return err
+		}
+	}
+
 	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE, 0600)
 	if err != nil {
 		return fmt.Errorf("failed to open file: %w", err)
@@ -125,7 +280,7 @@ func writeGuardianKey(key *ecdsa.PrivateKey, description string, filename string
 	if description != "" {
 		headers["Description"] = description
 	}
-	a, err := armor.Encode(f, GuardianKeyArmoredBlock, headers)
+	a, err := armor.Encode(f, blockType, headers)
 	if err != nil {
 		panic(err)
 	}
@@ -137,9 +292,38 @@ func writeGuardianKey(key *ecdsa.PrivateKey, description string, filename string
 	if err != nil {
 		return err
 	}
+	if err := f.Sync(); err != nil {
+		return fmt.Errorf("failed to sync file: %w", err)
+	}
 	return f.Close()
 }
 
+// encryptGuardianKey encrypts a serialized guardian key with a key derived from the passphrase, and returns the
+// serialized EncryptedGuardianKey.
+func encryptGuardianKey(b []byte, passphrase []byte) ([]byte, error) {
+	e := &nodev1.EncryptedGuardianKey{
+		Salt:    make([]byte, 32),
+		ScryptN: guardianKeyScryptN,
+		ScryptR: guardianKeyScryptR,
+		ScryptP: guardianKeyScryptP,
+	}
+	if _, err := rand.Read(e.Salt); err != nil {
+		return nil, fmt.Errorf("failed to read random data: %w", err)
+	}
+	key, err := scrypt.Key(passphrase, e.Salt, int(e.ScryptN), int(e.ScryptR), int(e.ScryptP), 32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+	if e.Ciphertext, err = common.EncryptAESGCM(b, key); err != nil {
+		return nil, err
+	}
+	b, err = proto.Marshal(e)
+	if err != nil {
+		panic(err)
+	}
+	return b, nil
+}
+
 // generateDevnetGuardianKey returns a deterministic testnet key.
 func generateDevnetGuardianKey() (*ecdsa.PrivateKey, error) {
 	// Figure out our devnet index
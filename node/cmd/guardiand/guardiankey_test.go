@@ -0,0 +1,132 @@
+package guardiand
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func staticPassphrase(pass string) passphraseFunc {
+	return func() ([]byte, error) { return []byte(pass), nil }
+}
+
+func failingPassphrase() ([]byte, error) {
+	return nil, errors.New("passphrase requested")
+}
+
+func useCheapScrypt(t *testing.T) {
+	n := guardianKeyScryptN
+	guardianKeyScryptN = 1 << 10
+	t.Cleanup(func() { guardianKeyScryptN = n })
+}
+
+func TestGuardianKeyUnencrypted(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "guardian.key")
+	gk, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	require.NoError(t, writeGuardianKey(gk, "test key", filename, false, nil))
+	// The passphrase isn't requested for unencrypted keys
+	loaded, err := loadGuardianKey(filename, failingPassphrase)
+	require.NoError(t, err)
+	assert.True(t, gk.Equal(loaded))
+
+	// Existing keys aren't overwritten
+	assert.Error(t, writeGuardianKey(gk, "test key", filename, false, nil))
+}
+
+func TestGuardianKeyEncrypted(t *testing.T) {
+	useCheapScrypt(t)
+	filename := filepath.Join(t.TempDir(), "guardian.key")
+	gk, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	require.NoError(t, writeGuardianKey(gk, "test key", filename, false, []byte("correct horse")))
+	content, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), EncryptedGuardianKeyArmoredBlock)
+
+	loaded, err := loadGuardianKey(filename, staticPassphrase("correct horse"))
+	require.NoError(t, err)
+	assert.True(t, gk.Equal(loaded))
+
+	_, err = loadGuardianKey(filename, staticPassphrase("battery staple"))
+	assert.Error(t, err)
+	_, err = loadGuardianKey(filename, failingPassphrase)
+	assert.Error(t, err)
+}
+
+func TestChangeGuardianKeyPassphrase(t *testing.T) {
+	useCheapScrypt(t)
+	filename := filepath.Join(t.TempDir(), "guardian.key")
+	gk, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	require.NoError(t, writeGuardianKey(gk, "test key", filename, false, nil))
+
+	// Encrypt an unencrypted key
+	require.NoError(t, changeGuardianKeyPassphrase(filename, failingPassphrase, staticPassphrase("first")))
+	assertGuardianKey(t, filename, gk, "test key", "first")
+
+	// Wrong current passphrase
+	assert.Error(t, changeGuardianKeyPassphrase(filename, staticPassphrase("wrong"), staticPassphrase("second")))
+	assertGuardianKey(t, filename, gk, "test key", "first")
+
+	require.NoError(t, changeGuardianKeyPassphrase(filename, staticPassphrase("first"), staticPassphrase("second")))
+	assertGuardianKey(t, filename, gk, "test key", "second")
+	_, err = os.Stat(filename + ".tmp")
+	assert.True(t, os.IsNotExist(err))
+
+	// A temporary key left by an interrupted change doesn't block later changes
+	require.NoError(t, os.WriteFile(filename+".tmp", []byte("partial"), 0600))
+	require.NoError(t, changeGuardianKeyPassphrase(filename, staticPassphrase("second"), staticPassphrase("third")))
+	assertGuardianKey(t, filename, gk, "test key", "third")
+}
+
+func TestGuardianKeyScryptBounds(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "guardian.key")
+	gk, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	// Excessive parameters are refused before the passphrase is requested
+	n, p := guardianKeyScryptN, guardianKeyScryptP
+	guardianKeyScryptN, guardianKeyScryptP = 1<<10, maxGuardianKeyScryptP+1
+	err = writeGuardianKey(gk, "test key", filename, false, []byte("correct horse"))
+	guardianKeyScryptN, guardianKeyScryptP = n, p
+	require.NoError(t, err)
+	_, err = loadGuardianKey(filename, failingPassphrase)
+	assert.ErrorContains(t, err, "unsupported scrypt parameters")
+}
+
+func TestGuardianKeyPassphraseSources(t *testing.T) {
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+	_, err = w.WriteString("from fd\n")
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	pass, err := guardianKeyPassphrase(int(r.Fd()), "")()
+	require.NoError(t, err)
+	assert.Equal(t, "from fd", string(pass))
+
+	t.Setenv(GuardianKeyPassphraseEnv, "from env")
+	pass, err = guardianKeyPassphrase(-1, "")()
+	require.NoError(t, err)
+	assert.Equal(t, "from env", string(pass))
+	// The variable is cleared once read
+	_, ok := os.LookupEnv(GuardianKeyPassphraseEnv)
+	assert.False(t, ok)
+}
+
+func assertGuardianKey(t *testing.T, filename string, gk *ecdsa.PrivateKey, description string, passphrase string) {
+	m, desc, err := readGuardianKey(filename, staticPassphrase(passphrase))
+	require.NoError(t, err)
+	assert.Equal(t, description, desc)
+	loaded, err := ethcrypto.ToECDSA(m.Data)
+	require.NoError(t, err)
+	assert.True(t, gk.Equal(loaded))
+}
@@ -0,0 +1,272 @@
+package guardiand
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/shamir"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/openpgp/armor" //nolint
+	"google.golang.org/protobuf/proto"
+)
+
+const GuardianKeyShareArmoredBlock = "WORMHOLE GUARDIAN PRIVATE KEY SHARE"
+
+var (
+	splitThreshold    *int
+	splitShares       *int
+	splitPassphraseFd *int
+
+	combineEncrypt      *bool
+	combinePassphraseFd *int
+)
+
+func init() {
+	splitThreshold = SplitKeyCmd.Flags().Int("threshold", 0, "Number of shares required to reconstruct the key (required)")
+	splitShares = SplitKeyCmd.Flags().Int("shares", 0, "Number of shares to create (required)")
+	splitPassphraseFd = SplitKeyCmd.Flags().Int("passphraseFd", -1, "File descriptor to read the passphrase from, if the key is encrypted (defaults to $"+GuardianKeyPassphraseEnv+" or the terminal)")
+	KeyCmd.AddCommand(SplitKeyCmd)
+
+	combineEncrypt = CombineKeyCmd.Flags().Bool("encrypt", false, "Encrypt the reconstructed key with a passphrase")
+	combinePassphraseFd = CombineKeyCmd.Flags().Int("passphraseFd", -1, "File descriptor to read the passphrase from (defaults to $"+GuardianKeyPassphraseEnv+" or the terminal)")
+	KeyCmd.AddCommand(CombineKeyCmd)
+}
+
+var SplitKeyCmd = &cobra.Command{
+	Use:   "split [KEYFILE] [OUTPUT_DIR]",
+	Short: "Split a guardian key into Shamir secret shares for backups",
+	Run:   runSplitKey,
+	Args:  cobra.ExactArgs(2),
+}
+
+var CombineKeyCmd = &cobra.Command{
+	Use:   "combine [KEYFILE] [SHARE...]",
+	Short: "Reconstruct a guardian key from its Shamir secret shares",
+	Run:   runCombineKey,
+	Args:  cobra.MinimumNArgs(3),
+}
+
+func runSplitKey(cmd *cobra.Command, args []string) {
+	common.LockMemory()
+	common.SetRestrictiveUmask()
+
+	m, description, err := readGuardianKey(args[0], guardianKeyPassphrase(*splitPassphraseFd, "Enter passphrase: "))
+	if err != nil {
+		log.Fatalf("failed to load key: %v", err)
+	}
+	shares, err := splitGuardianKey(m, *splitShares, *splitThreshold)
+	if err != nil {
+		log.Fatalf("failed to split key: %v", err)
+	}
+	for _, share := range shares {
+		filename := filepath.Join(args[1], fmt.Sprintf("share-%d-of-%d.key", share.Index, share.TotalShares))
+		if err := writeGuardianKeyShare(share, description, filename); err != nil {
+			log.Fatalf("failed to write share: %v", err)
+		}
+		log.Print("Wrote share ", filename)
+	}
+}
+
+func runCombineKey(cmd *cobra.Command, args []string) {
+	common.LockMemory()
+	common.SetRestrictiveUmask()
+
+	var shares []*nodev1.GuardianKeyShare
+	var description string
+	for _, filename := range args[1:] {
+		share, desc, err := readGuardianKeyShare(filename)
+		if err != nil {
+			log.Fatalf("failed to read share %s: %v", filename, err)
+		}
+		shares = append(shares, share)
+		description = desc
+	}
+	m, err := combineGuardianKeyShares(shares)
+	if err != nil {
+		log.Fatalf("failed to reconstruct key: %v", err)
+	}
+	gk, err := ethcrypto.ToECDSA(m.Data)
+	if err != nil {
+		log.Fatalf("failed to deserialize raw key data: %v", err)
+	}
+
+	var passphrase []byte
+	if *combineEncrypt {
+		passphrase, err = newGuardianKeyPassphrase(*combinePassphraseFd, "Enter passphrase: ")()
+		if err != nil {
+			log.Fatalf("failed to read passphrase: %v", err)
+		}
+	}
+	if err := writeGuardianKey(gk, description, args[0], m.UnsafeDeterministicKey, passphrase); err != nil {
+		log.Fatalf("failed to write key: %v", err)
+	}
+	log.Printf("Reconstructed key %s at %s", ethcrypto.PubkeyToAddress(gk.PublicKey), args[0])
+}
+
+// splitGuardianKey splits the serialized guardian key into n shares, any threshold of which can reconstruct it.
+func splitGuardianKey(m *nodev1.GuardianKey, n, threshold int) ([]*nodev1.GuardianKeyShare, error) {
+	gk, err := ethcrypto.ToECDSA(m.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize raw key data: %w", err)
+	}
+	b, err := proto.Marshal(m)
+	if err != nil {
+		panic(err)
+	}
+	parts, err := shamir.Split(b, n, threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	address := ethcrypto.PubkeyToAddress(gk.PublicKey)
+	shares := make([]*nodev1.GuardianKeyShare, len(parts))
+	for i, part := range parts {
+		shares[i] = &nodev1.GuardianKeyShare{
+			Index:       uint32(part.X),
+			Threshold:   uint32(threshold),
+			TotalShares: uint32(n),
+			Address:     address.Bytes(),
+			Data:        part.Y,
+		}
+		shares[i].Checksum = guardianKeyShareChecksum(shares[i])
+	}
+
+	// Make sure that the key can be reconstructed before anyone deletes the original one.
+	for _, subset := range [][]*nodev1.GuardianKeyShare{shares[:threshold], shares[n-threshold:]} {
+		if _, err := combineGuardianKeyShares(subset); err != nil {
+			return nil, fmt.Errorf("failed to verify shares: %w", err)
+		}
+	}
+	return shares, nil
+}
+
+// combineGuardianKeyShares reconstructs a guardian key from its shares, and verifies that it matches the address of
+// the shares.
+func combineGuardianKeyShares(shares []*nodev1.GuardianKeyShare) (*nodev1.GuardianKey, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("no shares")
+	}
+	first := shares[0]
+	parts := make([]shamir.Share, len(shares))
+	for i, share := range shares {
+		if !bytes.Equal(share.Checksum, guardianKeyShareChecksum(share)) {
+			return nil, fmt.Errorf("invalid checksum of share %d", share.Index)
+		}
+		if share.Threshold != first.Threshold || share.TotalShares != first.TotalShares || !bytes.Equal(share.Address, first.Address) {
+			return nil, fmt.Errorf("share %d belongs to another split than share %d", share.Index, first.Index)
+		}
+		if share.Index == 0 || share.Index > share.TotalShares {
+			return nil, fmt.Errorf("invalid share index %d", share.Index)
+		}
+		parts[i] = shamir.Share{X: byte(share.Index), Y: share.Data}
+	}
+	if len(shares) < int(first.Threshold) {
+		return nil, fmt.Errorf("%d shares are required, got %d", first.Threshold, len(shares))
+	}
+
+	b, err := shamir.Combine(parts)
+	if err != nil {
+		return nil, err
+	}
+	var m nodev1.GuardianKey
+	if err := proto.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("failed to deserialize protobuf: %w", err)
+	}
+	gk, err := ethcrypto.ToECDSA(m.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize raw key data: %w", err)
+	}
+	if address := ethcrypto.PubkeyToAddress(gk.PublicKey); !bytes.Equal(address.Bytes(), first.Address) {
+		return nil, fmt.Errorf("reconstructed key %s doesn't match the address of the shares %s", address, ethcommon.BytesToAddress(first.Address))
+	}
+	return &m, nil
+}
+
+func guardianKeyShareChecksum(share *nodev1.GuardianKeyShare) []byte {
+	h := sha256.New()
+	for _, v := range []uint32{share.Index, share.Threshold, share.TotalShares} {
+		_ = binary.Write(h, binary.BigEndian, v)
+	}
+	h.Write(share.Address)
+	h.Write(share.Data)
+	return h.Sum(nil)[:4]
+}
+
+func writeGuardianKeyShare(share *nodev1.GuardianKeyShare, description string, filename string) error {
+	b, err := proto.Marshal(share)
+	if err != nil {
+		panic(err)
+	}
+
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+
+	headers := map[string]string{
+		"PublicKey": ethcommon.BytesToAddress(share.Address).String(),
+		"Share":     fmt.Sprintf("%d/%d", share.Index, share.TotalShares),
+		"Threshold": strconv.Itoa(int(share.Threshold)),
+	}
+	if description != "" {
+		headers["Description"] = description
+	}
+	a, err := armor.Encode(f, GuardianKeyShareArmoredBlock, headers)
+	if err != nil {
+		panic(err)
+	}
+	_, err = a.Write(b)
+	if err != nil {
+		return fmt.Errorf("failed to write to file: %w", err)
+	}
+	err = a.Close()
+	if err != nil {
+		return err
+	}
+	return f.Close()
+}
+
+// readGuardianKeyShare reads a guardian key share, and returns it with the description of the key.
+func readGuardianKeyShare(filename string) (*nodev1.GuardianKeyShare, string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	p, err := armor.Decode(f)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read armored file: %w", err)
+	}
+
+	if p.Type != GuardianKeyShareArmoredBlock {
+		return nil, "", fmt.Errorf("invalid block type: %s", p.Type)
+	}
+
+	b, err := ioutil.ReadAll(p.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to read file: %w", err)
+	}
+
+	var share nodev1.GuardianKeyShare
+	if err := proto.Unmarshal(b, &share); err != nil {
+		return nil, "", fmt.Errorf("failed to deserialize protobuf: %w", err)
+	}
+	if !bytes.Equal(share.Checksum, guardianKeyShareChecksum(&share)) {
+		return nil, "", errors.New("invalid checksum")
+	}
+
+	return &share, p.Header["Description"], nil
+}
@@ -0,0 +1,78 @@
+package guardiand
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	nodev1 "github.com/alephium/wormhole-fork/node/pkg/proto/node/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestSplitCombineGuardianKey(t *testing.T) {
+	gk, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	m := &nodev1.GuardianKey{Data: ethcrypto.FromECDSA(gk)}
+
+	shares, err := splitGuardianKey(m, 5, 3)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+
+	dir := t.TempDir()
+	var read []*nodev1.GuardianKeyShare
+	for i, share := range shares {
+		filename := filepath.Join(dir, fmt.Sprintf("share-%d.key", share.Index))
+		require.NoError(t, writeGuardianKeyShare(share, "test key", filename))
+		r, description, err := readGuardianKeyShare(filename)
+		require.NoError(t, err)
+		assert.Equal(t, "test key", description)
+		assert.True(t, proto.Equal(shares[i], r))
+		read = append(read, r)
+	}
+
+	combined, err := combineGuardianKeyShares([]*nodev1.GuardianKeyShare{read[4], read[1], read[2]})
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(m, combined))
+
+	// Not enough shares
+	_, err = combineGuardianKeyShares(read[:2])
+	assert.Error(t, err)
+
+	// Corrupted share
+	corrupted := proto.Clone(read[0]).(*nodev1.GuardianKeyShare)
+	corrupted.Data[0] ^= 1
+	_, err = combineGuardianKeyShares([]*nodev1.GuardianKeyShare{corrupted, read[1], read[2]})
+	assert.ErrorContains(t, err, "checksum")
+
+	// Share of another split of the same key
+	other, err := splitGuardianKey(m, 5, 2)
+	require.NoError(t, err)
+	_, err = combineGuardianKeyShares([]*nodev1.GuardianKeyShare{other[0], read[1], read[2]})
+	assert.Error(t, err)
+
+	// Shares claiming the address of another key
+	otherKey, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	forged := make([]*nodev1.GuardianKeyShare, 3)
+	for i := range forged {
+		forged[i] = proto.Clone(read[i]).(*nodev1.GuardianKeyShare)
+		forged[i].Address = ethcrypto.PubkeyToAddress(otherKey.PublicKey).Bytes()
+		forged[i].Checksum = guardianKeyShareChecksum(forged[i])
+	}
+	_, err = combineGuardianKeyShares(forged)
+	assert.ErrorContains(t, err, "doesn't match")
+}
+
+func TestSplitGuardianKeyInvalidParameters(t *testing.T) {
+	gk, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+	m := &nodev1.GuardianKey{Data: ethcrypto.FromECDSA(gk)}
+
+	_, err = splitGuardianKey(m, 2, 3)
+	assert.Error(t, err)
+	_, err = splitGuardianKey(m, 3, 1)
+	assert.Error(t, err)
+}
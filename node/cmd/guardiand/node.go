@@ -2,6 +2,7 @@ package guardiand
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/base64"
 	"fmt"
 	"log"
@@ -13,10 +14,17 @@ import (
 	"time"
 
 	"github.com/alephium/wormhole-fork/node/pkg/alephium"
+	"github.com/alephium/wormhole-fork/node/pkg/chainreader"
 	"github.com/alephium/wormhole-fork/node/pkg/db"
 	"github.com/alephium/wormhole-fork/node/pkg/ecdsasigner"
 	"github.com/alephium/wormhole-fork/node/pkg/ethereum"
+	"github.com/alephium/wormhole-fork/node/pkg/governor"
+	"github.com/alephium/wormhole-fork/node/pkg/govtracker"
+	"github.com/alephium/wormhole-fork/node/pkg/gsmonitor"
 	"github.com/alephium/wormhole-fork/node/pkg/notify/discord"
+	"github.com/alephium/wormhole-fork/node/pkg/pause"
+	"github.com/alephium/wormhole-fork/node/pkg/policy"
+	"github.com/alephium/wormhole-fork/node/pkg/registrations"
 	"github.com/alephium/wormhole-fork/node/pkg/telemetry"
 	"github.com/alephium/wormhole-fork/node/pkg/version"
 	"github.com/benbjohnson/clock"
@@ -25,6 +33,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
+	"github.com/alephium/wormhole-fork/node/pkg/accountant"
 	"github.com/alephium/wormhole-fork/node/pkg/common"
 	"github.com/alephium/wormhole-fork/node/pkg/devnet"
 	"github.com/alephium/wormhole-fork/node/pkg/p2p"
@@ -58,51 +67,19 @@ var (
 
 	statusAddr *string
 
-	guardianKeyPath *string
+	guardianKeyPath         *string
+	guardianKeyPassphraseFd *int
 	// solanaContract  *string
 
+	// EVM chain RPC urls by network name, EVM chains are configured in the guardian config
+	evmRPC *map[string]string
+
+	// Deprecated aliases of --evmRPC and of the poll intervals of the guardian config
 	ethRPC            *string
 	ethPollIntervalMs *uint
-
 	bscRPC            *string
 	bscPollIntervalMs *uint
 
-	// polygonRPC      *string
-	// polygonContract *string
-
-	// ethRopstenRPC      *string
-	// ethRopstenContract *string
-
-	// auroraRPC      *string
-	// auroraContract *string
-
-	// fantomRPC      *string
-	// fantomContract *string
-
-	// avalancheRPC      *string
-	// avalancheContract *string
-
-	// oasisRPC      *string
-	// oasisContract *string
-
-	// karuraRPC      *string
-	// karuraContract *string
-
-	// acalaRPC      *string
-	// acalaContract *string
-
-	// klaytnRPC      *string
-	// klaytnContract *string
-
-	// celoRPC      *string
-	// celoContract *string
-
-	// moonbeamRPC      *string
-	// moonbeamContract *string
-
-	// neonRPC      *string
-	// neonContract *string
-
 	// terraWS       *string
 	// terraLCD      *string
 	// terraContract *string
@@ -144,6 +121,27 @@ var (
 
 	cloudKMSEnabled *bool
 	cloudKMSKeyName *string
+
+	remoteSignerEnabled *bool
+	remoteSignerAddress *string
+	remoteSignerTLSCert *string
+	remoteSignerTLSKey  *string
+	remoteSignerTLSCA   *string
+
+	governorConfigPath *string
+
+	signingPolicyEnabled    *bool
+	signingPolicyConfigPath *string
+
+	accountantEnabled *bool
+	accountantEnforce *bool
+
+	persistAggregationState *bool
+
+	guardianSetMonitorInterval *time.Duration
+
+	tokenBridgeRegistrationCheckInterval *time.Duration
+	governanceTrackerInterval            *time.Duration
 )
 
 func init() {
@@ -160,49 +158,19 @@ func init() {
 	dataDir = NodeCmd.Flags().String("dataDir", "", "Data directory")
 
 	guardianKeyPath = NodeCmd.Flags().String("guardianKey", "", "Path to guardian key (required)")
+	guardianKeyPassphraseFd = NodeCmd.Flags().Int("guardianKeyPassphraseFd", -1, "File descriptor to read the passphrase of an encrypted guardian key from (defaults to $"+GuardianKeyPassphraseEnv+" or the terminal)")
 	// solanaContract = NodeCmd.Flags().String("solanaContract", "", "Address of the Solana program (required)")
 
+	evmRPC = NodeCmd.Flags().StringToString("evmRPC", map[string]string{}, "EVM chain RPC URLs by network name, e.g. eth=ws://eth-devnet:8545,bsc=ws://bsc-devnet:8545 (overrides the RPC URLs of the guardian config)")
+
 	ethRPC = NodeCmd.Flags().String("ethRPC", "", "Ethereum RPC URL")
 	ethPollIntervalMs = NodeCmd.Flags().Uint("ethPollIntervalMs", 3000, "The poll interval for ethereum watcher")
-
 	bscRPC = NodeCmd.Flags().String("bscRPC", "", "Binance Smart Chain RPC URL")
 	bscPollIntervalMs = NodeCmd.Flags().Uint("bscPollIntervalMs", 3000, "The poll interval for bsc watcher")
-
-	// polygonRPC = NodeCmd.Flags().String("polygonRPC", "", "Polygon RPC URL")
-	// polygonContract = NodeCmd.Flags().String("polygonContract", "", "Polygon contract address")
-
-	// ethRopstenRPC = NodeCmd.Flags().String("ethRopstenRPC", "", "Ethereum Ropsten RPC URL")
-	// ethRopstenContract = NodeCmd.Flags().String("ethRopstenContract", "", "Ethereum Ropsten contract address")
-
-	// avalancheRPC = NodeCmd.Flags().String("avalancheRPC", "", "Avalanche RPC URL")
-	// avalancheContract = NodeCmd.Flags().String("avalancheContract", "", "Avalanche contract address")
-
-	// oasisRPC = NodeCmd.Flags().String("oasisRPC", "", "Oasis RPC URL")
-	// oasisContract = NodeCmd.Flags().String("oasisContract", "", "Oasis contract address")
-
-	// auroraRPC = NodeCmd.Flags().String("auroraRPC", "", "Aurora Websocket RPC URL")
-	// auroraContract = NodeCmd.Flags().String("auroraContract", "", "Aurora contract address")
-
-	// fantomRPC = NodeCmd.Flags().String("fantomRPC", "", "Fantom Websocket RPC URL")
-	// fantomContract = NodeCmd.Flags().String("fantomContract", "", "Fantom contract address")
-
-	// karuraRPC = NodeCmd.Flags().String("karuraRPC", "", "Karura RPC URL")
-	// karuraContract = NodeCmd.Flags().String("karuraContract", "", "Karura contract address")
-
-	// acalaRPC = NodeCmd.Flags().String("acalaRPC", "", "Acala RPC URL")
-	// acalaContract = NodeCmd.Flags().String("acalaContract", "", "Acala contract address")
-
-	// klaytnRPC = NodeCmd.Flags().String("klaytnRPC", "", "Klaytn RPC URL")
-	// klaytnContract = NodeCmd.Flags().String("klaytnContract", "", "Klaytn contract address")
-
-	// celoRPC = NodeCmd.Flags().String("celoRPC", "", "Celo RPC URL")
-	// celoContract = NodeCmd.Flags().String("celoContract", "", "Celo contract address")
-
-	// moonbeamRPC = NodeCmd.Flags().String("moonbeamRPC", "", "Moonbeam RPC URL")
-	// moonbeamContract = NodeCmd.Flags().String("moonbeamContract", "", "Moonbeam contract address")
-
-	// neonRPC = NodeCmd.Flags().String("neonRPC", "", "Neon RPC URL")
-	// neonContract = NodeCmd.Flags().String("neonContract", "", "Neon contract address")
+	_ = NodeCmd.Flags().MarkDeprecated("ethRPC", "use --evmRPC eth=<url> instead")
+	_ = NodeCmd.Flags().MarkDeprecated("ethPollIntervalMs", "set pollIntervalMs in the evmChains of the guardian config instead")
+	_ = NodeCmd.Flags().MarkDeprecated("bscRPC", "use --evmRPC bsc=<url> instead")
+	_ = NodeCmd.Flags().MarkDeprecated("bscPollIntervalMs", "set pollIntervalMs in the evmChains of the guardian config instead")
 
 	// terraWS = NodeCmd.Flags().String("terraWS", "", "Path to terrad root for websocket connection")
 	// terraLCD = NodeCmd.Flags().String("terraLCD", "", "Path to LCD service root for http calls")
@@ -249,6 +217,26 @@ func init() {
 
 	cloudKMSEnabled = NodeCmd.Flags().Bool("cloudKMSEnabled", false, "Turn on Cloud KMS support for Guardian Key")
 	cloudKMSKeyName = NodeCmd.Flags().String("cloudKMSKeyName", "", "Cloud KMS key name for Guardian Key")
+
+	remoteSignerEnabled = NodeCmd.Flags().Bool("remoteSignerEnabled", false, "Sign with a guardian key held by a remote signer")
+	remoteSignerAddress = NodeCmd.Flags().String("remoteSignerAddress", "", "Remote signer address, either a Unix socket (unix:///path/to/socket) or a TCP address (requires TLS)")
+	remoteSignerTLSCert = NodeCmd.Flags().String("remoteSignerTLSCert", "", "Path to the client TLS certificate for the remote signer")
+	remoteSignerTLSKey = NodeCmd.Flags().String("remoteSignerTLSKey", "", "Path to the client TLS key for the remote signer")
+	remoteSignerTLSCA = NodeCmd.Flags().String("remoteSignerTLSCA", "", "Path to the CA certificate of the remote signer TLS certificate")
+
+	governorConfigPath = NodeCmd.Flags().String("governorConfig", "", "Path to the chain governor config, the governor is disabled if not set")
+
+	signingPolicyEnabled = NodeCmd.Flags().Bool("signingPolicy", false, "Only sign messages of the token bridge emitters to the chains of the bridge config")
+	signingPolicyConfigPath = NodeCmd.Flags().String("signingPolicyConfig", "", "Path to a config of additional emitters and target chains allowed by the signing policy")
+
+	accountantEnabled = NodeCmd.Flags().Bool("accountant", false, "Keep a ledger of the tokens locked and released by the token bridges, and flag transfers exceeding it")
+	accountantEnforce = NodeCmd.Flags().Bool("accountantEnforce", false, "Refuse to sign the transfers flagged by the token accountant. The ledger is built from the VAAs in the local database, which must hold the whole history of the token bridges: the node refuses to start if VAAs are missing")
+
+	persistAggregationState = NodeCmd.Flags().Bool("persistAggregationState", false, "Persist in-flight observations and signatures in the database so that they survive restarts")
+
+	tokenBridgeRegistrationCheckInterval = NodeCmd.Flags().Duration("tokenBridgeRegistrationCheckInterval", 0, "How often to check that the token bridges of all chains have the token bridges of the other chains registered (0 to disable)")
+	governanceTrackerInterval = NodeCmd.Flags().Duration("governanceTrackerInterval", 0, "How often to check whether the stored governance VAAs were executed on their target chains (0 to disable)")
+	guardianSetMonitorInterval = NodeCmd.Flags().Duration("guardianSetMonitorInterval", 0, "How often to check that the core contracts of all chains are on the same guardian set (0 to disable)")
 }
 
 var (
@@ -337,8 +325,6 @@ func runNode(cmd *cobra.Command, args []string) {
 	ipfslog.SetAllLoggers(lvl)
 
 	// Register components for readiness checks.
-	readiness.RegisterComponent(common.ReadinessEthSyncing)
-	readiness.RegisterComponent(common.ReadinessBSCSyncing)
 	readiness.RegisterComponent(common.ReadinessAlephiumSyncing)
 
 	if *statusAddr != "" {
@@ -372,11 +358,30 @@ func runNode(cmd *cobra.Command, args []string) {
 		logger.Fatal("failed to read configs", zap.String("network", *network), zap.Error(err))
 	}
 	alphConfig := bridgeConfig.Alephium
-	ethConfig := bridgeConfig.Ethereum
-	bscConfig := bridgeConfig.Bsc
 
-	ethContract := eth_common.HexToAddress(ethConfig.Contracts.Governance)
-	bscContract := eth_common.HexToAddress(bscConfig.Contracts.Governance)
+	// RPC urls of the EVM chain registry can be overridden by flags.
+	applyLegacyEvmFlags(logger)
+	for networkName := range *evmRPC {
+		found := false
+		for _, chain := range bridgeConfig.EvmChains {
+			found = found || chain.NetworkName == networkName
+		}
+		if !found {
+			logger.Fatal("unknown EVM network in --evmRPC", zap.String("networkName", networkName))
+		}
+	}
+	for _, chain := range bridgeConfig.EvmChains {
+		if url, ok := (*evmRPC)[chain.NetworkName]; ok {
+			chain.Rpc = url
+		}
+		if chain.NetworkName == "eth" && cmd.Flags().Changed("ethPollIntervalMs") {
+			chain.PollIntervalMs = *ethPollIntervalMs
+		}
+		if chain.NetworkName == "bsc" && cmd.Flags().Changed("bscPollIntervalMs") {
+			chain.PollIntervalMs = *bscPollIntervalMs
+		}
+		readiness.RegisterComponent(evmReadinessComponent(chain))
+	}
 
 	governanceChainId := vaa.ChainID(bridgeConfig.Guardian.GovernanceChainId)
 	governanceEmitterAddress, err := vaa.StringToAddress(bridgeConfig.Guardian.GovernanceEmitterAddress)
@@ -403,8 +408,11 @@ func runNode(cmd *cobra.Command, args []string) {
 	if *nodeKeyPath == "" && !unsafeDevMode { // In devnet mode, keys are deterministically generated.
 		logger.Fatal("Please specify --nodeKey")
 	}
-	if *guardianKeyPath == "" && !*cloudKMSEnabled && !unsafeDevMode {
-		logger.Fatal("Please either specify --guardianKey or --cloudKMSEnabled")
+	if *guardianKeyPath == "" && !*cloudKMSEnabled && !*remoteSignerEnabled && !unsafeDevMode {
+		logger.Fatal("Please either specify --guardianKey, --cloudKMSEnabled or --remoteSignerEnabled")
+	}
+	if *cloudKMSEnabled && *remoteSignerEnabled {
+		logger.Fatal("Please do not specify both --cloudKMSEnabled and --remoteSignerEnabled")
 	}
 	if *cloudKMSEnabled && unsafeDevMode {
 		logger.Fatal("Please do not specify --cloudKMSEnabled in devnet")
@@ -415,11 +423,10 @@ func runNode(cmd *cobra.Command, args []string) {
 	if *dataDir == "" && !unsafeDevMode {
 		logger.Fatal("Please specify --dataDir")
 	}
-	if *ethRPC == "" {
-		logger.Fatal("Please specify --ethRPC")
-	}
-	if *bscRPC == "" {
-		logger.Fatal("Please specify --bscRPC")
+	for _, chain := range bridgeConfig.EvmChains {
+		if chain.Rpc == "" {
+			logger.Fatal(fmt.Sprintf("Please specify the RPC url of %s with --evmRPC", chain.NetworkName))
+		}
 	}
 	if *nodeName == "" {
 		logger.Fatal("Please specify --nodeName")
@@ -431,6 +438,15 @@ func runNode(cmd *cobra.Command, args []string) {
 		}
 	}
 
+	if *remoteSignerEnabled {
+		if *remoteSignerAddress == "" {
+			logger.Fatal("Please specify --remoteSignerAddress")
+		}
+		if !strings.HasPrefix(*remoteSignerAddress, "unix:") && (*remoteSignerTLSCert == "" || *remoteSignerTLSKey == "" || *remoteSignerTLSCA == "") {
+			logger.Fatal("Please specify --remoteSignerTLSCert, --remoteSignerTLSKey and --remoteSignerTLSCA to connect to the remote signer over TCP")
+		}
+	}
+
 	// Complain about Infura on mainnet.
 	//
 	// As it turns out, Infura has a bug where it would sometimes incorrectly round
@@ -449,23 +465,12 @@ func runNode(cmd *cobra.Command, args []string) {
 	//
 	// Insert "I'm a sign, not a cop" meme.
 	//
-	if strings.Contains(*ethRPC, "mainnet.infura.io") {
-		// strings.Contains(*polygonRPC, "polygon-mainnet.infura.io") {
-		logger.Fatal("Infura is known to send incorrect blocks - please use your own nodes")
-	}
-
-	// polygonContractAddr := eth_common.HexToAddress(*polygonContract)
-	// ethRopstenContractAddr := eth_common.HexToAddress(*ethRopstenContract)
-	// avalancheContractAddr := eth_common.HexToAddress(*avalancheContract)
-	// oasisContractAddr := eth_common.HexToAddress(*oasisContract)
-	// auroraContractAddr := eth_common.HexToAddress(*auroraContract)
-	// fantomContractAddr := eth_common.HexToAddress(*fantomContract)
-	// karuraContractAddr := eth_common.HexToAddress(*karuraContract)
-	// acalaContractAddr := eth_common.HexToAddress(*acalaContract)
-	// klaytnContractAddr := eth_common.HexToAddress(*klaytnContract)
-	// celoContractAddr := eth_common.HexToAddress(*celoContract)
-	// moonbeamContractAddr := eth_common.HexToAddress(*moonbeamContract)
-	// neonContractAddr := eth_common.HexToAddress(*neonContract)
+	for _, chain := range bridgeConfig.EvmChains {
+		if strings.Contains(chain.Rpc, "mainnet.infura.io") {
+			logger.Fatal("Infura is known to send incorrect blocks - please use your own nodes")
+		}
+	}
+
 	// solAddress, err := solana_types.PublicKeyFromBase58(*solanaContract)
 	// if err != nil {
 	// 	logger.Fatal("invalid Solana contract address", zap.Error(err))
@@ -496,7 +501,7 @@ func runNode(cmd *cobra.Command, args []string) {
 		}
 
 		gk := devnet.InsecureDeterministicEcdsaKeyByIndex(ethcrypto.S256(), uint64(*devnetGuardianIndex))
-		err = writeGuardianKey(gk, "auto-generated deterministic devnet key", *guardianKeyPath, true)
+		err = writeGuardianKey(gk, "auto-generated deterministic devnet key", *guardianKeyPath, true, nil)
 		if err != nil {
 			logger.Fatal("failed to write devnet guardian key", zap.Error(err))
 		}
@@ -534,8 +539,22 @@ func runNode(cmd *cobra.Command, args []string) {
 		}
 		defer kmsClient.Client.Close()
 		guardianSigner = kmsClient
+	} else if *remoteSignerEnabled {
+		var tlsConfig *tls.Config
+		if *remoteSignerTLSCert != "" {
+			tlsConfig, err = ecdsasigner.NewMutualTLSConfig(*remoteSignerTLSCert, *remoteSignerTLSKey, *remoteSignerTLSCA, false)
+			if err != nil {
+				logger.Fatal("Failed to load remote signer TLS config", zap.Error(err))
+			}
+		}
+		remoteSigner, err := ecdsasigner.NewRemoteSigner(context.Background(), *remoteSignerAddress, tlsConfig)
+		if err != nil {
+			logger.Fatal("Failed to connect to the remote signer", zap.Error(err))
+		}
+		defer remoteSigner.Close()
+		guardianSigner = remoteSigner
 	} else {
-		gk, err := loadGuardianKey(*guardianKeyPath)
+		gk, err := loadGuardianKey(*guardianKeyPath, guardianKeyPassphrase(*guardianKeyPassphraseFd, "Enter guardian key passphrase: "))
 		if err != nil {
 			logger.Fatal("Failed to load guardian key from file", zap.Error(err))
 		}
@@ -584,8 +603,9 @@ func runNode(cmd *cobra.Command, args []string) {
 	chainObsvReqC := make(map[vaa.ChainID]chan *gossipv1.ObservationRequest)
 
 	// Observation request channel for each chain supporting observation requests.
-	chainObsvReqC[vaa.ChainIDEthereum] = make(chan *gossipv1.ObservationRequest, observationRequestBufferSize)
-	chainObsvReqC[vaa.ChainIDBSC] = make(chan *gossipv1.ObservationRequest, observationRequestBufferSize)
+	for _, chain := range bridgeConfig.EvmChains {
+		chainObsvReqC[chain.WormholeChainId()] = make(chan *gossipv1.ObservationRequest, observationRequestBufferSize)
+	}
 	chainObsvReqC[vaa.ChainIDAlephium] = make(chan *gossipv1.ObservationRequest, observationRequestBufferSize)
 
 	go handleReobservationRequests(rootCtx, clock.New(), logger, obsvReqC, chainObsvReqC)
@@ -648,17 +668,124 @@ func runNode(cmd *cobra.Command, args []string) {
 	// Redirect ipfs logs to plain zap
 	ipfslog.SetPrimaryCore(logger.Core())
 
+	// tokenBridgeEmitters maps the watched chains to the address of their token bridge emitter.
+	tokenBridgeEmitters := func() map[vaa.ChainID]vaa.Address {
+		chainConfigs := map[vaa.ChainID]*common.ChainConfig{vaa.ChainIDAlephium: alphConfig}
+		for _, chain := range bridgeConfig.EvmChains {
+			chainConfigs[chain.WormholeChainId()] = chain.ChainConfig
+		}
+		emitters := make(map[vaa.ChainID]vaa.Address)
+		for chainId, config := range chainConfigs {
+			emitter, err := vaa.StringToAddress(config.TokenBridgeEmitterAddress)
+			if err != nil {
+				logger.Fatal("invalid token bridge emitter address", zap.Stringer("chainId", chainId), zap.Error(err))
+			}
+			emitters[chainId] = emitter
+		}
+		return emitters
+	}
+
+	var chainGovernor *governor.ChainGovernor
+	if *governorConfigPath != "" {
+		governorConfig, err := governor.ReadConfig(*governorConfigPath)
+		if err != nil {
+			logger.Fatal("failed to read governor config", zap.Error(err))
+		}
+		chainGovernor, err = governor.NewChainGovernor(logger.Named("governor"), db, governorConfig, tokenBridgeEmitters())
+		if err != nil {
+			logger.Fatal("failed to create chain governor", zap.Error(err))
+		}
+		logger.Info("chain governor enabled", zap.String("config", *governorConfigPath))
+	}
+
+	var tokenAccountant *accountant.Accountant
+	if *accountantEnabled {
+		tokenAccountant, err = accountant.NewAccountant(logger.Named("accountant"), db, tokenBridgeEmitters(), *accountantEnforce)
+		if err != nil {
+			logger.Fatal("failed to create token accountant", zap.Error(err))
+		}
+		logger.Info("token accountant enabled", zap.Bool("enforce", *accountantEnforce))
+	} else if *accountantEnforce {
+		logger.Fatal("--accountantEnforce requires --accountant")
+	}
+
+	signingPauses, err := pause.NewController(logger.Named("pause"), db)
+	if err != nil {
+		logger.Fatal("failed to load signing pauses", zap.Error(err))
+	}
+
+	var signingPolicy *policy.Policy
+	if *signingPolicyEnabled {
+		var policyConfig *policy.Config
+		if *signingPolicyConfigPath != "" {
+			policyConfig, err = policy.ReadConfig(*signingPolicyConfigPath)
+			if err != nil {
+				logger.Fatal("failed to read signing policy config", zap.Error(err))
+			}
+		}
+		signingPolicy, err = policy.NewPolicy(logger.Named("policy"), tokenBridgeEmitters(), policyConfig)
+		if err != nil {
+			logger.Fatal("failed to create signing policy", zap.Error(err))
+		}
+		logger.Info("signing policy enabled", zap.String("config", *signingPolicyConfigPath))
+	} else if *signingPolicyConfigPath != "" {
+		logger.Fatal("--signingPolicyConfig requires --signingPolicy")
+	}
+
+	// The chain readers share one client per chain. The clients only connect when first used.
+	var evmClients []*chainreader.EvmClient
+	for _, chain := range bridgeConfig.EvmChains {
+		evmClients = append(evmClients, chainreader.NewEvmClient(chain))
+	}
+	alphClient, err := chainreader.NewAlephiumClient(*alphRPC, *alphApiKey, alphConfig)
+	if err != nil {
+		logger.Fatal("failed to create alephium chain reader", zap.Error(err))
+	}
+
+	var gsMonitor *gsmonitor.Monitor
+	if *guardianSetMonitorInterval > 0 {
+		// Ethereum comes first, it is the reference chain since the guardian reads its guardian set from it.
+		var sources []gsmonitor.Source
+		for _, client := range evmClients {
+			source := gsmonitor.NewEvmSource(client)
+			if client.ChainID() == vaa.ChainIDEthereum {
+				sources = append([]gsmonitor.Source{source}, sources...)
+			} else {
+				sources = append(sources, source)
+			}
+		}
+		sources = append(sources, gsmonitor.NewAlephiumSource(alphClient))
+		gsMonitor = gsmonitor.NewMonitor(logger.Named("gsmonitor"), sources, notifier, *guardianSetMonitorInterval)
+	}
+
+	// The registration checker is always created, so that the admin RPC can check the registrations on demand.
+	emitters := tokenBridgeEmitters()
+	registrationChains := []registrations.Chain{{Source: registrations.NewAlephiumSource(alphClient), Emitter: emitters[vaa.ChainIDAlephium]}}
+	for _, client := range evmClients {
+		registrationChains = append(registrationChains, registrations.Chain{Source: registrations.NewEvmSource(client), Emitter: emitters[client.ChainID()]})
+	}
+	registrationChecker := registrations.NewChecker(logger.Named("registrations"), registrationChains, notifier, *tokenBridgeRegistrationCheckInterval)
+
+	var govTracker *govtracker.Tracker
+	if *governanceTrackerInterval > 0 {
+		sources := []govtracker.Source{govtracker.NewAlephiumSource(alphClient)}
+		for _, client := range evmClients {
+			sources = append(sources, govtracker.NewEvmSource(client))
+		}
+		govTracker = govtracker.NewTracker(logger.Named("govtracker"), db, governanceChainId, governanceEmitterAddress, sources, *governanceTrackerInterval)
+	}
+
 	// provides methods for reporting progress toward message attestation, and channels for receiving attestation lifecyclye events.
 	attestationEvents := reporter.EventListener(logger)
 
-	publicrpcService, publicrpcServer, err := publicrpcServiceRunnable(logger, *publicRPC, db, gst, governanceChainId, governanceEmitterAddress)
+	publicrpcService, publicrpcServer, err := publicrpcServiceRunnable(logger, *publicRPC, db, gst, governanceChainId, governanceEmitterAddress, attestationEvents)
 
 	if err != nil {
 		log.Fatal("failed to create publicrpc service socket", zap.Error(err))
 	}
 
 	// local admin service socket
-	adminService, err := adminServiceRunnable(logger, *adminSocketPath, injectC, signedInC, obsvReqSendC, db, gst, governanceChainId, governanceEmitterAddress)
+	adminService, err := adminServiceRunnable(logger, *adminSocketPath, injectC, signedInC, obsvReqSendC, db, gst, governanceChainId, governanceEmitterAddress, chainGovernor, tokenAccountant, signingPauses, signingPolicy, gsMonitor, registrationChecker, govTracker)
 	if err != nil {
 		logger.Fatal("failed to create admin service socket", zap.Error(err))
 	}
@@ -676,14 +803,27 @@ func runNode(cmd *cobra.Command, args []string) {
 			return err
 		}
 
-		if err := supervisor.Run(ctx, "ethwatch",
-			ethereum.NewEthWatcher(*ethRPC, ethContract, "eth", common.ReadinessEthSyncing, vaa.ChainIDEthereum, lockC, setC, chainObsvReqC[vaa.ChainIDEthereum], unsafeDevMode, ethPollIntervalMs, false).Run); err != nil {
-			return err
-		}
-
-		if err := supervisor.Run(ctx, "bscwatch",
-			ethereum.NewEthWatcher(*bscRPC, bscContract, "bsc", common.ReadinessBSCSyncing, vaa.ChainIDBSC, lockC, setC, chainObsvReqC[vaa.ChainIDBSC], unsafeDevMode, bscPollIntervalMs, true).Run); err != nil {
-			return err
+		for _, chain := range bridgeConfig.EvmChains {
+			watcher := ethereum.NewEthWatcher(
+				chain.Rpc,
+				eth_common.HexToAddress(chain.Contracts.Governance),
+				chain.NetworkName,
+				evmReadinessComponent(chain),
+				chain.WormholeChainId(),
+				lockC,
+				setC,
+				chainObsvReqC[chain.WormholeChainId()],
+				unsafeDevMode,
+				&chain.PollIntervalMs,
+				chain.WaitForConfirmations,
+				chain.MaxWaitConfirmations,
+				chain.Finality == common.EvmFinalityFinalized,
+				db,
+				chain.MaxCatchupBlocks,
+			)
+			if err := supervisor.Run(ctx, fmt.Sprintf("%swatch", chain.NetworkName), watcher.Run); err != nil {
+				return err
+			}
 		}
 
 		// if err := supervisor.Run(ctx, "algorandwatch",
@@ -693,7 +833,7 @@ func runNode(cmd *cobra.Command, args []string) {
 
 		alphWatcher, err := alephium.NewAlephiumWatcher(
 			*alphRPC, *alphApiKey, alphConfig, common.ReadinessAlephiumSyncing,
-			lockC, *alphPollIntervalMs, chainObsvReqC[vaa.ChainIDAlephium], *network == "mainnet",
+			lockC, setC, *alphPollIntervalMs, chainObsvReqC[vaa.ChainIDAlephium], *network == "mainnet", db,
 		)
 		if err != nil {
 			logger.Error("failed to create alephium watcher", zap.Error(err))
@@ -710,6 +850,7 @@ func runNode(cmd *cobra.Command, args []string) {
 			sendC,
 			obsvC,
 			obsvReqSendC,
+			obsvReqC,
 			injectC,
 			signedInC,
 			guardianSigner,
@@ -718,6 +859,11 @@ func runNode(cmd *cobra.Command, args []string) {
 			notifier,
 			governanceChainId,
 			governanceEmitterAddress,
+			chainGovernor,
+			tokenAccountant,
+			signingPauses,
+			signingPolicy,
+			*persistAggregationState,
 		)
 		if err := supervisor.Run(ctx, "processor", p.Run); err != nil {
 			return err
@@ -726,6 +872,21 @@ func runNode(cmd *cobra.Command, args []string) {
 		if err := supervisor.Run(ctx, "admin", adminService); err != nil {
 			return err
 		}
+		if gsMonitor != nil {
+			if err := supervisor.Run(ctx, "gsmonitor", gsMonitor.Run); err != nil {
+				return err
+			}
+		}
+		if *tokenBridgeRegistrationCheckInterval > 0 {
+			if err := supervisor.Run(ctx, "registrations", registrationChecker.Run); err != nil {
+				return err
+			}
+		}
+		if govTracker != nil {
+			if err := supervisor.Run(ctx, "govtracker", govTracker.Run); err != nil {
+				return err
+			}
+		}
 		if *publicRPC != "" {
 			if err := supervisor.Run(ctx, "publicrpc", publicrpcService); err != nil {
 				return err
@@ -770,3 +931,21 @@ func decryptTelemetryServiceAccount() ([]byte, error) {
 
 	return creds, err
 }
+
+// applyLegacyEvmFlags adds the RPC urls of the deprecated --ethRPC and --bscRPC flags to --evmRPC.
+func applyLegacyEvmFlags(logger *zap.Logger) {
+	for networkName, url := range map[string]string{"eth": *ethRPC, "bsc": *bscRPC} {
+		if url == "" {
+			continue
+		}
+		if existing, ok := (*evmRPC)[networkName]; ok && existing != url {
+			logger.Fatal("conflicting RPC urls in --evmRPC and its deprecated alias", zap.String("networkName", networkName))
+		}
+		(*evmRPC)[networkName] = url
+	}
+}
+
+// evmReadinessComponent returns the readiness component of an EVM chain, e.g. ethSyncing for the eth network.
+func evmReadinessComponent(chain *common.EvmChainConfig) readiness.Component {
+	return readiness.Component(fmt.Sprintf("%sSyncing", chain.NetworkName))
+}
@@ -0,0 +1,97 @@
+package guardiand
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/term"
+)
+
+// GuardianKeyPassphraseEnv is the environment variable holding the passphrase of an encrypted guardian key, for
+// unattended starts without a passphrase file descriptor.
+const GuardianKeyPassphraseEnv = "GUARDIAN_KEY_PASSPHRASE"
+
+// passphraseFunc returns the passphrase of an encrypted guardian key.
+type passphraseFunc func() ([]byte, error)
+
+// guardianKeyPassphrase returns a passphraseFunc reading the passphrase from the file descriptor fd if it's not
+// negative, otherwise from the GUARDIAN_KEY_PASSPHRASE environment variable if it's set, otherwise from the terminal.
+func guardianKeyPassphrase(fd int, prompt string) passphraseFunc {
+	return func() ([]byte, error) {
+		if fd >= 0 {
+			return readPassphraseFd(fd)
+		}
+		if pass, ok := os.LookupEnv(GuardianKeyPassphraseEnv); ok {
+			// Don't leak the passphrase to child processes
+			if err := os.Unsetenv(GuardianKeyPassphraseEnv); err != nil {
+				return nil, err
+			}
+			if pass == "" {
+				return nil, fmt.Errorf("%s is empty", GuardianKeyPassphraseEnv)
+			}
+			return []byte(pass), nil
+		}
+		return readPassphraseTerminal(prompt)
+	}
+}
+
+// newGuardianKeyPassphrase is like guardianKeyPassphrase, but asks for a confirmation on the terminal.
+func newGuardianKeyPassphrase(fd int, prompt string) passphraseFunc {
+	if _, ok := os.LookupEnv(GuardianKeyPassphraseEnv); fd >= 0 || ok {
+		return guardianKeyPassphrase(fd, prompt)
+	}
+	return func() ([]byte, error) {
+		pass, err := readPassphraseTerminal(prompt)
+		if err != nil {
+			return nil, err
+		}
+		confirmation, err := readPassphraseTerminal("Confirm passphrase: ")
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(pass, confirmation) {
+			return nil, errors.New("passphrases don't match")
+		}
+		return pass, nil
+	}
+}
+
+// readPassphraseFd reads the first line of the file descriptor fd, which is closed afterwards.
+func readPassphraseFd(fd int) ([]byte, error) {
+	f := os.NewFile(uintptr(fd), "passphrase")
+	if f == nil {
+		return nil, fmt.Errorf("invalid file descriptor %d", fd)
+	}
+	defer f.Close()
+
+	pass, err := bufio.NewReader(f).ReadBytes('\n')
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("failed to read file descriptor %d: %w", fd, err)
+	}
+	pass = bytes.TrimRight(pass, "\r\n")
+	if len(pass) == 0 {
+		return nil, fmt.Errorf("empty passphrase read from file descriptor %d", fd)
+	}
+	return pass, nil
+}
+
+func readPassphraseTerminal(prompt string) ([]byte, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return nil, fmt.Errorf("no terminal to read the passphrase from, use a file descriptor or %s", GuardianKeyPassphraseEnv)
+	}
+	fmt.Fprint(os.Stderr, prompt)
+	pass, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return nil, err
+	}
+	if len(pass) == 0 {
+		return nil, errors.New("empty passphrase")
+	}
+	return pass, nil
+}
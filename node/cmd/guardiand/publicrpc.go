@@ -8,6 +8,7 @@ import (
 	"github.com/alephium/wormhole-fork/node/pkg/db"
 	publicrpcv1 "github.com/alephium/wormhole-fork/node/pkg/proto/publicrpc/v1"
 	"github.com/alephium/wormhole-fork/node/pkg/publicrpc"
+	"github.com/alephium/wormhole-fork/node/pkg/reporter"
 	"github.com/alephium/wormhole-fork/node/pkg/supervisor"
 	"github.com/alephium/wormhole-fork/node/pkg/vaa"
 	"go.uber.org/zap"
@@ -21,6 +22,7 @@ func publicrpcServiceRunnable(
 	gst *common.GuardianSetState,
 	governanceChainId vaa.ChainID,
 	governanceEmitter vaa.Address,
+	attestationEvents *reporter.AttestationEventReporter,
 ) (supervisor.Runnable, *grpc.Server, error) {
 	l, err := net.Listen("tcp", listenAddr)
 	if err != nil {
@@ -29,7 +31,7 @@ func publicrpcServiceRunnable(
 
 	logger.Info("publicrpc server listening", zap.String("addr", l.Addr().String()))
 
-	rpcServer := publicrpc.NewPublicrpcServer(logger, db, gst, governanceChainId, governanceEmitter)
+	rpcServer := publicrpc.NewPublicrpcServer(logger, db, gst, governanceChainId, governanceEmitter, attestationEvents)
 	grpcServer := common.NewInstrumentedGRPCServer(logger)
 	publicrpcv1.RegisterPublicRPCServiceServer(grpcServer, rpcServer)
 
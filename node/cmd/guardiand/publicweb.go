@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strings"
 
+	publicrpcv1 "github.com/alephium/wormhole-fork/node/pkg/proto/publicrpc/v1"
 	"github.com/alephium/wormhole-fork/node/pkg/supervisor"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
@@ -71,6 +72,7 @@ func publicwebServiceRunnable(
 		}
 
 		mux := http.NewServeMux()
+		mux.Handle("/v1/signed_vaa/stream", allowCORSWrapper(signedVAAStreamHandler(logger, publicrpcv1.NewPublicRPCServiceClient(conn))))
 		grpcWebServer := grpcweb.WrapServer(grpcServer)
 		mux.Handle("/", allowCORSWrapper(http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
 			if grpcWebServer.IsGrpcWebRequest(req) {
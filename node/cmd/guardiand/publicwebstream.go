@@ -0,0 +1,94 @@
+package guardiand
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	publicrpcv1 "github.com/alephium/wormhole-fork/node/pkg/proto/publicrpc/v1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// parseStreamSignedVAAsQuery builds a StreamSignedVAAsRequest from the query parameters of a REST request.
+// Emitters are given as `emitter=<chain>/<hex address>` and target chains as `target_chain=<chain>`,
+// both can be repeated.
+func parseStreamSignedVAAsQuery(req *http.Request) (*publicrpcv1.StreamSignedVAAsRequest, error) {
+	query := req.URL.Query()
+	streamReq := &publicrpcv1.StreamSignedVAAsRequest{}
+	for _, emitter := range query["emitter"] {
+		parts := strings.Split(emitter, "/")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid emitter %s, expected <chain>/<address>", emitter)
+		}
+		chainId, err := parseChainID(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		streamReq.EmitterFilters = append(streamReq.EmitterFilters, &publicrpcv1.EmitterFilter{
+			ChainId:        publicrpcv1.ChainID(chainId),
+			EmitterAddress: parts[1],
+		})
+	}
+	for _, targetChain := range query["target_chain"] {
+		chainId, err := parseChainID(targetChain)
+		if err != nil {
+			return nil, err
+		}
+		streamReq.TargetChains = append(streamReq.TargetChains, publicrpcv1.ChainID(chainId))
+	}
+	return streamReq, nil
+}
+
+// signedVAAStreamHandler serves StreamSignedVAAs as server-sent events, one JSON encoded
+// StreamSignedVAAsResponse per event.
+func signedVAAStreamHandler(logger *zap.Logger, client publicrpcv1.PublicRPCServiceClient) http.Handler {
+	return http.HandlerFunc(func(resp http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			http.Error(resp, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		flusher, ok := resp.(http.Flusher)
+		if !ok {
+			http.Error(resp, "streaming not supported", http.StatusInternalServerError)
+			return
+		}
+
+		streamReq, err := parseStreamSignedVAAsQuery(req)
+		if err != nil {
+			http.Error(resp, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		stream, err := client.StreamSignedVAAs(req.Context(), streamReq)
+		if err != nil {
+			http.Error(resp, err.Error(), http.StatusBadGateway)
+			return
+		}
+
+		resp.Header().Set("Content-Type", "text/event-stream")
+		resp.Header().Set("Cache-Control", "no-cache")
+		resp.Header().Set("Connection", "keep-alive")
+		resp.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				if req.Context().Err() == nil {
+					logger.Debug("signed VAA stream closed", zap.Error(err))
+				}
+				return
+			}
+			data, err := protojson.Marshal(msg)
+			if err != nil {
+				logger.Error("failed to marshal signed VAA", zap.Error(err))
+				return
+			}
+			if _, err := fmt.Fprintf(resp, "data: %s\n\n", data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	})
+}
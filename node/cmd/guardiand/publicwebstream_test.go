@@ -0,0 +1,27 @@
+package guardiand
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	publicrpcv1 "github.com/alephium/wormhole-fork/node/pkg/proto/publicrpc/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStreamSignedVAAsQuery(t *testing.T) {
+	req := httptest.NewRequest("GET", "/v1/signed_vaa/stream?emitter=ethereum/0001&emitter=4/0002&target_chain=alephium", nil)
+	streamReq, err := parseStreamSignedVAAsQuery(req)
+	require.NoError(t, err)
+	assert.Equal(t, []*publicrpcv1.EmitterFilter{
+		{ChainId: publicrpcv1.ChainID_CHAIN_ID_ETHEREUM, EmitterAddress: "0001"},
+		{ChainId: publicrpcv1.ChainID_CHAIN_ID_BSC, EmitterAddress: "0002"},
+	}, streamReq.EmitterFilters)
+	assert.Equal(t, []publicrpcv1.ChainID{publicrpcv1.ChainID_CHAIN_ID_ALEPHIUM}, streamReq.TargetChains)
+
+	_, err = parseStreamSignedVAAsQuery(httptest.NewRequest("GET", "/v1/signed_vaa/stream?emitter=0001", nil))
+	assert.Error(t, err)
+
+	_, err = parseStreamSignedVAAsQuery(httptest.NewRequest("GET", "/v1/signed_vaa/stream?target_chain=unknown", nil))
+	assert.Error(t, err)
+}
@@ -0,0 +1,120 @@
+package guardiand
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/ecdsasigner"
+	signerv1 "github.com/alephium/wormhole-fork/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	ipfslog "github.com/ipfs/go-log/v2"
+	"github.com/spf13/cobra"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+var (
+	signerListen                 *string
+	signerGuardianKey            *string
+	signerPassphraseFd           *int
+	signerTLSCert                *string
+	signerTLSKey                 *string
+	signerTLSCA                  *string
+	signerMaxSignaturesPerSecond *int
+	signerLogLevel               *string
+)
+
+func init() {
+	signerListen = RemoteSignerCmd.Flags().String("listen", "", "Listen address, either a Unix socket (unix:///path/to/socket) or a TCP address (requires TLS)")
+	signerGuardianKey = RemoteSignerCmd.Flags().String("guardianKey", "", "Path to guardian key (required)")
+	signerPassphraseFd = RemoteSignerCmd.Flags().Int("guardianKeyPassphraseFd", -1, "File descriptor to read the passphrase of an encrypted guardian key from (defaults to $"+GuardianKeyPassphraseEnv+" or the terminal)")
+	signerTLSCert = RemoteSignerCmd.Flags().String("tlsCert", "", "Path to the server TLS certificate")
+	signerTLSKey = RemoteSignerCmd.Flags().String("tlsKey", "", "Path to the server TLS key")
+	signerTLSCA = RemoteSignerCmd.Flags().String("tlsCA", "", "Path to the CA certificate of the guardian TLS certificates")
+	signerMaxSignaturesPerSecond = RemoteSignerCmd.Flags().Int("maxSignaturesPerSecond", 100, "Maximum number of signatures per second, 0 to disable the limit")
+	signerLogLevel = RemoteSignerCmd.Flags().String("logLevel", "info", "Logging level (debug, info, warn, error, dpanic, panic, fatal)")
+}
+
+// RemoteSignerCmd runs a reference remote signer, which holds the guardian key for guardians started with
+// --remoteSignerEnabled.
+var RemoteSignerCmd = &cobra.Command{
+	Use:   "remote-signer",
+	Short: "Run a remote signer holding the guardian key",
+	Run:   runRemoteSigner,
+}
+
+func runRemoteSigner(cmd *cobra.Command, args []string) {
+	common.SetRestrictiveUmask()
+	common.LockMemory()
+
+	lvl, err := ipfslog.LevelFromString(*signerLogLevel)
+	if err != nil {
+		fmt.Println("Invalid log level")
+		os.Exit(1)
+	}
+	logger := ipfslog.Logger("wormhole-remote-signer").Desugar()
+	ipfslog.SetAllLoggers(lvl)
+
+	if *signerListen == "" {
+		logger.Fatal("Please specify --listen")
+	}
+	if *signerGuardianKey == "" {
+		logger.Fatal("Please specify --guardianKey")
+	}
+
+	var opts []grpc.ServerOption
+	var l net.Listener
+	if socketPath := strings.TrimPrefix(*signerListen, "unix://"); socketPath != *signerListen {
+		if fi, err := os.Stat(socketPath); err == nil {
+			if fi.Mode()&os.ModeType != os.ModeSocket {
+				logger.Fatal("listen path is not a UNIX socket", zap.String("path", socketPath))
+			}
+			if err := os.Remove(socketPath); err != nil {
+				logger.Fatal("failed to remove existing socket", zap.String("path", socketPath), zap.Error(err))
+			}
+		}
+		l, err = net.Listen("unix", socketPath)
+	} else {
+		if *signerTLSCert == "" || *signerTLSKey == "" || *signerTLSCA == "" {
+			logger.Fatal("Please specify --tlsCert, --tlsKey and --tlsCA to listen on TCP")
+		}
+		tlsConfig, tlsErr := ecdsasigner.NewMutualTLSConfig(*signerTLSCert, *signerTLSKey, *signerTLSCA, true)
+		if tlsErr != nil {
+			logger.Fatal("failed to load TLS config", zap.Error(tlsErr))
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		l, err = net.Listen("tcp", *signerListen)
+	}
+	if err != nil {
+		logger.Fatal("failed to listen", zap.String("address", *signerListen), zap.Error(err))
+	}
+
+	gk, err := loadGuardianKey(*signerGuardianKey, guardianKeyPassphrase(*signerPassphraseFd, "Enter guardian key passphrase: "))
+	if err != nil {
+		logger.Fatal("failed to load guardian key", zap.Error(err))
+	}
+	logger.Info("loaded guardian key", zap.String("address", ethcrypto.PubkeyToAddress(gk.PublicKey).String()))
+
+	grpcServer := common.NewInstrumentedGRPCServer(logger, opts...)
+	signerv1.RegisterRemoteSignerServiceServer(grpcServer,
+		ecdsasigner.NewRemoteSignerServer(logger, &ecdsasigner.ECDSAPrivateKey{Value: gk}, *signerMaxSignaturesPerSecond))
+
+	sigC := make(chan os.Signal, 1)
+	signal.Notify(sigC, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigC
+		logger.Info("shutting down")
+		grpcServer.GracefulStop()
+	}()
+
+	logger.Info("remote signer listening", zap.String("address", *signerListen))
+	if err := grpcServer.Serve(l); err != nil {
+		logger.Fatal("remote signer failed", zap.Error(err))
+	}
+}
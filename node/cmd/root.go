@@ -49,6 +49,8 @@ func init() {
 	rootCmd.AddCommand(guardiand.NodeCmd)
 	rootCmd.AddCommand(spy.SpyCmd)
 	rootCmd.AddCommand(guardiand.KeygenCmd)
+	rootCmd.AddCommand(guardiand.KeyCmd)
+	rootCmd.AddCommand(guardiand.RemoteSignerCmd)
 	rootCmd.AddCommand(guardiand.AdminCmd)
 	rootCmd.AddCommand(guardiand.TemplateCmd)
 	rootCmd.AddCommand(versionCmd)
@@ -0,0 +1,125 @@
+package spy
+
+import (
+	"fmt"
+	"math"
+
+	spyv1 "github.com/alephium/wormhole-fork/node/pkg/proto/spy/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type emitterFilter struct {
+	chainId     vaa.ChainID
+	emitterAddr vaa.Address
+}
+
+type sequenceRange struct {
+	from uint64
+	to   uint64
+}
+
+// filter is the set of filters of a subscription. Filters of the same type are combined with OR,
+// and the resulting groups with AND. An empty group matches all VAAs.
+type filter struct {
+	emitters     []emitterFilter
+	targetChains []vaa.ChainID
+	payloadIds   []uint8
+	sequences    []sequenceRange
+}
+
+func newFilter(entries []*spyv1.FilterEntry) (*filter, error) {
+	f := &filter{}
+	for _, entry := range entries {
+		switch t := entry.Filter.(type) {
+		case *spyv1.FilterEntry_EmitterFilter:
+			addr, err := decodeEmitterAddr(t.EmitterFilter.EmitterAddress)
+			if err != nil {
+				return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode emitter address: %v", err))
+			}
+			f.emitters = append(f.emitters, emitterFilter{
+				chainId:     vaa.ChainID(t.EmitterFilter.ChainId),
+				emitterAddr: addr,
+			})
+		case *spyv1.FilterEntry_TargetChainFilter:
+			f.targetChains = append(f.targetChains, vaa.ChainID(t.TargetChainFilter.ChainId))
+		case *spyv1.FilterEntry_PayloadIdFilter:
+			if t.PayloadIdFilter.PayloadId > math.MaxUint8 {
+				return nil, status.Error(codes.InvalidArgument, "payload id must be a single byte")
+			}
+			f.payloadIds = append(f.payloadIds, uint8(t.PayloadIdFilter.PayloadId))
+		case *spyv1.FilterEntry_SequenceRangeFilter:
+			r := sequenceRange{from: t.SequenceRangeFilter.FromSequence, to: t.SequenceRangeFilter.ToSequence}
+			if r.to == 0 {
+				r.to = math.MaxUint64
+			}
+			if r.from > r.to {
+				return nil, status.Error(codes.InvalidArgument, "invalid sequence range")
+			}
+			f.sequences = append(f.sequences, r)
+		default:
+			return nil, status.Error(codes.InvalidArgument, "unsupported filter type")
+		}
+	}
+	return f, nil
+}
+
+func (f *filter) isEmpty() bool {
+	return len(f.emitters) == 0 && len(f.targetChains) == 0 && len(f.payloadIds) == 0 && len(f.sequences) == 0
+}
+
+func (f *filter) matches(v *vaa.VAA) bool {
+	return f.matchesEmitter(v) && f.matchesTargetChain(v) && f.matchesPayloadId(v) && f.matchesSequence(v)
+}
+
+func (f *filter) matchesEmitter(v *vaa.VAA) bool {
+	if len(f.emitters) == 0 {
+		return true
+	}
+	for _, e := range f.emitters {
+		if e.chainId == v.EmitterChain && e.emitterAddr == v.EmitterAddress {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *filter) matchesTargetChain(v *vaa.VAA) bool {
+	if len(f.targetChains) == 0 {
+		return true
+	}
+	for _, chainId := range f.targetChains {
+		if chainId == v.TargetChain {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *filter) matchesPayloadId(v *vaa.VAA) bool {
+	if len(f.payloadIds) == 0 {
+		return true
+	}
+	if len(v.Payload) == 0 {
+		return false
+	}
+	for _, payloadId := range f.payloadIds {
+		if payloadId == v.Payload[0] {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *filter) matchesSequence(v *vaa.VAA) bool {
+	if len(f.sequences) == 0 {
+		return true
+	}
+	for _, r := range f.sequences {
+		if v.Sequence >= r.from && v.Sequence <= r.to {
+			return true
+		}
+	}
+	return false
+}
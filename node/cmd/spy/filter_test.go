@@ -0,0 +1,109 @@
+package spy
+
+import (
+	"encoding/hex"
+	"testing"
+
+	publicrpcv1 "github.com/alephium/wormhole-fork/node/pkg/proto/publicrpc/v1"
+	spyv1 "github.com/alephium/wormhole-fork/node/pkg/proto/spy/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	emitter0 = vaa.Address{1}
+	emitter1 = vaa.Address{2}
+)
+
+func emitterEntry(chainId vaa.ChainID, addr vaa.Address) *spyv1.FilterEntry {
+	return &spyv1.FilterEntry{Filter: &spyv1.FilterEntry_EmitterFilter{EmitterFilter: &spyv1.EmitterFilter{
+		ChainId:        publicrpcv1.ChainID(chainId),
+		EmitterAddress: hex.EncodeToString(addr[:]),
+	}}}
+}
+
+func targetChainEntry(chainId vaa.ChainID) *spyv1.FilterEntry {
+	return &spyv1.FilterEntry{Filter: &spyv1.FilterEntry_TargetChainFilter{TargetChainFilter: &spyv1.TargetChainFilter{
+		ChainId: publicrpcv1.ChainID(chainId),
+	}}}
+}
+
+func payloadIdEntry(payloadId uint32) *spyv1.FilterEntry {
+	return &spyv1.FilterEntry{Filter: &spyv1.FilterEntry_PayloadIdFilter{PayloadIdFilter: &spyv1.PayloadIdFilter{
+		PayloadId: payloadId,
+	}}}
+}
+
+func sequenceRangeEntry(from, to uint64) *spyv1.FilterEntry {
+	return &spyv1.FilterEntry{Filter: &spyv1.FilterEntry_SequenceRangeFilter{SequenceRangeFilter: &spyv1.SequenceRangeFilter{
+		FromSequence: from,
+		ToSequence:   to,
+	}}}
+}
+
+func testVAA(emitterChain vaa.ChainID, emitter vaa.Address, targetChain vaa.ChainID, sequence uint64, payloadId byte) *vaa.VAA {
+	return &vaa.VAA{
+		Version:        vaa.SupportedVAAVersion,
+		EmitterChain:   emitterChain,
+		EmitterAddress: emitter,
+		TargetChain:    targetChain,
+		Sequence:       sequence,
+		Payload:        []byte{payloadId, 0, 0},
+	}
+}
+
+func TestEmptyFilter(t *testing.T) {
+	f, err := newFilter(nil)
+	assert.Nil(t, err)
+	assert.True(t, f.isEmpty())
+	assert.True(t, f.matches(testVAA(vaa.ChainIDEthereum, emitter0, vaa.ChainIDAlephium, 0, 1)))
+}
+
+func TestFilterCombination(t *testing.T) {
+	f, err := newFilter([]*spyv1.FilterEntry{
+		emitterEntry(vaa.ChainIDEthereum, emitter0),
+		emitterEntry(vaa.ChainIDBSC, emitter1),
+		targetChainEntry(vaa.ChainIDAlephium),
+		payloadIdEntry(1),
+		sequenceRangeEntry(10, 20),
+		sequenceRangeEntry(100, 0),
+	})
+	assert.Nil(t, err)
+	assert.False(t, f.isEmpty())
+
+	assert.True(t, f.matches(testVAA(vaa.ChainIDEthereum, emitter0, vaa.ChainIDAlephium, 10, 1)))
+	assert.True(t, f.matches(testVAA(vaa.ChainIDBSC, emitter1, vaa.ChainIDAlephium, 20, 1)))
+	assert.True(t, f.matches(testVAA(vaa.ChainIDBSC, emitter1, vaa.ChainIDAlephium, 1000, 1)))
+
+	// emitter mismatch
+	assert.False(t, f.matches(testVAA(vaa.ChainIDEthereum, emitter1, vaa.ChainIDAlephium, 10, 1)))
+	// target chain mismatch
+	assert.False(t, f.matches(testVAA(vaa.ChainIDEthereum, emitter0, vaa.ChainIDBSC, 10, 1)))
+	// payload id mismatch
+	assert.False(t, f.matches(testVAA(vaa.ChainIDEthereum, emitter0, vaa.ChainIDAlephium, 10, 2)))
+	// sequence out of range
+	assert.False(t, f.matches(testVAA(vaa.ChainIDEthereum, emitter0, vaa.ChainIDAlephium, 21, 1)))
+	assert.False(t, f.matches(testVAA(vaa.ChainIDEthereum, emitter0, vaa.ChainIDAlephium, 9, 1)))
+}
+
+func TestPayloadIdFilterEmptyPayload(t *testing.T) {
+	f, err := newFilter([]*spyv1.FilterEntry{payloadIdEntry(1)})
+	assert.Nil(t, err)
+	v := testVAA(vaa.ChainIDEthereum, emitter0, vaa.ChainIDAlephium, 0, 1)
+	v.Payload = nil
+	assert.False(t, f.matches(v))
+}
+
+func TestInvalidFilters(t *testing.T) {
+	_, err := newFilter([]*spyv1.FilterEntry{payloadIdEntry(256)})
+	assert.NotNil(t, err)
+
+	_, err = newFilter([]*spyv1.FilterEntry{sequenceRangeEntry(10, 9)})
+	assert.NotNil(t, err)
+
+	_, err = newFilter([]*spyv1.FilterEntry{{Filter: &spyv1.FilterEntry_EmitterFilter{EmitterFilter: &spyv1.EmitterFilter{EmitterAddress: "00"}}}})
+	assert.NotNil(t, err)
+
+	_, err = newFilter([]*spyv1.FilterEntry{{}})
+	assert.NotNil(t, err)
+}
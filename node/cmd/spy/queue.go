@@ -0,0 +1,169 @@
+package spy
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	subscriptionQueueDepth = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_spy_subscription_queue_depth",
+			Help: "Number of VAAs waiting to be sent to a subscriber",
+		}, []string{"subscription"})
+	subscriptionDropped = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_spy_subscription_dropped_total",
+			Help: "Total number of VAAs dropped because the queue of a subscriber was full",
+		}, []string{"subscription"})
+	subscriptionsDisconnected = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "wormhole_spy_subscriptions_disconnected_total",
+			Help: "Total number of subscribers disconnected because their queue was full",
+		})
+)
+
+// overflowPolicy is the behaviour of a subscription when a VAA is published while its queue is full.
+type overflowPolicy string
+
+const (
+	// overflowDropOldest drops the oldest queued VAA to make room for the new one.
+	overflowDropOldest overflowPolicy = "drop-oldest"
+	// overflowDisconnect disconnects the subscriber, which can resubscribe and replay the missed VAAs.
+	overflowDisconnect overflowPolicy = "disconnect"
+	// overflowBlock blocks the publisher until there is room in the queue, and drops the VAA after a timeout.
+	overflowBlock overflowPolicy = "block"
+)
+
+type queueConfig struct {
+	size         int
+	policy       overflowPolicy
+	blockTimeout time.Duration
+}
+
+func newQueueConfig(size uint, policy string, blockTimeout time.Duration) (*queueConfig, error) {
+	if size == 0 {
+		return nil, fmt.Errorf("queue size must be positive")
+	}
+	switch p := overflowPolicy(policy); p {
+	case overflowDropOldest, overflowDisconnect, overflowBlock:
+		return &queueConfig{size: int(size), policy: p, blockTimeout: blockTimeout}, nil
+	default:
+		return nil, fmt.Errorf("invalid overflow policy %s", policy)
+	}
+}
+
+type subscription struct {
+	id     string
+	filter *filter
+	config *queueConfig
+	ch     chan message
+
+	// overflow is closed when the subscriber is disconnected because its queue is full.
+	overflow     chan struct{}
+	overflowOnce sync.Once
+	// done is closed when the subscriber is gone.
+	done chan struct{}
+
+	// Live messages are buffered while stored VAAs are replayed to the subscriber, guarded by subsMu.
+	replaying bool
+	buffer    []message
+}
+
+func newSubscription(id string, filter *filter, config *queueConfig, replaying bool) *subscription {
+	return &subscription{
+		id:        id,
+		filter:    filter,
+		config:    config,
+		ch:        make(chan message, config.size),
+		overflow:  make(chan struct{}),
+		done:      make(chan struct{}),
+		replaying: replaying,
+	}
+}
+
+func (sub *subscription) disconnect() {
+	sub.overflowOnce.Do(func() {
+		subscriptionsDisconnected.Inc()
+		close(sub.overflow)
+	})
+}
+
+func (sub *subscription) drop() {
+	subscriptionDropped.WithLabelValues(sub.id).Inc()
+}
+
+func (sub *subscription) updateQueueDepth() {
+	subscriptionQueueDepth.WithLabelValues(sub.id).Set(float64(len(sub.ch)))
+}
+
+// close releases the metrics of the subscription once the subscriber is gone.
+func (sub *subscription) close() {
+	close(sub.done)
+	subscriptionQueueDepth.DeleteLabelValues(sub.id)
+	subscriptionDropped.DeleteLabelValues(sub.id)
+}
+
+// bufferMessage buffers a live message while replaying, subsMu must be held. The buffer is bounded by the queue
+// size. Since the subscriber doesn't consume the buffer until the replay is done, the block policy disconnects it.
+func (sub *subscription) bufferMessage(msg message) {
+	if len(sub.buffer) < sub.config.size {
+		sub.buffer = append(sub.buffer, msg)
+		return
+	}
+	sub.drop()
+	if sub.config.policy == overflowDropOldest {
+		sub.buffer = append(sub.buffer[1:], msg)
+		return
+	}
+	sub.disconnect()
+}
+
+// enqueue adds a message to the queue of the subscriber, and applies the overflow policy if it is full.
+func (sub *subscription) enqueue(msg message) {
+	select {
+	case <-sub.done:
+		// The subscriber is gone since it was routed the message
+		return
+	default:
+	}
+	defer sub.updateQueueDepth()
+
+	select {
+	case sub.ch <- msg:
+		return
+	default:
+	}
+
+	switch sub.config.policy {
+	case overflowDropOldest:
+		for {
+			select {
+			case <-sub.ch:
+				sub.drop()
+			default:
+			}
+			select {
+			case sub.ch <- msg:
+				return
+			default:
+			}
+		}
+	case overflowDisconnect:
+		sub.drop()
+		sub.disconnect()
+	case overflowBlock:
+		timer := time.NewTimer(sub.config.blockTimeout)
+		defer timer.Stop()
+		select {
+		case sub.ch <- msg:
+		case <-timer.C:
+			sub.drop()
+		case <-sub.done:
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package spy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testQueueConfig = &queueConfig{size: 10, policy: overflowDisconnect}
+
+func testMessage(b byte) message {
+	return message{vaaBytes: []byte{b}}
+}
+
+func isClosed(ch chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestNewQueueConfig(t *testing.T) {
+	config, err := newQueueConfig(100, "drop-oldest", time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, &queueConfig{size: 100, policy: overflowDropOldest, blockTimeout: time.Second}, config)
+
+	_, err = newQueueConfig(0, "disconnect", time.Second)
+	assert.NotNil(t, err)
+
+	_, err = newQueueConfig(100, "unknown", time.Second)
+	assert.NotNil(t, err)
+}
+
+func TestEnqueueDropOldest(t *testing.T) {
+	sub := newSubscription("drop-oldest", &filter{}, &queueConfig{size: 2, policy: overflowDropOldest}, false)
+	defer sub.close()
+
+	for i := byte(0); i < 4; i++ {
+		sub.enqueue(testMessage(i))
+	}
+	assert.False(t, isClosed(sub.overflow))
+	assert.Equal(t, testMessage(2), <-sub.ch)
+	assert.Equal(t, testMessage(3), <-sub.ch)
+}
+
+func TestEnqueueDisconnect(t *testing.T) {
+	sub := newSubscription("disconnect", &filter{}, &queueConfig{size: 2, policy: overflowDisconnect}, false)
+	defer sub.close()
+
+	sub.enqueue(testMessage(0))
+	sub.enqueue(testMessage(1))
+	assert.False(t, isClosed(sub.overflow))
+	sub.enqueue(testMessage(2))
+	assert.True(t, isClosed(sub.overflow))
+	// Disconnecting more than once must not panic
+	sub.enqueue(testMessage(3))
+}
+
+func TestEnqueueBlock(t *testing.T) {
+	sub := newSubscription("block", &filter{}, &queueConfig{size: 1, policy: overflowBlock, blockTimeout: 50 * time.Millisecond}, false)
+	defer sub.close()
+
+	sub.enqueue(testMessage(0))
+
+	// The message is dropped after the timeout
+	start := time.Now()
+	sub.enqueue(testMessage(1))
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	assert.Equal(t, 1, len(sub.ch))
+
+	// The message is queued once the subscriber catches up
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		<-sub.ch
+	}()
+	sub.enqueue(testMessage(2))
+	assert.Equal(t, testMessage(2), <-sub.ch)
+	assert.False(t, isClosed(sub.overflow))
+}
+
+func TestBufferMessage(t *testing.T) {
+	sub := newSubscription("buffer-drop-oldest", &filter{}, &queueConfig{size: 2, policy: overflowDropOldest}, true)
+	defer sub.close()
+	for i := byte(0); i < 3; i++ {
+		sub.bufferMessage(testMessage(i))
+	}
+	assert.Equal(t, []message{testMessage(1), testMessage(2)}, sub.buffer)
+	assert.False(t, isClosed(sub.overflow))
+
+	sub = newSubscription("buffer-block", &filter{}, &queueConfig{size: 2, policy: overflowBlock}, true)
+	defer sub.close()
+	for i := byte(0); i < 3; i++ {
+		sub.bufferMessage(testMessage(i))
+	}
+	assert.Equal(t, []message{testMessage(0), testMessage(1)}, sub.buffer)
+	assert.True(t, isClosed(sub.overflow))
+}
@@ -0,0 +1,85 @@
+package spy
+
+import (
+	"fmt"
+
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	spyv1 "github.com/alephium/wormhole-fork/node/pkg/proto/spy/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func decodeReplayFrom(entries []*spyv1.ReplayFrom) ([]vaa.VAAID, error) {
+	ids := make([]vaa.VAAID, 0, len(entries))
+	for _, entry := range entries {
+		addr, err := decodeEmitterAddr(entry.EmitterAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode emitter address: %v", err))
+		}
+		ids = append(ids, vaa.VAAID{
+			EmitterChain:   vaa.ChainID(entry.EmitterChain),
+			EmitterAddress: addr,
+			TargetChain:    vaa.ChainID(entry.TargetChain),
+			Sequence:       entry.Sequence,
+		})
+	}
+	return ids, nil
+}
+
+// replay streams the stored VAAs matching the subscription filters, and then the live VAAs which have been
+// buffered in the meantime. Buffered VAAs which have already been replayed are skipped, and the subscription
+// switches to live delivery.
+func (s *spyServer) replay(sub *subscription, replay []vaa.VAAID, resp spyv1.SpyRPCService_SubscribeSignedVAAServer) error {
+	replayed := make(map[string]struct{})
+	for _, from := range replay {
+		vaas, err := s.db.GetSignedVAAsFromSequence(from)
+		if err != nil {
+			s.logger.Error("failed to get stored VAAs", zap.String("from", from.ToString()), zap.Error(err))
+			return status.Error(codes.Internal, "failed to get stored VAAs")
+		}
+
+		for _, v := range vaas {
+			if !sub.filter.matches(v) {
+				continue
+			}
+			vaaBytes, err := v.Marshal()
+			if err != nil {
+				return status.Error(codes.Internal, fmt.Sprintf("failed to marshal VAA: %v", err))
+			}
+			if err := resp.Send(&spyv1.SubscribeSignedVAAResponse{VaaBytes: vaaBytes}); err != nil {
+				return err
+			}
+			replayed[db.VaaIDFromVAA(v).ToString()] = struct{}{}
+		}
+	}
+
+	for {
+		s.subsMu.Lock()
+		buffered := sub.buffer
+		sub.buffer = nil
+		if len(buffered) == 0 {
+			// Live VAAs published from now on were not in the store while replaying
+			sub.replaying = false
+		}
+		s.subsMu.Unlock()
+
+		if len(buffered) == 0 {
+			return nil
+		}
+
+		for _, msg := range buffered {
+			v, err := vaa.Unmarshal(msg.vaaBytes)
+			if err != nil {
+				return status.Error(codes.Internal, fmt.Sprintf("failed to unmarshal VAA: %v", err))
+			}
+			if _, ok := replayed[db.VaaIDFromVAA(v).ToString()]; ok {
+				continue
+			}
+			if err := resp.Send(&spyv1.SubscribeSignedVAAResponse{VaaBytes: msg.vaaBytes}); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package spy
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	publicrpcv1 "github.com/alephium/wormhole-fork/node/pkg/proto/publicrpc/v1"
+	spyv1 "github.com/alephium/wormhole-fork/node/pkg/proto/spy/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+type mockStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	ch  chan *spyv1.SubscribeSignedVAAResponse
+}
+
+func (m *mockStream) Send(resp *spyv1.SubscribeSignedVAAResponse) error {
+	m.ch <- resp
+	return nil
+}
+
+func (m *mockStream) Context() context.Context {
+	return m.ctx
+}
+
+func signedVAABytes(t *testing.T, key *ecdsa.PrivateKey, sequence uint64) []byte {
+	v := testVAA(vaa.ChainIDEthereum, emitter0, vaa.ChainIDAlephium, sequence, 1)
+	v.Timestamp = time.Unix(0, 0)
+	v.AddSignature(key, 0)
+	b, err := v.Marshal()
+	assert.Nil(t, err)
+	return b
+}
+
+func receiveSequence(t *testing.T, ch chan *spyv1.SubscribeSignedVAAResponse) uint64 {
+	select {
+	case resp := <-ch:
+		v, err := vaa.Unmarshal(resp.VaaBytes)
+		assert.Nil(t, err)
+		return v.Sequence
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for VAA")
+		return 0
+	}
+}
+
+func TestReplayStoredVAAs(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+
+	key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	assert.Nil(t, err)
+
+	s := newSpyServer(zap.NewNop(), database, testQueueConfig)
+	for sequence := uint64(1); sequence <= 3; sequence++ {
+		assert.Nil(t, s.Publish(signedVAABytes(t, key, sequence)))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &mockStream{ctx: ctx, ch: make(chan *spyv1.SubscribeSignedVAAResponse, 10)}
+	req := &spyv1.SubscribeSignedVAARequest{
+		Replay: []*spyv1.ReplayFrom{{
+			EmitterChain:   publicrpcv1.ChainID(vaa.ChainIDEthereum),
+			EmitterAddress: hex.EncodeToString(emitter0[:]),
+			TargetChain:    publicrpcv1.ChainID(vaa.ChainIDAlephium),
+			Sequence:       2,
+		}},
+	}
+	errC := make(chan error, 1)
+	go func() {
+		errC <- s.SubscribeSignedVAA(req, stream)
+	}()
+
+	assert.Equal(t, uint64(2), receiveSequence(t, stream.ch))
+	assert.Equal(t, uint64(3), receiveSequence(t, stream.ch))
+
+	assert.Nil(t, s.Publish(signedVAABytes(t, key, 4)))
+	assert.Equal(t, uint64(4), receiveSequence(t, stream.ch))
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-errC)
+	assert.Equal(t, 0, len(stream.ch))
+}
+
+func TestReplayWithoutStore(t *testing.T) {
+	s := newSpyServer(zap.NewNop(), nil, testQueueConfig)
+	stream := &mockStream{ctx: context.Background(), ch: make(chan *spyv1.SubscribeSignedVAAResponse, 1)}
+	req := &spyv1.SubscribeSignedVAARequest{
+		Replay: []*spyv1.ReplayFrom{{EmitterAddress: hex.EncodeToString(emitter0[:])}},
+	}
+	assert.NotNil(t, s.SubscribeSignedVAA(req, stream))
+}
+
+func TestStoredVAAsAreNotReplaced(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+
+	key, err := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	assert.Nil(t, err)
+
+	s := newSpyServer(zap.NewNop(), database, testQueueConfig)
+	stored := testVAA(vaa.ChainIDEthereum, emitter0, vaa.ChainIDAlephium, 1, 1)
+	stored.AddSignature(key, 0)
+	conflicting := testVAA(vaa.ChainIDEthereum, emitter0, vaa.ChainIDAlephium, 1, 2)
+	conflicting.AddSignature(key, 0)
+	for _, v := range []*vaa.VAA{stored, conflicting} {
+		b, err := v.Marshal()
+		assert.Nil(t, err)
+		assert.Nil(t, s.Publish(b))
+	}
+
+	b, err := database.GetSignedVAABytes(*db.VaaIDFromVAA(stored))
+	assert.Nil(t, err)
+	expected, err := stored.Marshal()
+	assert.Nil(t, err)
+	assert.Equal(t, expected, b)
+}
@@ -7,14 +7,20 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"path"
 	"sync"
+	"time"
 
 	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/ethereum"
 	"github.com/alephium/wormhole-fork/node/pkg/p2p"
 	gossipv1 "github.com/alephium/wormhole-fork/node/pkg/proto/gossip/v1"
+	publicrpcv1 "github.com/alephium/wormhole-fork/node/pkg/proto/publicrpc/v1"
 	spyv1 "github.com/alephium/wormhole-fork/node/pkg/proto/spy/v1"
 	"github.com/alephium/wormhole-fork/node/pkg/supervisor"
 	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	ipfslog "github.com/ipfs/go-log/v2"
@@ -24,6 +30,7 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 )
 
@@ -44,6 +51,17 @@ var (
 	logLevel *string
 
 	spyRPC *string
+
+	dataDir *string
+
+	subscriberQueueSize    *uint
+	subscriberOverflow     *string
+	subscriberBlockTimeout *time.Duration
+
+	verifyEthRPC               *string
+	verifyEthContract          *string
+	verifyGuardianRPC          *string
+	guardianSetRefreshInterval *time.Duration
 )
 
 func init() {
@@ -58,6 +76,17 @@ func init() {
 	logLevel = SpyCmd.Flags().String("logLevel", "info", "Logging level (debug, info, warn, error, dpanic, panic, fatal)")
 
 	spyRPC = SpyCmd.Flags().String("spyRPC", "", "Listen address for gRPC interface")
+
+	dataDir = SpyCmd.Flags().String("dataDir", "", "Data directory of the local VAA store, VAAs are not stored if empty")
+
+	subscriberQueueSize = SpyCmd.Flags().Uint("subscriberQueueSize", 1000, "Maximum number of VAAs queued for a subscriber")
+	subscriberOverflow = SpyCmd.Flags().String("subscriberOverflow", string(overflowDisconnect), "Policy when the queue of a subscriber is full (drop-oldest, disconnect, block)")
+	subscriberBlockTimeout = SpyCmd.Flags().Duration("subscriberBlockTimeout", time.Second, "Maximum time to wait for room in the queue of a subscriber with the block policy")
+
+	verifyEthRPC = SpyCmd.Flags().String("verifyEthRPC", "", "Ethereum RPC URL to fetch the guardian set from, signed VAAs are verified against it if set")
+	verifyEthContract = SpyCmd.Flags().String("verifyEthContract", "", "Ethereum core bridge contract address used with --verifyEthRPC")
+	verifyGuardianRPC = SpyCmd.Flags().String("verifyGuardianRPC", "", "Guardian public gRPC address to fetch the guardian set from, signed VAAs are verified against it if set")
+	guardianSetRefreshInterval = SpyCmd.Flags().Duration("guardianSetRefreshInterval", time.Minute, "Interval between guardian set updates when verifying signed VAAs")
 }
 
 // SpyCmd represents the node command
@@ -72,22 +101,15 @@ type spyServer struct {
 	logger *zap.Logger
 	subs   map[string]*subscription
 	subsMu sync.Mutex
+	// db is the local VAA store used to replay VAAs to new subscribers, nil if disabled.
+	db    *db.Database
+	queue *queueConfig
 }
 
 type message struct {
 	vaaBytes []byte
 }
 
-type filter struct {
-	chainId     vaa.ChainID
-	emitterAddr vaa.Address
-}
-
-type subscription struct {
-	filters []filter
-	ch      chan message
-}
-
 func subscriptionId() string {
 	return uuid.New().String()
 }
@@ -108,60 +130,92 @@ func decodeEmitterAddr(hexAddr string) (vaa.Address, error) {
 }
 
 func (s *spyServer) Publish(vaaBytes []byte) error {
+	live, err := s.route(vaaBytes)
+	if err != nil {
+		return err
+	}
+
+	// Messages are queued without holding subsMu, so that a blocking subscriber doesn't prevent others from
+	// subscribing or unsubscribing.
+	for _, sub := range live {
+		sub.enqueue(message{vaaBytes: vaaBytes})
+	}
+	return nil
+}
+
+// route stores the VAA and returns the live subscriptions it must be sent to. Subscriptions which are replaying
+// stored VAAs buffer it instead.
+func (s *spyServer) route(vaaBytes []byte) ([]*subscription, error) {
 	s.subsMu.Lock()
 	defer s.subsMu.Unlock()
 
+	// The VAA is parsed at most once, and only if it is stored or a subscription has filters.
 	var v *vaa.VAA
+	parse := func() error {
+		if v != nil {
+			return nil
+		}
+		var err error
+		v, err = vaa.Unmarshal(vaaBytes)
+		return err
+	}
 
+	// The VAA is stored while holding subsMu, so that subscribers which are replaying stored VAAs either
+	// find it in the store, or receive it once they switch to live delivery.
+	if s.db != nil {
+		if err := parse(); err != nil {
+			return nil, err
+		}
+		if len(v.Signatures) == 0 {
+			return nil, fmt.Errorf("unsigned VAA %s", db.VaaIDFromVAA(v).ToString())
+		}
+		// The first VAA stored under an ID is kept, it can't be replaced by another body with the same ID.
+		_, err := s.db.GetSignedVAABytes(*db.VaaIDFromVAA(v))
+		if err == db.ErrVAANotFound {
+			err = s.db.StoreSignedVAA(v)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	live := make([]*subscription, 0, len(s.subs))
 	for _, sub := range s.subs {
-		if len(sub.filters) == 0 {
-			sub.ch <- message{vaaBytes: vaaBytes}
-		} else {
-			if v == nil {
-				var err error
-				v, err = vaa.Unmarshal(vaaBytes)
-				if err != nil {
-					return err
-				}
+		if !sub.filter.isEmpty() {
+			if err := parse(); err != nil {
+				return nil, err
 			}
-
-			for _, fi := range sub.filters {
-				if fi.chainId == v.EmitterChain && fi.emitterAddr == v.EmitterAddress {
-					sub.ch <- message{vaaBytes: vaaBytes}
-				}
+			if !sub.filter.matches(v) {
+				continue
 			}
 		}
+
+		if sub.replaying {
+			sub.bufferMessage(message{vaaBytes: vaaBytes})
+		} else {
+			live = append(live, sub)
+		}
 	}
 
-	return nil
+	return live, nil
 }
 
 func (s *spyServer) SubscribeSignedVAA(req *spyv1.SubscribeSignedVAARequest, resp spyv1.SpyRPCService_SubscribeSignedVAAServer) error {
-	var fi []filter
-	if req.Filters != nil {
-		for _, f := range req.Filters {
-			switch t := f.Filter.(type) {
-			case *spyv1.FilterEntry_EmitterFilter:
-				addr, err := decodeEmitterAddr(t.EmitterFilter.EmitterAddress)
-				if err != nil {
-					return status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode emitter address: %v", err))
-				}
-				fi = append(fi, filter{
-					chainId:     vaa.ChainID(t.EmitterFilter.ChainId),
-					emitterAddr: addr,
-				})
-			default:
-				return status.Error(codes.InvalidArgument, "unsupported filter type")
-			}
-		}
+	fi, err := newFilter(req.Filters)
+	if err != nil {
+		return err
+	}
+	replay, err := decodeReplayFrom(req.Replay)
+	if err != nil {
+		return err
+	}
+	if len(replay) != 0 && s.db == nil {
+		return status.Error(codes.FailedPrecondition, "replay requires the spy to run with a VAA store")
 	}
 
 	s.subsMu.Lock()
 	id := subscriptionId()
-	sub := &subscription{
-		ch:      make(chan message, 1),
-		filters: fi,
-	}
+	sub := newSubscription(id, fi, s.queue, len(replay) != 0)
 	s.subs[id] = sub
 	s.subsMu.Unlock()
 
@@ -169,13 +223,23 @@ func (s *spyServer) SubscribeSignedVAA(req *spyv1.SubscribeSignedVAARequest, res
 		s.subsMu.Lock()
 		defer s.subsMu.Unlock()
 		delete(s.subs, id)
+		sub.close()
 	}()
 
+	if sub.replaying {
+		if err := s.replay(sub, replay, resp); err != nil {
+			return err
+		}
+	}
+
 	for {
 		select {
 		case <-resp.Context().Done():
 			return resp.Context().Err()
+		case <-sub.overflow:
+			return status.Error(codes.ResourceExhausted, "too many VAAs queued for the subscriber")
 		case msg := <-sub.ch:
+			sub.updateQueueDepth()
 			if err := resp.Send(&spyv1.SubscribeSignedVAAResponse{
 				VaaBytes: msg.vaaBytes,
 			}); err != nil {
@@ -185,10 +249,12 @@ func (s *spyServer) SubscribeSignedVAA(req *spyv1.SubscribeSignedVAARequest, res
 	}
 }
 
-func newSpyServer(logger *zap.Logger) *spyServer {
+func newSpyServer(logger *zap.Logger, db *db.Database, queue *queueConfig) *spyServer {
 	return &spyServer{
 		logger: logger.Named("spyserver"),
 		subs:   make(map[string]*subscription),
+		db:     db,
+		queue:  queue,
 	}
 }
 
@@ -239,6 +305,20 @@ func runSpy(cmd *cobra.Command, args []string) {
 	if *p2pBootstrap == "" {
 		logger.Fatal("Please specify --bootstrap")
 	}
+	queue, err := newQueueConfig(*subscriberQueueSize, *subscriberOverflow, *subscriberBlockTimeout)
+	if err != nil {
+		logger.Fatal("Invalid subscriber queue settings", zap.Error(err))
+	}
+	if *verifyEthRPC != "" && *verifyGuardianRPC != "" {
+		logger.Fatal("Please specify only one of --verifyEthRPC and --verifyGuardianRPC")
+	}
+	if *verifyEthRPC != "" && !ethcommon.IsHexAddress(*verifyEthContract) {
+		logger.Fatal("Please specify a valid --verifyEthContract")
+	}
+	// Anyone on the network can gossip signed VAAs, only verified ones may be stored and replayed.
+	if *dataDir != "" && *verifyEthRPC == "" && *verifyGuardianRPC == "" {
+		logger.Fatal("--dataDir requires --verifyEthRPC or --verifyGuardianRPC")
+	}
 
 	// Node's main lifecycle context.
 	rootCtx, rootCtxCancel = context.WithCancel(context.Background())
@@ -259,8 +339,35 @@ func runSpy(cmd *cobra.Command, args []string) {
 	// Guardian set state managed by processor
 	gst := common.NewGuardianSetState(nil)
 
+	// Local VAA store
+	var database *db.Database
+	if *dataDir != "" {
+		database, err = db.Open(path.Join(*dataDir, "db"))
+		if err != nil {
+			logger.Fatal("failed to open database", zap.Error(err))
+		}
+		defer database.Close()
+	}
+
+	// Guardian set source used to verify signed VAAs
+	var fetchGuardianSet guardianSetFetcher
+	if *verifyEthRPC != "" {
+		conn, err := ethereum.NewEthereumConnector(rootCtx, "eth", *verifyEthRPC, ethcommon.HexToAddress(*verifyEthContract), logger)
+		if err != nil {
+			logger.Fatal("failed to connect to Ethereum", zap.Error(err))
+		}
+		fetchGuardianSet = ethGuardianSetFetcher(conn)
+	} else if *verifyGuardianRPC != "" {
+		conn, err := grpc.DialContext(rootCtx, *verifyGuardianRPC, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			logger.Fatal("failed to connect to guardian", zap.Error(err))
+		}
+		defer conn.Close()
+		fetchGuardianSet = guardianRPCGuardianSetFetcher(publicrpcv1.NewPublicRPCServiceClient(conn))
+	}
+
 	// RPC server
-	s := newSpyServer(logger)
+	s := newSpyServer(logger, database, queue)
 	rpcSvc, _, err := spyServerRunnable(s, logger, *spyRPC)
 	if err != nil {
 		logger.Fatal("failed to start RPC server", zap.Error(err))
@@ -298,6 +405,12 @@ func runSpy(cmd *cobra.Command, args []string) {
 			case v := <-signedInC:
 				logger.Info("Received signed VAA",
 					zap.Any("vaa", v.Vaa))
+				if fetchGuardianSet != nil {
+					if err := s.verifyAndPublish(gst, v.Vaa); err != nil {
+						logger.Warn("failed to publish signed VAA", zap.Error(err))
+					}
+					continue
+				}
 				if err := s.Publish(v.Vaa); err != nil {
 					logger.Error("failed to publish signed VAA", zap.Error(err))
 				}
@@ -322,6 +435,12 @@ func runSpy(cmd *cobra.Command, args []string) {
 			return err
 		}
 
+		if fetchGuardianSet != nil {
+			if err := supervisor.Run(ctx, "guardianset", guardianSetUpdater(fetchGuardianSet, gst, *guardianSetRefreshInterval)); err != nil {
+				return err
+			}
+		}
+
 		logger.Info("Started internal services")
 
 		<-ctx.Done()
@@ -0,0 +1,157 @@
+package spy
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/ethereum"
+	"github.com/alephium/wormhole-fork/node/pkg/processor"
+	publicrpcv1 "github.com/alephium/wormhole-fork/node/pkg/proto/publicrpc/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/supervisor"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	ethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	vaasRejected = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_spy_vaas_rejected_total",
+			Help: "Total number of signed VAAs rejected by the spy signature verification",
+		}, []string{"reason"})
+)
+
+const (
+	rejectInvalidVAA          = "invalid_vaa"
+	rejectNoGuardianSet       = "no_guardian_set"
+	rejectGuardianSetMismatch = "guardian_set_mismatch"
+	rejectNoQuorum            = "no_quorum"
+	rejectInvalidSignatures   = "invalid_signatures"
+)
+
+// guardianSetFetcher fetches the current guardian set from a trusted source, and the guardian set it replaced while
+// the core contract still accepts it, nil otherwise.
+type guardianSetFetcher func(ctx context.Context) (current *common.GuardianSet, previous *common.GuardianSet, err error)
+
+// ethGuardianSetFetcher fetches the current and the previous guardian set from the core contract on Ethereum.
+func ethGuardianSetFetcher(conn ethereum.Connector) guardianSetFetcher {
+	return func(ctx context.Context) (*common.GuardianSet, *common.GuardianSet, error) {
+		index, err := conn.GetCurrentGuardianSetIndex(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error requesting current guardian set index: %w", err)
+		}
+		gs, err := conn.GetGuardianSet(ctx, index)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error requesting current guardian set value: %w", err)
+		}
+		prev, err := ethereum.FetchPreviousGuardianSet(ctx, conn, index)
+		if err != nil {
+			return nil, nil, err
+		}
+		return &common.GuardianSet{Keys: gs.Keys, Index: index}, prev, nil
+	}
+}
+
+// guardianRPCGuardianSetFetcher fetches the current guardian set from the public RPC of a guardian, which doesn't
+// expose the previous guardian set.
+func guardianRPCGuardianSetFetcher(client publicrpcv1.PublicRPCServiceClient) guardianSetFetcher {
+	return func(ctx context.Context) (*common.GuardianSet, *common.GuardianSet, error) {
+		resp, err := client.GetCurrentGuardianSet(ctx, &publicrpcv1.GetCurrentGuardianSetRequest{})
+		if err != nil {
+			return nil, nil, fmt.Errorf("error requesting current guardian set: %w", err)
+		}
+		keys := make([]ethCommon.Address, len(resp.GuardianSet.Addresses))
+		for i, addr := range resp.GuardianSet.Addresses {
+			if !ethCommon.IsHexAddress(addr) {
+				return nil, nil, fmt.Errorf("invalid guardian address %s", addr)
+			}
+			keys[i] = ethCommon.HexToAddress(addr)
+		}
+		return &common.GuardianSet{Keys: keys, Index: resp.GuardianSet.Index}, nil, nil
+	}
+}
+
+// guardianSetUpdater periodically fetches the current and the previous guardian set and stores them in gst.
+func guardianSetUpdater(fetch guardianSetFetcher, gst *common.GuardianSetState, interval time.Duration) supervisor.Runnable {
+	return func(ctx context.Context) error {
+		logger := supervisor.Logger(ctx)
+		supervisor.Signal(ctx, supervisor.SignalHealthy)
+
+		update := func() error {
+			timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
+			defer cancel()
+			gs, prev, err := fetch(timeout)
+			if err != nil {
+				return err
+			}
+			if current := gst.Get(); current == nil || current.Index != gs.Index {
+				logger.Info("updated guardian set found", zap.Uint32("index", gs.Index), zap.Strings("keys", gs.KeysAsHexStrings()))
+			}
+			gst.Set(gs)
+			if prev != nil {
+				gst.SetPrevious(prev, prev.ExpirationTime)
+			} else {
+				gst.SetPrevious(nil, time.Time{})
+			}
+			return nil
+		}
+
+		if err := update(); err != nil {
+			return err
+		}
+
+		t := time.NewTicker(interval)
+		defer t.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-t.C:
+				if err := update(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// verifySignedVAA checks that a VAA is signed by a quorum of the current guardian set in gst, or of the previous
+// guardian set until it expires. It returns the reason of the rejection if the VAA is invalid.
+func verifySignedVAA(gst *common.GuardianSetState, vaaBytes []byte) (string, error) {
+	v, err := vaa.Unmarshal(vaaBytes)
+	if err != nil {
+		return rejectInvalidVAA, fmt.Errorf("failed to unmarshal VAA: %w", err)
+	}
+	gs := gst.Get()
+	if gs == nil || len(gs.Keys) == 0 {
+		return rejectNoGuardianSet, fmt.Errorf("guardian set not fetched yet")
+	}
+	if v.GuardianSetIndex != gs.Index {
+		prev, expiry := gst.GetPrevious()
+		if prev == nil || v.GuardianSetIndex != prev.Index || !time.Now().Before(expiry) {
+			return rejectGuardianSetMismatch, fmt.Errorf("VAA guardian set index %d does not match the current guardian set index %d", v.GuardianSetIndex, gs.Index)
+		}
+		gs = prev
+	}
+	quorum := processor.CalculateQuorum(len(gs.Keys))
+	if len(v.Signatures) < quorum {
+		return rejectNoQuorum, fmt.Errorf("VAA has %d signatures, wanted %d", len(v.Signatures), quorum)
+	}
+	if !v.VerifySignatures(gs.Keys) {
+		return rejectInvalidSignatures, fmt.Errorf("invalid VAA signatures")
+	}
+	return "", nil
+}
+
+// verifyAndPublish publishes a signed VAA to subscribers if it is signed by a quorum of the guardian sets in gst.
+func (s *spyServer) verifyAndPublish(gst *common.GuardianSetState, vaaBytes []byte) error {
+	if reason, err := verifySignedVAA(gst, vaaBytes); err != nil {
+		vaasRejected.WithLabelValues(reason).Inc()
+		return fmt.Errorf("rejected signed VAA: %w", err)
+	}
+	return s.Publish(vaaBytes)
+}
@@ -0,0 +1,95 @@
+package spy
+
+import (
+	"crypto/ecdsa"
+	"testing"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/devnet"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func signedByGuardians(t *testing.T, guardianSetIndex uint32, keys []*ecdsa.PrivateKey) []byte {
+	v := testVAA(vaa.ChainIDEthereum, emitter0, vaa.ChainIDAlephium, 1, 1)
+	v.Timestamp = time.Unix(0, 0)
+	v.GuardianSetIndex = guardianSetIndex
+	for i, key := range keys {
+		v.AddSignature(key, uint8(i))
+	}
+	b, err := v.Marshal()
+	assert.Nil(t, err)
+	return b
+}
+
+func TestVerifySignedVAA(t *testing.T) {
+	gs, keys := devnet.InsecureDeterministicGuardianSet(1, 4)
+	_, otherKeys := devnet.InsecureDeterministicGuardianSet(2, 4)
+	gst := common.NewGuardianSetState(nil)
+
+	reason, err := verifySignedVAA(gst, signedByGuardians(t, 1, keys))
+	assert.NotNil(t, err)
+	assert.Equal(t, rejectNoGuardianSet, reason)
+
+	gst.Set(gs)
+	reason, err = verifySignedVAA(gst, signedByGuardians(t, 1, keys[:3]))
+	assert.Nil(t, err)
+	assert.Equal(t, "", reason)
+
+	reason, err = verifySignedVAA(gst, []byte{1, 2, 3})
+	assert.NotNil(t, err)
+	assert.Equal(t, rejectInvalidVAA, reason)
+
+	reason, err = verifySignedVAA(gst, signedByGuardians(t, 0, keys))
+	assert.NotNil(t, err)
+	assert.Equal(t, rejectGuardianSetMismatch, reason)
+
+	reason, err = verifySignedVAA(gst, signedByGuardians(t, 1, keys[:2]))
+	assert.NotNil(t, err)
+	assert.Equal(t, rejectNoQuorum, reason)
+
+	reason, err = verifySignedVAA(gst, signedByGuardians(t, 1, otherKeys))
+	assert.NotNil(t, err)
+	assert.Equal(t, rejectInvalidSignatures, reason)
+}
+
+func TestVerifySignedVAAWithPreviousGuardianSet(t *testing.T) {
+	prev, prevKeys := devnet.InsecureDeterministicGuardianSet(1, 4)
+	gs, _ := devnet.InsecureDeterministicGuardianSet(2, 4)
+	gst := common.NewGuardianSetState(nil)
+	gst.Set(gs)
+
+	reason, err := verifySignedVAA(gst, signedByGuardians(t, prev.Index, prevKeys))
+	assert.NotNil(t, err)
+	assert.Equal(t, rejectGuardianSetMismatch, reason)
+
+	// Accepted until the previous guardian set expires
+	gst.SetPrevious(prev, time.Now().Add(time.Hour))
+	reason, err = verifySignedVAA(gst, signedByGuardians(t, prev.Index, prevKeys))
+	assert.Nil(t, err)
+	assert.Equal(t, "", reason)
+
+	gst.SetPrevious(prev, time.Now().Add(-time.Second))
+	reason, err = verifySignedVAA(gst, signedByGuardians(t, prev.Index, prevKeys))
+	assert.NotNil(t, err)
+	assert.Equal(t, rejectGuardianSetMismatch, reason)
+}
+
+func TestVerifyAndPublish(t *testing.T) {
+	gs, keys := devnet.InsecureDeterministicGuardianSet(1, 1)
+	gst := common.NewGuardianSetState(nil)
+	s := newSpyServer(zap.NewNop(), nil, testQueueConfig)
+	sub := newSubscription("verify", &filter{}, testQueueConfig, false)
+	defer sub.close()
+	s.subs[sub.id] = sub
+
+	// Rejected until the guardian set is fetched
+	assert.NotNil(t, s.verifyAndPublish(gst, signedByGuardians(t, 1, keys)))
+	assert.Equal(t, 0, len(sub.ch))
+
+	gst.Set(gs)
+	assert.Nil(t, s.verifyAndPublish(gst, signedByGuardians(t, 1, keys)))
+	assert.Equal(t, 1, len(sub.ch))
+}
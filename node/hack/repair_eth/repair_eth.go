@@ -74,15 +74,11 @@ func checkFlagsAndGetChainConfig() (*common.BridgeConfig, *common.ChainConfig) {
 		log.Fatalf("invalid target chain id: %d", *targetChainId)
 	}
 
-	switch vaa.ChainID(*emitterChainId) {
-	case vaa.ChainIDEthereum:
-		return bridgeConfig, bridgeConfig.Ethereum
-	case vaa.ChainIDBSC:
-		return bridgeConfig, bridgeConfig.Bsc
-	default:
+	chain := bridgeConfig.EvmChain(vaa.ChainID(*emitterChainId))
+	if chain == nil {
 		log.Fatalf("invalid chain id %v", *emitterChainId)
-		return nil, nil
 	}
+	return bridgeConfig, chain.ChainConfig
 }
 
 func main() {
@@ -0,0 +1,346 @@
+// Package accountant keeps a ledger of the tokens moved between chains by the token bridge.
+//
+// For every origin token, the ledger holds the amount locked in the token bridge of its origin chain, and the
+// amount of wrapped tokens released on every other chain. A transfer leaving a chain either locks native tokens
+// or burns wrapped tokens, and a transfer arriving on a chain either unlocks native tokens or mints wrapped
+// tokens. A transfer which would burn or unlock more tokens than were ever locked for its chain can only be
+// the result of a bug or of a compromised chain: it is flagged, and not signed if enforcement is enabled.
+//
+// The ledger is built from the quorum VAAs of the token bridges stored in the local database. It is kept in
+// memory, rebuilt on startup, and can be rebuilt from scratch at any time. It assumes that all the chains the
+// token bridge is connected to are watched by the guardian, balances of tokens whose origin chain isn't watched
+// are tracked but never checked.
+//
+// The ledger is only correct if the database holds the whole history of the token bridges. Sequences are assigned
+// from 0 per emitter and target chain, so VAAs missing before the latest stored one are detected, and enforcement
+// is refused when there are any. A database missing the latest VAAs, or all of them, can't be told apart from a
+// complete one.
+package accountant
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+var (
+	accountantTransfersFlaggedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_accountant_transfers_flagged_total",
+			Help: "Total number of transfers which would release more tokens on a chain than were locked for it",
+		}, []string{"emitter_chain"})
+
+	accountantVAAsApplied = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_accountant_vaas_applied",
+			Help: "Current number of token bridge transfer VAAs in the accountant ledger",
+		})
+)
+
+type tokenKey struct {
+	chain   vaa.ChainID
+	address vaa.Address
+}
+
+// Balance is the amount of an origin token held for a chain. On the origin chain of the token, it is the amount
+// locked in the token bridge, on other chains it is the supply of wrapped tokens. Amounts are normalized to at
+// most 8 decimals by the token bridge.
+type Balance struct {
+	OriginChain   vaa.ChainID
+	OriginAddress vaa.Address
+	Chain         vaa.ChainID
+	Amount        *big.Int
+}
+
+// sequenceKey identifies the sequences of the messages sent by a token bridge to a target chain.
+type sequenceKey struct {
+	emitterChain vaa.ChainID
+	targetChain  vaa.ChainID
+}
+
+type Accountant struct {
+	db      *db.Database
+	logger  *zap.Logger
+	enforce bool
+	// emitters maps the watched chains to the address of their token bridge emitter.
+	emitters map[vaa.ChainID]vaa.Address
+
+	mutex    sync.Mutex
+	balances map[tokenKey]map[vaa.ChainID]*big.Int
+	// applied contains the id of all VAAs in the ledger. VAAs can be stored several times, and observations
+	// of transfers which already reached quorum must not be checked against a ledger which includes them.
+	applied map[string]bool
+	// missing is the number of token bridge VAAs missing from the database when the ledger was built.
+	missing uint64
+}
+
+// NewAccountant creates an accountant for the token bridges in emitters, and builds its ledger from the VAAs
+// stored in the database. If enforce is set, ProcessMsg rejects the transfers which are flagged, and an error is
+// returned if VAAs are missing from the database.
+func NewAccountant(logger *zap.Logger, database *db.Database, emitters map[vaa.ChainID]vaa.Address, enforce bool) (*Accountant, error) {
+	acct := &Accountant{
+		db:       database,
+		logger:   logger,
+		enforce:  enforce,
+		emitters: emitters,
+	}
+	count, err := acct.Rebuild()
+	if err != nil {
+		return nil, err
+	}
+	if enforce && acct.missing != 0 {
+		return nil, fmt.Errorf("refusing to enforce an incomplete ledger: %d token bridge VAAs are missing from the database", acct.missing)
+	}
+	if enforce && count == 0 {
+		logger.Warn("accountant: enforcing an empty ledger, all the transfers releasing tokens will be rejected until the database holds the history of the token bridges")
+	}
+	return acct, nil
+}
+
+// Rebuild discards the ledger and builds it again from the VAAs stored in the database. It returns the number
+// of transfer VAAs in the ledger.
+func (acct *Accountant) Rebuild() (int, error) {
+	acct.mutex.Lock()
+	defer acct.mutex.Unlock()
+
+	acct.balances = make(map[tokenKey]map[vaa.ChainID]*big.Int)
+	acct.applied = make(map[string]bool)
+	sequences := make(map[sequenceKey]map[uint64]bool)
+	for chainId, emitter := range acct.emitters {
+		err := acct.db.IterateSignedVAAs(chainId, emitter, func(v *vaa.VAA) error {
+			key := sequenceKey{emitterChain: v.EmitterChain, targetChain: v.TargetChain}
+			if sequences[key] == nil {
+				sequences[key] = make(map[uint64]bool)
+			}
+			sequences[key][v.Sequence] = true
+			acct.applyVAA(v)
+			return nil
+		})
+		if err != nil {
+			return 0, fmt.Errorf("failed to load VAAs of chain %v: %w", chainId, err)
+		}
+	}
+
+	acct.missing = 0
+	for key, seqs := range sequences {
+		var last uint64
+		for seq := range seqs {
+			if seq > last {
+				last = seq
+			}
+		}
+		missing := last + 1 - uint64(len(seqs))
+		if missing == 0 {
+			continue
+		}
+		acct.missing += missing
+		acct.logger.Error("accountant: token bridge VAAs are missing from the database, legitimate transfers may be flagged",
+			zap.Stringer("emitterChain", key.emitterChain),
+			zap.Stringer("targetChain", key.targetChain),
+			zap.Uint64("missing", missing),
+			zap.Uint64("lastSequence", last),
+			zap.Bool("enforced", acct.enforce),
+		)
+	}
+
+	// The order of the VAAs in the database isn't the order in which they were signed, so balances are only
+	// checked once all of them have been applied.
+	for token, chains := range acct.balances {
+		for chainId, amount := range chains {
+			if amount.Sign() < 0 && acct.isChecked(token, chainId) {
+				acct.logger.Error("accountant: negative balance after rebuilding the ledger",
+					zap.Stringer("originChain", token.chain),
+					zap.Stringer("originAddress", token.address),
+					zap.Stringer("chain", chainId),
+					zap.Stringer("amount", amount),
+				)
+			}
+		}
+	}
+
+	accountantVAAsApplied.Set(float64(len(acct.applied)))
+	acct.logger.Info("accountant: built ledger",
+		zap.Int("vaas", len(acct.applied)),
+		zap.Int("tokens", len(acct.balances)),
+		zap.Uint64("missing", acct.missing),
+	)
+	return len(acct.applied), nil
+}
+
+// transfer decodes the transfer of a message emitted by a watched token bridge. It returns false if the
+// message isn't a token bridge transfer.
+func (acct *Accountant) transfer(emitterChain vaa.ChainID, emitterAddress vaa.Address, payload []byte) (*vaa.TransferPayloadHdr, bool, error) {
+	emitter, ok := acct.emitters[emitterChain]
+	if !ok || emitter != emitterAddress || !vaa.IsTransfer(payload) {
+		return nil, false, nil
+	}
+	hdr, err := vaa.DecodeTransferPayloadHdr(payload)
+	if err != nil {
+		return nil, true, err
+	}
+	return hdr, true, nil
+}
+
+// isChecked returns true if the balance of a token on a chain is fully known to the ledger, which is the case
+// if both the chain and the origin chain of the token are watched.
+func (acct *Accountant) isChecked(token tokenKey, chainId vaa.ChainID) bool {
+	_, originWatched := acct.emitters[token.chain]
+	_, chainWatched := acct.emitters[chainId]
+	return originWatched && chainWatched
+}
+
+// balanceChange is a change of the balance of a token on a chain.
+type balanceChange struct {
+	chain  vaa.ChainID
+	amount *big.Int
+}
+
+// balanceChanges returns the changes of the ledger caused by a transfer from emitterChain to targetChain.
+func balanceChanges(emitterChain vaa.ChainID, targetChain vaa.ChainID, hdr *vaa.TransferPayloadHdr) []balanceChange {
+	changes := make([]balanceChange, 0, 2)
+	if emitterChain == hdr.OriginChain {
+		// native tokens are locked
+		changes = append(changes, balanceChange{emitterChain, new(big.Int).Set(hdr.Amount)})
+	} else {
+		// wrapped tokens are burned
+		changes = append(changes, balanceChange{emitterChain, new(big.Int).Neg(hdr.Amount)})
+	}
+	if targetChain == hdr.OriginChain {
+		// native tokens are unlocked
+		changes = append(changes, balanceChange{targetChain, new(big.Int).Neg(hdr.Amount)})
+	} else {
+		// wrapped tokens are minted
+		changes = append(changes, balanceChange{targetChain, new(big.Int).Set(hdr.Amount)})
+	}
+	return changes
+}
+
+func (acct *Accountant) balance(token tokenKey, chainId vaa.ChainID) *big.Int {
+	if amount, ok := acct.balances[token][chainId]; ok {
+		return amount
+	}
+	return new(big.Int)
+}
+
+// applyVAA adds a quorum VAA to the ledger, if it is a transfer which isn't in the ledger yet.
+func (acct *Accountant) applyVAA(v *vaa.VAA) {
+	msgID := v.MessageID()
+	if acct.applied[msgID] {
+		return
+	}
+	hdr, ok, err := acct.transfer(v.EmitterChain, v.EmitterAddress, v.Payload)
+	if err != nil {
+		acct.logger.Error("accountant: failed to decode transfer VAA", zap.String("msgID", msgID), zap.Error(err))
+		return
+	}
+	if !ok {
+		return
+	}
+
+	token := tokenKey{chain: hdr.OriginChain, address: hdr.OriginAddress}
+	chains, ok := acct.balances[token]
+	if !ok {
+		chains = make(map[vaa.ChainID]*big.Int)
+		acct.balances[token] = chains
+	}
+	for _, change := range balanceChanges(v.EmitterChain, v.TargetChain, hdr) {
+		chains[change.chain] = new(big.Int).Add(acct.balance(token, change.chain), change.amount)
+	}
+	acct.applied[msgID] = true
+}
+
+// ApplyVAA adds a VAA which reached quorum to the ledger. VAAs which aren't token bridge transfers, or which are
+// already in the ledger, are ignored.
+func (acct *Accountant) ApplyVAA(v *vaa.VAA) {
+	acct.mutex.Lock()
+	defer acct.mutex.Unlock()
+
+	acct.applyVAA(v)
+	accountantVAAsApplied.Set(float64(len(acct.applied)))
+}
+
+// ProcessMsg checks a message against the ledger. It returns false if the message is a transfer which would
+// release more tokens on a chain than were locked for it, and enforcement is enabled.
+//
+// Transfers are only added to the ledger once they reach quorum, so concurrent transfers which haven't reached
+// quorum yet are checked independently.
+func (acct *Accountant) ProcessMsg(msg *common.MessagePublication) bool {
+	acct.mutex.Lock()
+	defer acct.mutex.Unlock()
+
+	msgID := msg.MessageIDString()
+	hdr, ok, err := acct.transfer(msg.EmitterChain, msg.EmitterAddress, msg.Payload)
+	if !ok {
+		return true
+	}
+	if err != nil {
+		accountantTransfersFlaggedTotal.WithLabelValues(msg.EmitterChain.String()).Inc()
+		acct.logger.Error("accountant: failed to decode transfer", zap.String("msgID", msgID), zap.Bool("enforced", acct.enforce), zap.Error(err))
+		return !acct.enforce
+	}
+	if acct.applied[msgID] {
+		return true
+	}
+
+	token := tokenKey{chain: hdr.OriginChain, address: hdr.OriginAddress}
+	for _, change := range balanceChanges(msg.EmitterChain, msg.TargetChain, hdr) {
+		if change.amount.Sign() >= 0 || !acct.isChecked(token, change.chain) {
+			continue
+		}
+		balance := acct.balance(token, change.chain)
+		if new(big.Int).Add(balance, change.amount).Sign() >= 0 {
+			continue
+		}
+		accountantTransfersFlaggedTotal.WithLabelValues(msg.EmitterChain.String()).Inc()
+		acct.logger.Error("accountant: transfer would release more tokens than were locked",
+			zap.String("msgID", msgID),
+			zap.Stringer("originChain", token.chain),
+			zap.Stringer("originAddress", token.address),
+			zap.Stringer("chain", change.chain),
+			zap.Stringer("amount", hdr.Amount),
+			zap.Stringer("balance", balance),
+			zap.Stringer("txHash", msg.TxHash),
+			zap.Bool("enforced", acct.enforce),
+		)
+		return !acct.enforce
+	}
+	return true
+}
+
+// GetBalances returns the balances of all tokens in the ledger, sorted by origin token and chain.
+func (acct *Accountant) GetBalances() []*Balance {
+	acct.mutex.Lock()
+	defer acct.mutex.Unlock()
+
+	balances := make([]*Balance, 0)
+	for token, chains := range acct.balances {
+		for chainId, amount := range chains {
+			balances = append(balances, &Balance{
+				OriginChain:   token.chain,
+				OriginAddress: token.address,
+				Chain:         chainId,
+				Amount:        new(big.Int).Set(amount),
+			})
+		}
+	}
+	sort.Slice(balances, func(i, j int) bool {
+		a, b := balances[i], balances[j]
+		if a.OriginChain != b.OriginChain {
+			return a.OriginChain < b.OriginChain
+		}
+		if a.OriginAddress != b.OriginAddress {
+			return a.OriginAddress.String() < b.OriginAddress.String()
+		}
+		return a.Chain < b.Chain
+	})
+	return balances
+}
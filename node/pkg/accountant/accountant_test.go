@@ -0,0 +1,220 @@
+package accountant
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"encoding/binary"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+var (
+	ethTokenBridge  = vaa.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x67, 0xb5, 0x65, 0x6d, 0x60, 0xa8, 0x09, 0x91, 0x53, 0x23, 0xbf, 0x2c, 0x40, 0xa8, 0xbe, 0xf1, 0x5a, 0x15, 0x2e, 0x3e}
+	alphTokenBridge = vaa.Address{0x0e, 0x2c, 0x7e, 0x0f, 0xa9, 0x55, 0x4d, 0x3c, 0x2d, 0x62, 0x24, 0xc4, 0x6b, 0x3a, 0x0b, 0x04, 0x3e, 0x4d, 0x55, 0x3c, 0x89, 0x3d, 0x84, 0x27, 0xad, 0x41, 0xa1, 0x8f, 0xa2, 0x41, 0x7b, 0x0f}
+	bscTokenBridge  = vaa.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x0e, 0x07, 0x45, 0x54, 0x1e, 0x48, 0x58, 0x63, 0x8b, 0x1b, 0x07, 0x6d, 0x9a, 0x5d, 0x1e, 0xb0, 0x9b, 0x38, 0x22, 0x44}
+	tokenAddress    = vaa.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x95, 0x61, 0xc1, 0x33, 0xdd, 0x85, 0x80, 0x86, 0x0b, 0x6b, 0x7e, 0x50, 0x4b, 0xc5, 0xaa, 0x50, 0x0f, 0x0f, 0x06, 0xa7}
+
+	emitters = map[vaa.ChainID]vaa.Address{
+		vaa.ChainIDEthereum: ethTokenBridge,
+		vaa.ChainIDAlephium: alphTokenBridge,
+		vaa.ChainIDBSC:      bscTokenBridge,
+	}
+)
+
+func transferPayload(amount uint64, tokenChain vaa.ChainID, token vaa.Address) []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteByte(1)
+	buf.Write(ethcommon.LeftPadBytes(new(big.Int).SetUint64(amount).Bytes(), 32))
+	buf.Write(token[:])
+	vaa.MustWrite(buf, binary.BigEndian, tokenChain)
+	recipient := make([]byte, 32)
+	vaa.MustWrite(buf, binary.BigEndian, uint16(len(recipient)))
+	buf.Write(recipient)
+	buf.Write(make([]byte, 32))
+	return buf.Bytes()
+}
+
+// transferMsg returns a transfer of tokenAddress, which originates from Ethereum.
+func transferMsg(emitterChain vaa.ChainID, targetChain vaa.ChainID, sequence uint64, amount uint64) *common.MessagePublication {
+	return &common.MessagePublication{
+		Timestamp:      time.Unix(int64(1654516425+sequence), 0),
+		Nonce:          1,
+		Sequence:       sequence,
+		EmitterChain:   emitterChain,
+		TargetChain:    targetChain,
+		EmitterAddress: emitters[emitterChain],
+		Payload:        transferPayload(amount, vaa.ChainIDEthereum, tokenAddress),
+	}
+}
+
+func signedVAA(msg *common.MessagePublication) *vaa.VAA {
+	v := &vaa.VAA{
+		Version:          vaa.SupportedVAAVersion,
+		GuardianSetIndex: 0,
+		Timestamp:        msg.Timestamp,
+		Nonce:            msg.Nonce,
+		Sequence:         msg.Sequence,
+		EmitterChain:     msg.EmitterChain,
+		TargetChain:      msg.TargetChain,
+		EmitterAddress:   msg.EmitterAddress,
+		Payload:          msg.Payload,
+	}
+	key, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	v.AddSignature(key, 0)
+	return v
+}
+
+func balanceOf(acct *Accountant, chainId vaa.ChainID) int64 {
+	return acct.balance(tokenKey{chain: vaa.ChainIDEthereum, address: tokenAddress}, chainId).Int64()
+}
+
+func TestTransfersUpdateBalances(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+	acct, err := NewAccountant(zap.NewNop(), database, emitters, true)
+	assert.Nil(t, err)
+
+	// lock on ethereum, mint on alephium
+	acct.ApplyVAA(signedVAA(transferMsg(vaa.ChainIDEthereum, vaa.ChainIDAlephium, 1, 100)))
+	assert.Equal(t, int64(100), balanceOf(acct, vaa.ChainIDEthereum))
+	assert.Equal(t, int64(100), balanceOf(acct, vaa.ChainIDAlephium))
+
+	// burn on alephium, mint on bsc
+	acct.ApplyVAA(signedVAA(transferMsg(vaa.ChainIDAlephium, vaa.ChainIDBSC, 1, 40)))
+	assert.Equal(t, int64(60), balanceOf(acct, vaa.ChainIDAlephium))
+	assert.Equal(t, int64(40), balanceOf(acct, vaa.ChainIDBSC))
+
+	// burn on bsc, unlock on ethereum
+	v := signedVAA(transferMsg(vaa.ChainIDBSC, vaa.ChainIDEthereum, 1, 30))
+	acct.ApplyVAA(v)
+	assert.Equal(t, int64(10), balanceOf(acct, vaa.ChainIDBSC))
+	assert.Equal(t, int64(70), balanceOf(acct, vaa.ChainIDEthereum))
+
+	// VAAs are only applied once
+	acct.ApplyVAA(v)
+	assert.Equal(t, int64(70), balanceOf(acct, vaa.ChainIDEthereum))
+
+	balances := acct.GetBalances()
+	assert.Equal(t, 3, len(balances))
+	assert.Equal(t, vaa.ChainIDEthereum, balances[0].Chain)
+	assert.Equal(t, vaa.ChainIDBSC, balances[1].Chain)
+	assert.Equal(t, vaa.ChainIDAlephium, balances[2].Chain)
+	assert.Equal(t, big.NewInt(60), balances[2].Amount)
+}
+
+func TestTransfersExceedingBalanceAreRejected(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+	acct, err := NewAccountant(zap.NewNop(), database, emitters, true)
+	assert.Nil(t, err)
+
+	// nothing was ever locked
+	assert.False(t, acct.ProcessMsg(transferMsg(vaa.ChainIDAlephium, vaa.ChainIDEthereum, 1, 1)))
+	// locking is always allowed
+	lock := transferMsg(vaa.ChainIDEthereum, vaa.ChainIDAlephium, 1, 100)
+	assert.True(t, acct.ProcessMsg(lock))
+	acct.ApplyVAA(signedVAA(lock))
+
+	assert.True(t, acct.ProcessMsg(transferMsg(vaa.ChainIDAlephium, vaa.ChainIDEthereum, 2, 100)))
+	assert.False(t, acct.ProcessMsg(transferMsg(vaa.ChainIDAlephium, vaa.ChainIDEthereum, 3, 101)))
+	// no wrapped tokens on bsc
+	assert.False(t, acct.ProcessMsg(transferMsg(vaa.ChainIDBSC, vaa.ChainIDAlephium, 1, 1)))
+
+	// re-observations of transfers in the ledger are not checked again
+	release := transferMsg(vaa.ChainIDAlephium, vaa.ChainIDEthereum, 4, 100)
+	acct.ApplyVAA(signedVAA(release))
+	assert.True(t, acct.ProcessMsg(release))
+
+	// other emitters are ignored
+	msg := transferMsg(vaa.ChainIDAlephium, vaa.ChainIDEthereum, 5, 100)
+	msg.EmitterAddress = tokenAddress
+	assert.True(t, acct.ProcessMsg(msg))
+}
+
+func TestTransfersExceedingBalanceAreFlagged(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+	acct, err := NewAccountant(zap.NewNop(), database, emitters, false)
+	assert.Nil(t, err)
+
+	assert.True(t, acct.ProcessMsg(transferMsg(vaa.ChainIDAlephium, vaa.ChainIDEthereum, 1, 1)))
+}
+
+func TestTokensOfUnwatchedChainsAreNotChecked(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+	acct, err := NewAccountant(zap.NewNop(), database, emitters, true)
+	assert.Nil(t, err)
+
+	msg := transferMsg(vaa.ChainIDAlephium, vaa.ChainIDEthereum, 1, 1)
+	msg.Payload = transferPayload(1, vaa.ChainIDSolana, tokenAddress)
+	assert.True(t, acct.ProcessMsg(msg))
+}
+
+func TestRebuild(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+
+	// the release is stored before the lock in the database
+	assert.Nil(t, database.StoreSignedVAA(signedVAA(transferMsg(vaa.ChainIDAlephium, vaa.ChainIDEthereum, 0, 30))))
+	assert.Nil(t, database.StoreSignedVAA(signedVAA(transferMsg(vaa.ChainIDEthereum, vaa.ChainIDAlephium, 0, 100))))
+	// not a token bridge transfer
+	msg := transferMsg(vaa.ChainIDEthereum, vaa.ChainIDAlephium, 2, 100)
+	msg.EmitterAddress = tokenAddress
+	assert.Nil(t, database.StoreSignedVAA(signedVAA(msg)))
+
+	acct, err := NewAccountant(zap.NewNop(), database, emitters, true)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(70), balanceOf(acct, vaa.ChainIDEthereum))
+	assert.Equal(t, int64(70), balanceOf(acct, vaa.ChainIDAlephium))
+
+	assert.Nil(t, database.StoreSignedVAA(signedVAA(transferMsg(vaa.ChainIDAlephium, vaa.ChainIDBSC, 0, 20))))
+	count, err := acct.Rebuild()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, int64(50), balanceOf(acct, vaa.ChainIDAlephium))
+	assert.Equal(t, int64(20), balanceOf(acct, vaa.ChainIDBSC))
+}
+
+func TestIncompleteHistory(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+
+	assert.Nil(t, database.StoreSignedVAA(signedVAA(transferMsg(vaa.ChainIDEthereum, vaa.ChainIDAlephium, 0, 100))))
+	assert.Nil(t, database.StoreSignedVAA(signedVAA(transferMsg(vaa.ChainIDEthereum, vaa.ChainIDAlephium, 2, 100))))
+	assert.Nil(t, database.StoreSignedVAA(signedVAA(transferMsg(vaa.ChainIDAlephium, vaa.ChainIDEthereum, 3, 30))))
+
+	// the transfers are only flagged
+	acct, err := NewAccountant(zap.NewNop(), database, emitters, false)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(4), acct.missing)
+
+	_, err = NewAccountant(zap.NewNop(), database, emitters, true)
+	assert.NotNil(t, err)
+
+	// the history is complete once the missing VAAs are stored
+	assert.Nil(t, database.StoreSignedVAA(signedVAA(transferMsg(vaa.ChainIDEthereum, vaa.ChainIDAlephium, 1, 100))))
+	for seq := uint64(0); seq < 3; seq++ {
+		assert.Nil(t, database.StoreSignedVAA(signedVAA(transferMsg(vaa.ChainIDAlephium, vaa.ChainIDEthereum, seq, 10))))
+	}
+	acct, err = NewAccountant(zap.NewNop(), database, emitters, true)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), acct.missing)
+	assert.Equal(t, int64(240), balanceOf(acct, vaa.ChainIDEthereum))
+}
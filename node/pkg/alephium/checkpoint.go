@@ -0,0 +1,92 @@
+package alephium
+
+import (
+	"encoding/json"
+	"sync/atomic"
+
+	sdk "github.com/alephium/go-sdk"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/p2p"
+	gossipv1 "github.com/alephium/wormhole-fork/node/pkg/proto/gossip/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"go.uber.org/zap"
+)
+
+// The checkpoint persists the index of the next contract event to fetch together with the fetched events which
+// are not confirmed yet. It is written whenever one of them changes, so that no event is skipped on restart.
+
+// loadCheckpoint returns the index of the next contract event to fetch and the pending events of the last run,
+// the index is nil if there is no checkpoint.
+func (w *Watcher) loadCheckpoint(logger *zap.Logger) (*int32, []*UnconfirmedEvent, error) {
+	if w.db == nil {
+		return nil, nil, nil
+	}
+	checkpoint, err := w.db.GetContractEventCheckpoint(vaa.ChainIDAlephium)
+	if err == db.ErrCheckpointNotFound {
+		logger.Info("no checkpoint found, fetching events from the current event count")
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	events := make([]*UnconfirmedEvent, 0, len(checkpoint.PendingEvents))
+	for _, b := range checkpoint.PendingEvents {
+		var contractEvent sdk.ContractEvent
+		if err := json.Unmarshal(b, &contractEvent); err != nil {
+			logger.Error("ignore invalid pending event", zap.Error(err))
+			continue
+		}
+		event, err := w.toUnconfirmedEvent(&contractEvent)
+		if err != nil {
+			logger.Error("ignore invalid pending event", zap.Error(err), zap.String("event", marshalContractEvent(&contractEvent)))
+			continue
+		}
+		events = append(events, event)
+	}
+
+	logger.Info("loaded checkpoint", zap.Int32("nextIndex", checkpoint.NextIndex), zap.Int("pendingEvents", len(events)))
+	w.setNextEventIndex(checkpoint.NextIndex)
+	alphPendingEvents.Set(float64(len(events)))
+	return &checkpoint.NextIndex, events, nil
+}
+
+func (w *Watcher) storeCheckpoint(logger *zap.Logger, pendingEvents map[string]*UnconfirmedEventsPerBlock) {
+	events := make([][]byte, 0)
+	for _, blockEvents := range pendingEvents {
+		for _, event := range blockEvents.events {
+			b, err := json.Marshal(event.ContractEvent)
+			if err != nil {
+				logger.Error("failed to marshal pending event", zap.Error(err), zap.String("txId", event.TxId))
+				return
+			}
+			events = append(events, b)
+		}
+	}
+	alphPendingEvents.Set(float64(len(events)))
+
+	if w.db == nil {
+		return
+	}
+	checkpoint := &db.ContractEventCheckpoint{
+		NextIndex:     atomic.LoadInt32(&w.nextEventIndex),
+		PendingEvents: events,
+	}
+	if err := w.db.StoreContractEventCheckpoint(vaa.ChainIDAlephium, checkpoint); err != nil {
+		logger.Error("failed to store checkpoint", zap.Error(err))
+	}
+}
+
+func (w *Watcher) setNextEventIndex(index int32) {
+	atomic.StoreInt32(&w.nextEventIndex, index)
+	alphNextEventIndex.Set(float64(index))
+	w.setNetworkStats()
+}
+
+func (w *Watcher) setNetworkStats() {
+	p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDAlephium, &gossipv1.Heartbeat_Network{
+		ContractAddress: w.governanceContractAddress,
+		Height:          int64(atomic.LoadInt32(&w.currentHeight)),
+		EventIndex:      int64(atomic.LoadInt32(&w.nextEventIndex)),
+	})
+}
@@ -0,0 +1,101 @@
+package alephium
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	sdk "github.com/alephium/go-sdk"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func wormholeMessageEvent(t *testing.T, watcher *Watcher) *UnconfirmedEvent {
+	fields := []sdk.Val{
+		byteVecField("deae14cf3bcfaea1f8f7e905fd8b554833d1bccaa8a9a1dd01f29fea6c7bca07"),
+		u256Field(3),
+		u256Field(101),
+		byteVecField("1e308999"),
+		byteVecField("010000000000000000000000000000000000000000000000004563918244f400009fb80859f87d9d56a118624a12258e7dd471a0a474490807986d9b0bb7f576ab00ff0000000000000000000000000d0f183465284cb5cb426902445860456ed59b3400000000000000000000000000000000000000000000000000005af3107a4000"),
+		u256Field(1),
+	}
+	event, err := watcher.toUnconfirmedEvent(&sdk.ContractEvent{
+		BlockHash:  randomByte32().ToHex(),
+		TxId:       randomByte32().ToHex(),
+		EventIndex: WormholeMessageEventIndex,
+		Fields:     fields,
+	})
+	assert.Nil(t, err)
+	return event
+}
+
+func TestCheckpoint(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+
+	newWatcher := func() *Watcher {
+		return &Watcher{
+			chainIndex:         &ChainIndex{0, 0},
+			blockPollerEnabled: &atomic.Bool{},
+			db:                 database,
+		}
+	}
+	watcher := newWatcher()
+	logger := zap.NewNop()
+
+	fromIndex, restored, err := watcher.loadCheckpoint(logger)
+	assert.Nil(t, err)
+	assert.Nil(t, fromIndex)
+	assert.Equal(t, 0, len(restored))
+
+	confirmedEvents := make([]*ConfirmedEvent, 0)
+	handler := func(logger *zap.Logger, confirmed []*ConfirmedEvent) {
+		confirmedEvents = append(confirmedEvents, confirmed...)
+	}
+	isBlockInMainChain := func(hash string) (*bool, error) {
+		result := true
+		return &result, nil
+	}
+	getBlockHeader := func(hash string) (*sdk.BlockHeaderEntry, error) {
+		return &sdk.BlockHeaderEntry{Height: 1, Hash: hash}, nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	eventsC := make(chan *FetchedEvents)
+	heightC := make(chan int32)
+	go watcher.handleEvents_(ctx, logger, isBlockInMainChain, getBlockHeader, handler, nil, eventsC, heightC)
+
+	event0 := wormholeMessageEvent(t, watcher)
+	event1 := wormholeMessageEvent(t, watcher)
+	eventsC <- &FetchedEvents{events: []*UnconfirmedEvent{event0, event1}, nextIndex: 5}
+	// the events are not confirmed yet, sending the height waits for the events to be handled
+	heightC <- 1
+	heightC <- 1
+	assert.Equal(t, 0, len(confirmedEvents))
+
+	restoredWatcher := newWatcher()
+	fromIndex, restored, err = restoredWatcher.loadCheckpoint(logger)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), *fromIndex)
+	assert.Equal(t, int32(5), restoredWatcher.nextEventIndex)
+	assert.Equal(t, 2, len(restored))
+	txIds := map[string]bool{restored[0].TxId: true, restored[1].TxId: true}
+	assert.True(t, txIds[event0.TxId])
+	assert.True(t, txIds[event1.TxId])
+	assert.Equal(t, event0.msg.GetID(), restored[0].msg.GetID())
+
+	// no valid events, but the next index moves forward
+	eventsC <- &FetchedEvents{events: []*UnconfirmedEvent{}, nextIndex: 7}
+	heightC <- 2
+	heightC <- 2
+	assert.Equal(t, 2, len(confirmedEvents))
+
+	checkpoint, err := database.GetContractEventCheckpoint(vaa.ChainIDAlephium)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(7), checkpoint.NextIndex)
+	assert.Equal(t, 0, len(checkpoint.PendingEvents))
+}
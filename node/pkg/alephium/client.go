@@ -8,6 +8,7 @@ import (
 	"time"
 
 	sdk "github.com/alephium/go-sdk"
+	"github.com/alephium/wormhole-fork/node/pkg/common"
 	"github.com/alephium/wormhole-fork/node/pkg/p2p"
 	"github.com/alephium/wormhole-fork/node/pkg/vaa"
 )
@@ -194,6 +195,109 @@ func (c *Client) MultiCallContract(ctx context.Context, multiCall *sdk.MultipleC
 	return response, nil
 }
 
+// GetCurrentGuardianSet reads the current guardian set from the state of the governance contract, which is returned
+// by a call of its getMessageFee method.
+func (c *Client) GetCurrentGuardianSet(ctx context.Context, governanceContractAddress string, groupIndex int32) (*common.GuardianSet, error) {
+	mutFields, err := c.getGovernanceMutFields(ctx, governanceContractAddress, groupIndex)
+	if err != nil {
+		return nil, err
+	}
+	return ToCurrentGuardianSet(mutFields)
+}
+
+// GetPreviousGuardianSet reads the guardian set replaced by the current guardian set from the state of the governance
+// contract, see ToPreviousGuardianSet.
+func (c *Client) GetPreviousGuardianSet(ctx context.Context, governanceContractAddress string, groupIndex int32) (*common.GuardianSet, error) {
+	mutFields, err := c.getGovernanceMutFields(ctx, governanceContractAddress, groupIndex)
+	if err != nil {
+		return nil, err
+	}
+	return ToPreviousGuardianSet(mutFields)
+}
+
+func (c *Client) getGovernanceMutFields(ctx context.Context, governanceContractAddress string, groupIndex int32) ([]sdk.Val, error) {
+	multiCallContract := &sdk.MultipleCallContract{
+		Calls: []sdk.CallContract{
+			{
+				Group:       groupIndex,
+				Address:     governanceContractAddress,
+				MethodIndex: 0,
+			},
+		},
+	}
+
+	result, err := c.MultiCallContract(ctx, multiCallContract)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Results) != 1 || result.Results[0].CallContractSucceeded == nil {
+		return nil, fmt.Errorf("failed to call governance contract %s", governanceContractAddress)
+	}
+
+	for _, state := range result.Results[0].CallContractSucceeded.Contracts {
+		if state.Address == governanceContractAddress {
+			return state.MutFields, nil
+		}
+	}
+	return nil, fmt.Errorf("no state of governance contract %s", governanceContractAddress)
+}
+
+func (c *Client) GetContractState(ctx context.Context, contractAddress string, groupIndex int32) (*sdk.ContractState, *http.Response, error) {
+	timestamp, timeoutCtx, cancel := c.timeoutContext(ctx)
+	defer cancel()
+
+	request := c.impl.ContractsApi.GetContractsAddressState(timeoutCtx, contractAddress).Group(groupIndex)
+	return requestWithMetric[*sdk.ContractState](request, timestamp, "get_contract_state")
+}
+
+// GetRegisteredTokenBridge reads the token bridge of remoteChainId registered on the token bridge, it returns nil if
+// the chain is not registered.
+func (c *Client) GetRegisteredTokenBridge(ctx context.Context, tokenBridgeId Byte32, remoteChainId uint16, groupIndex uint8) (*Byte32, error) {
+	contractId := TokenBridgeForChainId(tokenBridgeId, remoteChainId, groupIndex)
+	contractAddress, err := ToContractAddress(contractId.ToHex())
+	if err != nil {
+		return nil, err
+	}
+
+	state, r, err := c.GetContractState(ctx, *contractAddress, int32(groupIndex))
+	if err != nil && r != nil && r.StatusCode == 404 {
+		// the TokenBridgeForChain contract is created when the chain is registered
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(state.ImmFields) != TokenBridgeForChainImmFieldSize {
+		return nil, fmt.Errorf("invalid token bridge for chain field size, expect %d, have %d", TokenBridgeForChainImmFieldSize, len(state.ImmFields))
+	}
+	return toByte32(state.ImmFields[4])
+}
+
+// GetGovernanceState reads the state of the governance contract.
+func (c *Client) GetGovernanceState(ctx context.Context, governanceContractAddress string, groupIndex int32) (*GovernanceState, error) {
+	state, _, err := c.GetContractState(ctx, governanceContractAddress, groupIndex)
+	if err != nil {
+		return nil, err
+	}
+	return ToGovernanceState(state.MutFields)
+}
+
+// GetTokenBridgeReceivedSequence reads the next governance sequence accepted by the token bridge.
+func (c *Client) GetTokenBridgeReceivedSequence(ctx context.Context, tokenBridgeAddress string, groupIndex int32) (uint64, error) {
+	state, _, err := c.GetContractState(ctx, tokenBridgeAddress, groupIndex)
+	if err != nil {
+		return 0, err
+	}
+	if len(state.MutFields) != TokenBridgeMutFieldSize {
+		return 0, fmt.Errorf("invalid token bridge field size, expect %d, have %d", TokenBridgeMutFieldSize, len(state.MutFields))
+	}
+	receivedSequence, err := toUint64(state.MutFields[0])
+	if err != nil {
+		return 0, err
+	}
+	return *receivedSequence, nil
+}
+
 func (c *Client) GetTokenInfo(ctx context.Context, tokenId Byte32) (*TokenInfo, error) {
 	if tokenId == ALPHTokenId {
 		return &ALPHTokenInfo, nil
@@ -0,0 +1,48 @@
+package alephium
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+)
+
+// guardianSetPollInterval is the interval between two reads of the guardian sets of the governance contract.
+const guardianSetPollInterval = 15 * time.Second
+
+// fetchPreviousGuardianSet sends the guardian set replaced by the last guardian set upgrade on Alephium to setC,
+// for as long as it hasn't expired. The current guardian set is read from the EVM chains, so it is sent on every
+// poll to reach the processor once it applied the upgrade.
+func (w *Watcher) fetchPreviousGuardianSet(ctx context.Context, logger *zap.Logger, client *Client) {
+	t := time.NewTicker(guardianSetPollInterval)
+	defer t.Stop()
+
+	var last *common.GuardianSet
+	for {
+		gs, err := client.GetPreviousGuardianSet(ctx, w.governanceContractAddress, w.chainIndex.FromGroup)
+		if err != nil {
+			logger.Error("failed to get previous guardian set", zap.Error(err))
+		} else if gs != nil {
+			if last == nil || last.Index != gs.Index || !last.ExpirationTime.Equal(gs.ExpirationTime) {
+				logger.Info("previous guardian set found",
+					zap.Uint32("index", gs.Index),
+					zap.Strings("keys", gs.KeysAsHexStrings()),
+					zap.Time("expiry", gs.ExpirationTime))
+			}
+			last = gs
+			select {
+			case w.setChan <- gs:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
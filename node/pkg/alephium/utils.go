@@ -16,6 +16,7 @@ import (
 	"github.com/alephium/wormhole-fork/node/pkg/vaa"
 	"github.com/btcsuite/btcutil/base58"
 	ethCommon "github.com/ethereum/go-ethereum/common"
+	"golang.org/x/crypto/blake2b"
 )
 
 const HashLength = 32
@@ -25,6 +26,22 @@ const TransferTokenPayloadId = 1
 const AttestTokenPayloadId = 2
 const AttestTokenPayloadLength = 100
 
+// GovernanceMutFieldSize is the number of mutable fields of the governance contract, with arrays flattened:
+// receivedSequence, messageFee, guardianSets[2], guardianSetIndexes[2], previousGuardianSetExpirationTimeMS.
+const GovernanceMutFieldSize = 7
+
+// TokenBridgeMutFieldSize is the number of mutable fields of the token bridge contract:
+// receivedSequence, sendSequence, minimalConsistencyLevel, refundAddress.
+const TokenBridgeMutFieldSize = 4
+
+// TokenBridgeForChainPath is the path prefix of the TokenBridgeForChain sub-contracts of the token bridge, see
+// Path.TokenBridgeForChain in token_bridge_constants.ral.
+const TokenBridgeForChainPath = 0x01
+
+// TokenBridgeForChainImmFieldSize is the number of immutable fields of the TokenBridgeForChain contract:
+// governance, localChainId, localTokenBridge, remoteChainId, remoteTokenBridgeId, unexecutedSequenceTemplateId.
+const TokenBridgeForChainImmFieldSize = 6
+
 var ALPHTokenId Byte32
 var ALPHTokenInfo TokenInfo = TokenInfo{
 	TokenId:  ALPHTokenId,
@@ -54,6 +71,20 @@ func ToContractAddress(contractId string) (*string, error) {
 	return &address, nil
 }
 
+// SubContractId computes the id of the sub-contract created by the parent contract at path, in the given group.
+func SubContractId(parentId Byte32, path []byte, groupIndex uint8) Byte32 {
+	hash := blake2b.Sum256(append(parentId[:], path...))
+	id := Byte32(blake2b.Sum256(hash[:]))
+	id[len(id)-1] = groupIndex
+	return id
+}
+
+// TokenBridgeForChainId computes the id of the contract keeping the registered token bridge of remoteChainId.
+func TokenBridgeForChainId(tokenBridgeId Byte32, remoteChainId uint16, groupIndex uint8) Byte32 {
+	path := append([]byte{TokenBridgeForChainPath}, Uint16ToBytes(remoteChainId)...)
+	return SubContractId(tokenBridgeId, path, groupIndex)
+}
+
 func HexToByte32(str string) (Byte32, error) {
 	var byte32 Byte32
 	bytes, err := HexToFixedSizeBytes(str, 32)
@@ -294,6 +325,98 @@ func maxUint8(a, b uint8) uint8 {
 	return b
 }
 
+// ToCurrentGuardianSet parses the current guardian set from the mutable fields of the governance contract, which
+// keeps the previous and the current guardian set, each encoded as its size followed by the guardian addresses.
+func ToCurrentGuardianSet(mutFields []sdk.Val) (*common.GuardianSet, error) {
+	if len(mutFields) != GovernanceMutFieldSize {
+		return nil, fmt.Errorf("invalid governance field size, expect %d, have %d", GovernanceMutFieldSize, len(mutFields))
+	}
+	return toGuardianSet(mutFields[3], mutFields[5])
+}
+
+// ToPreviousGuardianSet parses the guardian set replaced by the current guardian set from the mutable fields of the
+// governance contract, with its expiration time. It returns nil if there is none or if it already expired.
+func ToPreviousGuardianSet(mutFields []sdk.Val) (*common.GuardianSet, error) {
+	current, err := ToCurrentGuardianSet(mutFields)
+	if err != nil {
+		return nil, err
+	}
+	expirationTimeMs, err := toUint64(mutFields[6])
+	if err != nil {
+		return nil, err
+	}
+	// Both guardian sets are the initial one until the first upgrade.
+	if current.Index == 0 {
+		return nil, nil
+	}
+	expiry := time.UnixMilli(int64(*expirationTimeMs))
+	if !time.Now().Before(expiry) {
+		return nil, nil
+	}
+	gs, err := toGuardianSet(mutFields[2], mutFields[4])
+	if err != nil {
+		return nil, err
+	}
+	if gs.Index+1 != current.Index {
+		return nil, fmt.Errorf("invalid previous guardian set index %d, current index is %d", gs.Index, current.Index)
+	}
+	gs.ExpirationTime = expiry
+	return gs, nil
+}
+
+func toGuardianSet(keysField sdk.Val, indexField sdk.Val) (*common.GuardianSet, error) {
+	keys, err := toByteVec(keysField)
+	if err != nil {
+		return nil, err
+	}
+	index, err := toU256(indexField)
+	if err != nil {
+		return nil, err
+	}
+	if !index.IsUint64() || index.Uint64() > math.MaxUint32 {
+		return nil, fmt.Errorf("invalid guardian set index %s", index)
+	}
+	if len(keys) == 0 || len(keys) != 1+int(keys[0])*ethCommon.AddressLength {
+		return nil, fmt.Errorf("invalid guardian set %s", hex.EncodeToString(keys))
+	}
+
+	gs := &common.GuardianSet{Index: uint32(index.Uint64())}
+	for offset := 1; offset < len(keys); offset += ethCommon.AddressLength {
+		gs.Keys = append(gs.Keys, ethCommon.BytesToAddress(keys[offset:offset+ethCommon.AddressLength]))
+	}
+	return gs, nil
+}
+
+// GovernanceState is the state of the governance contract updated by governance VAAs.
+type GovernanceState struct {
+	// ReceivedSequence is the next governance sequence accepted by the contract, governance VAAs with lower sequences
+	// can't be executed anymore.
+	ReceivedSequence uint64
+	MessageFee       *big.Int
+	GuardianSetIndex uint32
+}
+
+// ToGovernanceState parses the state of the governance contract from its mutable fields.
+func ToGovernanceState(mutFields []sdk.Val) (*GovernanceState, error) {
+	gs, err := ToCurrentGuardianSet(mutFields)
+	if err != nil {
+		return nil, err
+	}
+	receivedSequence, err := toUint64(mutFields[0])
+	if err != nil {
+		return nil, err
+	}
+	messageFee, err := toU256(mutFields[1])
+	if err != nil {
+		return nil, err
+	}
+	return &GovernanceState{
+		ReceivedSequence: *receivedSequence,
+		MessageFee:       messageFee,
+		GuardianSetIndex: gs.Index,
+	}, nil
+}
+
 func ToWormholeMessage(fields []sdk.Val, txId string) (*WormholeMessage, error) {
 	if len(fields) != WormholeMessageFieldSize {
 		return nil, fmt.Errorf("invalid wormhole message field size, expect %d, have %d", WormholeMessageFieldSize, len(fields))
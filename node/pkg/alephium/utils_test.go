@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	sdk "github.com/alephium/go-sdk"
 	"github.com/alephium/wormhole-fork/node/pkg/vaa"
@@ -221,3 +222,92 @@ func TestParseAttestToken(t *testing.T) {
 	assert.Nil(t, tokenInfo)
 	assert.Equal(t, err.Error(), "invalid token chain id")
 }
+
+func TestToCurrentGuardianSet(t *testing.T) {
+	key0 := "beFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe"
+	key1 := "88D7D8B32a9105d228100E72dFFe2Fae0705D31c"
+	fields := []sdk.Val{
+		u256Field(3),
+		u256Field(1000),
+		byteVecField("01" + key0),
+		byteVecField("02" + key0 + key1),
+		u256Field(0),
+		u256Field(1),
+		u256Field(0),
+	}
+	gs, err := ToCurrentGuardianSet(fields)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(1), gs.Index)
+	assert.Equal(t, []string{"0x" + key0, "0x" + key1}, gs.KeysAsHexStrings())
+
+	_, err = ToCurrentGuardianSet(fields[:6])
+	assert.NotNil(t, err)
+
+	fields[3] = byteVecField("03" + key0 + key1)
+	_, err = ToCurrentGuardianSet(fields)
+	assert.NotNil(t, err)
+}
+
+func TestToPreviousGuardianSet(t *testing.T) {
+	key0 := "beFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe"
+	key1 := "88D7D8B32a9105d228100E72dFFe2Fae0705D31c"
+	expiry := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+	fields := []sdk.Val{
+		u256Field(3),
+		u256Field(1000),
+		byteVecField("01" + key0),
+		byteVecField("02" + key0 + key1),
+		u256Field(0),
+		u256Field(1),
+		u256Field(int(expiry.UnixMilli())),
+	}
+	gs, err := ToPreviousGuardianSet(fields)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(0), gs.Index)
+	assert.Equal(t, []string{"0x" + key0}, gs.KeysAsHexStrings())
+	assert.True(t, expiry.Equal(gs.ExpirationTime))
+
+	// expired
+	fields[6] = u256Field(int(time.Now().Add(-time.Hour).UnixMilli()))
+	gs, err = ToPreviousGuardianSet(fields)
+	assert.Nil(t, err)
+	assert.Nil(t, gs)
+
+	// no upgrade yet
+	fields[5] = u256Field(0)
+	gs, err = ToPreviousGuardianSet(fields)
+	assert.Nil(t, err)
+	assert.Nil(t, gs)
+}
+
+func TestToGovernanceState(t *testing.T) {
+	key0 := "beFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe"
+	fields := []sdk.Val{
+		u256Field(3),
+		u256Field(1000),
+		byteVecField("01" + key0),
+		byteVecField("01" + key0),
+		u256Field(0),
+		u256Field(1),
+		u256Field(0),
+	}
+	state, err := ToGovernanceState(fields)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), state.ReceivedSequence)
+	assert.Equal(t, big.NewInt(1000), state.MessageFee)
+	assert.Equal(t, uint32(1), state.GuardianSetIndex)
+
+	_, err = ToGovernanceState(fields[:6])
+	assert.NotNil(t, err)
+}
+
+func TestTokenBridgeForChainId(t *testing.T) {
+	tokenBridgeId, err := HexToByte32("3c69f024ff0a7978c0e5608fe5df698dfba5ef9134a947dc189f4a5443a48600")
+	assert.Nil(t, err)
+
+	id := TokenBridgeForChainId(tokenBridgeId, 2, 1)
+	assert.Equal(t, id, SubContractId(tokenBridgeId, []byte{0x01, 0x00, 0x02}, 1))
+	assert.Equal(t, uint8(1), id[31])
+	assert.NotEqual(t, id, TokenBridgeForChainId(tokenBridgeId, 4, 1))
+	assert.NotEqual(t, id, SubContractId(tokenBridgeId, []byte{0x00, 0x00, 0x02}, 1))
+}
@@ -8,11 +8,10 @@ import (
 
 	sdk "github.com/alephium/go-sdk"
 	"github.com/alephium/wormhole-fork/node/pkg/common"
-	"github.com/alephium/wormhole-fork/node/pkg/p2p"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
 	gossipv1 "github.com/alephium/wormhole-fork/node/pkg/proto/gossip/v1"
 	"github.com/alephium/wormhole-fork/node/pkg/readiness"
 	"github.com/alephium/wormhole-fork/node/pkg/supervisor"
-	"github.com/alephium/wormhole-fork/node/pkg/vaa"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/zap"
@@ -49,6 +48,16 @@ var (
 			Name: "wormhole_alph_query_latency",
 			Help: "Latency histogram for Alephium calls",
 		}, []string{"operation"})
+	alphNextEventIndex = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_alph_next_event_index",
+			Help: "Index of the next Alephium contract event to be fetched",
+		})
+	alphPendingEvents = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_alph_pending_events",
+			Help: "Number of fetched Alephium events waiting for confirmations",
+		})
 )
 
 const BlockTimeMs = 8000
@@ -66,10 +75,17 @@ type Watcher struct {
 
 	msgChan  chan *common.MessagePublication
 	obsvReqC chan *gossipv1.ObservationRequest
+	// setChan receives the previous guardian set during guardian set transitions, it can be nil.
+	setChan chan *common.GuardianSet
 
 	blockPollerEnabled *atomic.Bool
 	pollIntervalMs     uint
 	currentHeight      int32
+	nextEventIndex     int32
+
+	// db is used to persist the next event index and the pending events, so that events emitted while
+	// the guardian was down are observed on startup. The checkpoint is disabled if db is nil.
+	db *db.Database
 
 	client    *Client
 	isMainnet bool
@@ -90,15 +106,23 @@ type ConfirmedEvent struct {
 	event  *UnconfirmedEvent
 }
 
+// FetchedEvents are the valid events fetched from the contract up to nextIndex.
+type FetchedEvents struct {
+	events    []*UnconfirmedEvent
+	nextIndex int32
+}
+
 func NewAlephiumWatcher(
 	url string,
 	apiKey string,
 	chainConfig *common.ChainConfig,
 	readiness readiness.Component,
 	messageEvents chan *common.MessagePublication,
+	setEvents chan *common.GuardianSet,
 	pollIntervalMs uint,
 	obsvReqC chan *gossipv1.ObservationRequest,
 	isMainnet bool,
+	db *db.Database,
 ) (*Watcher, error) {
 	governanceContractAddress, err := ToContractAddress(chainConfig.Contracts.Governance)
 	if err != nil {
@@ -124,10 +148,12 @@ func NewAlephiumWatcher(
 		readiness: readiness,
 		msgChan:   messageEvents,
 		obsvReqC:  obsvReqC,
+		setChan:   setEvents,
 
 		blockPollerEnabled: &atomic.Bool{},
 		pollIntervalMs:     pollIntervalMs,
 
+		db:        db,
 		client:    NewClient(url, apiKey, 10),
 		isMainnet: isMainnet,
 	}
@@ -143,9 +169,7 @@ func (w *Watcher) EnableBlockPoller() {
 }
 
 func (w *Watcher) Run(ctx context.Context) error {
-	p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDAlephium, &gossipv1.Heartbeat_Network{
-		ContractAddress: w.governanceContractAddress,
-	})
+	w.setNetworkStats()
 
 	logger := supervisor.Logger(ctx)
 	nodeVersion, err := w.client.GetNodeVersion(ctx)
@@ -165,22 +189,36 @@ func (w *Watcher) Run(ctx context.Context) error {
 
 	logger.Info("alephium watcher started", zap.String("url", w.url), zap.String("version", nodeVersion.Version))
 
+	fromIndex, restored, err := w.loadCheckpoint(logger)
+	if err != nil {
+		logger.Error("failed to load checkpoint", zap.Error(err))
+		return err
+	}
+	nextIndex, err := w.startEventIndex(ctx, logger, w.client, fromIndex)
+	if err != nil {
+		return err
+	}
+	if fromIndex == nil || *fromIndex != nextIndex {
+		// The pending events of an ignored checkpoint are not valid either. The start index is stored right away,
+		// otherwise the events emitted before the first fetch would be skipped on restart.
+		restored = nil
+		w.storeCheckpoint(logger, nil)
+	}
+
 	readiness.SetReady(w.readiness)
-	errC := make(chan error)
-	eventsC := make(chan []*UnconfirmedEvent)
+	eventsC := make(chan *FetchedEvents)
 	heightC := make(chan int32)
 
-	go w.fetchEvents(ctx, logger, w.client, errC, eventsC)
+	go w.fetchEvents(ctx, logger, w.client, nextIndex, eventsC)
 	go w.handleObsvRequest(ctx, logger, w.client)
 	go w.fetchHeight(ctx, logger, w.client, heightC)
-	go w.handleEvents(ctx, logger, w.client, eventsC, heightC)
-
-	select {
-	case <-ctx.Done():
-		return ctx.Err()
-	case err := <-errC:
-		return err
+	go w.handleEvents(ctx, logger, w.client, restored, eventsC, heightC)
+	if w.setChan != nil {
+		go w.fetchPreviousGuardianSet(ctx, logger, w.client)
 	}
+
+	<-ctx.Done()
+	return ctx.Err()
 }
 
 func (w *Watcher) validateAttestToken(ctx context.Context, msg *WormholeMessage) error {
@@ -198,16 +236,33 @@ func (w *Watcher) validateAttestToken(ctx context.Context, msg *WormholeMessage)
 	return nil
 }
 
-func (w *Watcher) fetchEvents(ctx context.Context, logger *zap.Logger, client *Client, errC chan<- error, eventsC chan<- []*UnconfirmedEvent) {
+// startEventIndex returns the index of the first contract event to fetch, fromIndex, or the current event count if
+// fromIndex is nil or ahead of the event count.
+func (w *Watcher) startEventIndex(ctx context.Context, logger *zap.Logger, client *Client, fromIndex *int32) (int32, error) {
 	contractAddress := w.governanceContractAddress
 	currentEventCount, err := client.GetContractEventsCount(ctx, contractAddress)
 	if err != nil {
 		logger.Error("failed to get contract event count", zap.String("contractAddress", contractAddress), zap.Error(err))
-		errC <- err
-		return
+		return 0, err
 	}
 
-	fromIndex := *currentEventCount
+	nextIndex := *currentEventCount
+	if fromIndex != nil {
+		if *fromIndex > *currentEventCount {
+			logger.Warn("checkpoint is ahead of the contract event count, ignoring it",
+				zap.Int32("checkpoint", *fromIndex), zap.Int32("count", *currentEventCount))
+		} else {
+			logger.Info("resuming from checkpoint", zap.Int32("fromIndex", *fromIndex), zap.Int32("count", *currentEventCount))
+			nextIndex = *fromIndex
+		}
+	}
+	w.setNextEventIndex(nextIndex)
+	return nextIndex, nil
+}
+
+// fetchEvents fetches the contract events from nextIndex.
+func (w *Watcher) fetchEvents(ctx context.Context, logger *zap.Logger, client *Client, nextIndex int32, eventsC chan<- *FetchedEvents) {
+	contractAddress := w.governanceContractAddress
 	eventTick := time.NewTicker(time.Duration(w.pollIntervalMs) * time.Millisecond)
 	defer eventTick.Stop()
 
@@ -223,34 +278,37 @@ func (w *Watcher) fetchEvents(ctx context.Context, logger *zap.Logger, client *C
 				// It’s safe to ignore this error, since we will refetch the event count on the next timer tick
 				continue
 			}
-			logger.Info("alephium contract event count", zap.Int32("count", *count), zap.Int32("fromIndex", fromIndex))
+			logger.Info("alephium contract event count", zap.Int32("count", *count), zap.Int32("fromIndex", nextIndex))
 
-			if *count == fromIndex {
+			if *count == nextIndex {
 				continue
 			}
 
+			fromIndex := nextIndex
 			unconfirmedEvents := make([]*UnconfirmedEvent, 0)
 			for {
-				events, err := client.GetContractEvents(ctx, contractAddress, fromIndex, w.chainIndex.FromGroup)
+				events, err := client.GetContractEvents(ctx, contractAddress, nextIndex, w.chainIndex.FromGroup)
 				if err != nil {
-					logger.Error("failed to get contract events", zap.Int32("fromIndex", fromIndex), zap.Error(err))
-					// It’s safe to ignore this error, since we will refetch the events from `fromIndex` in the next timer tick
+					logger.Error("failed to get contract events", zap.Int32("fromIndex", nextIndex), zap.Error(err))
+					// It’s safe to ignore this error, since we will refetch the events from `nextIndex` in the next timer tick
 					break
 				}
 
 				unconfirmed := w.handleUnconfirmedEvents(ctx, logger, events)
 				unconfirmedEvents = append(unconfirmedEvents, unconfirmed...)
 
-				fromIndex = events.NextStart
+				nextIndex = events.NextStart
 				if events.NextStart == *count {
 					break
 				}
 			}
 
-			if len(unconfirmedEvents) > 0 {
-				alphMessagesObserved.Add(float64(len(unconfirmedEvents)))
-				eventsC <- unconfirmedEvents
+			if nextIndex == fromIndex {
+				continue
 			}
+			alphMessagesObserved.Add(float64(len(unconfirmedEvents)))
+			// The next index is sent even if there is no valid event, so that the checkpoint moves forward
+			eventsC <- &FetchedEvents{events: unconfirmedEvents, nextIndex: nextIndex}
 		}
 	}
 }
@@ -305,15 +363,12 @@ func (w *Watcher) _fetchHeight(ctx context.Context, logger *zap.Logger, getCurre
 				continue
 			}
 
-			previousHeight := w.currentHeight
+			previousHeight := atomic.LoadInt32(&w.currentHeight)
 			if *latestHeight != previousHeight {
 				logger.Info("block height changed", zap.Int32("prevHeight", previousHeight), zap.Int32("latestHeight", *latestHeight))
-				p2p.DefaultRegistry.SetNetworkStats(vaa.ChainIDAlephium, &gossipv1.Heartbeat_Network{
-					ContractAddress: w.governanceContractAddress,
-					Height:          int64(*latestHeight),
-				})
 				currentAlphHeight.Set(float64(*latestHeight))
-				w.currentHeight = *latestHeight
+				atomic.StoreInt32(&w.currentHeight, *latestHeight)
+				w.setNetworkStats()
 			}
 
 			// Always send the block height to avoid having enough block confirmations but not enough confirmation time
@@ -357,7 +412,7 @@ func (w *Watcher) toUnconfirmedEvent(event *sdk.ContractEvent) (*UnconfirmedEven
 	return &UnconfirmedEvent{event, msg}, err
 }
 
-func (w *Watcher) handleEvents(ctx context.Context, logger *zap.Logger, client *Client, eventsC <-chan []*UnconfirmedEvent, heightC <-chan int32) {
+func (w *Watcher) handleEvents(ctx context.Context, logger *zap.Logger, client *Client, restored []*UnconfirmedEvent, eventsC <-chan *FetchedEvents, heightC <-chan int32) {
 	isBlockInMainChain := func(hash string) (*bool, error) {
 		return client.IsBlockInMainChain(ctx, hash)
 	}
@@ -365,7 +420,7 @@ func (w *Watcher) handleEvents(ctx context.Context, logger *zap.Logger, client *
 		return client.GetBlockHeader(ctx, hash)
 	}
 
-	w.handleEvents_(ctx, logger, isBlockInMainChain, getBlockHeader, w.handleConfirmedEvents, eventsC, heightC)
+	w.handleEvents_(ctx, logger, isBlockInMainChain, getBlockHeader, w.handleConfirmedEvents, restored, eventsC, heightC)
 }
 
 func (w *Watcher) handleEvents_(
@@ -374,15 +429,33 @@ func (w *Watcher) handleEvents_(
 	isBlockInMainChain func(string) (*bool, error),
 	getBlockHeader func(string) (*sdk.BlockHeaderEntry, error),
 	handler func(*zap.Logger, []*ConfirmedEvent),
-	eventsC <-chan []*UnconfirmedEvent,
+	restored []*UnconfirmedEvent,
+	eventsC <-chan *FetchedEvents,
 	heightC <-chan int32,
 ) {
 	pendingEvents := map[string]*UnconfirmedEventsPerBlock{}
+	addEvents := func(events []*UnconfirmedEvent) {
+		if len(events) != 0 {
+			w.EnableBlockPoller()
+		}
+		for _, event := range events {
+			blockHash := event.BlockHash
+			if lst, ok := pendingEvents[blockHash]; ok {
+				lst.events = append(lst.events, event)
+			} else {
+				pendingEvents[blockHash] = &UnconfirmedEventsPerBlock{
+					events: []*UnconfirmedEvent{event},
+				}
+			}
+		}
+	}
+	addEvents(restored)
 
 	process := func(height int32) error {
 		now := time.Now().UnixMilli()
 		logger.Debug("processing events", zap.Int32("height", height))
 		confirmedEvents := make([]*ConfirmedEvent, 0)
+		pendingChanged := false
 		for blockHash, blockEvents := range pendingEvents {
 			isCanonical, err := isBlockInMainChain(blockHash)
 			if err != nil {
@@ -424,6 +497,9 @@ func (w *Watcher) handleEvents_(
 				})
 			}
 
+			if len(remain) != len(blockEvents.events) {
+				pendingChanged = true
+			}
 			if len(remain) == 0 {
 				delete(pendingEvents, blockHash)
 			} else {
@@ -433,10 +509,12 @@ func (w *Watcher) handleEvents_(
 		if len(pendingEvents) == 0 {
 			w.DisableBlockPoller()
 		}
-		if len(confirmedEvents) == 0 {
-			return nil
+		if len(confirmedEvents) != 0 {
+			handler(logger, confirmedEvents)
+		}
+		if pendingChanged {
+			w.storeCheckpoint(logger, pendingEvents)
 		}
-		handler(logger, confirmedEvents)
 		return nil
 	}
 
@@ -445,20 +523,10 @@ func (w *Watcher) handleEvents_(
 		case <-ctx.Done():
 			return
 
-		case events := <-eventsC:
-			if len(events) != 0 {
-				w.EnableBlockPoller()
-			}
-			for _, event := range events {
-				blockHash := event.BlockHash
-				if lst, ok := pendingEvents[blockHash]; ok {
-					lst.events = append(lst.events, event)
-				} else {
-					pendingEvents[blockHash] = &UnconfirmedEventsPerBlock{
-						events: []*UnconfirmedEvent{event},
-					}
-				}
-			}
+		case fetched := <-eventsC:
+			addEvents(fetched.events)
+			w.setNextEventIndex(fetched.nextIndex)
+			w.storeCheckpoint(logger, pendingEvents)
 
 		case height := <-heightC:
 			if err := process(height); err != nil {
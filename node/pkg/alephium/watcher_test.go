@@ -49,7 +49,7 @@ func TestSubscribeEvents(t *testing.T) {
 
 	logger, err := zap.NewDevelopment()
 	assert.Nil(t, err)
-	eventsC := make(chan []*UnconfirmedEvent)
+	eventsC := make(chan *FetchedEvents)
 	heightC := make(chan int32)
 
 	isBlockInMainChain := func(hash string) (*bool, error) {
@@ -79,11 +79,11 @@ func TestSubscribeEvents(t *testing.T) {
 		}, nil
 	}
 
-	go watcher.handleEvents_(ctx, logger, isBlockInMainChain, getBlockHeader, handler, eventsC, heightC)
+	go watcher.handleEvents_(ctx, logger, isBlockInMainChain, getBlockHeader, handler, nil, eventsC, heightC)
 
 	sendEventsAtHeight := func(height int32, unconfirmedEvents []*UnconfirmedEvent) {
 		atomic.StoreInt32(&watcher.currentHeight, height)
-		eventsC <- unconfirmedEvents
+		eventsC <- &FetchedEvents{events: unconfirmedEvents}
 		heightC <- height
 		time.Sleep(500 * time.Millisecond)
 	}
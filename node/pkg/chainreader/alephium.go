@@ -0,0 +1,40 @@
+package chainreader
+
+import (
+	"fmt"
+
+	"github.com/alephium/wormhole-fork/node/pkg/alephium"
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+)
+
+// AlephiumClient reads the governance contract and the token bridge of Alephium.
+type AlephiumClient struct {
+	*alephium.Client
+	// Governance and TokenBridge are the addresses of the contracts, TokenBridgeID is the contract id of the token bridge.
+	Governance    string
+	TokenBridge   string
+	TokenBridgeID alephium.Byte32
+	GroupIndex    uint8
+}
+
+func NewAlephiumClient(url string, apiKey string, chainConfig *common.ChainConfig) (*AlephiumClient, error) {
+	governance, err := alephium.ToContractAddress(chainConfig.Contracts.Governance)
+	if err != nil {
+		return nil, fmt.Errorf("invalid governance contract id %s: %w", chainConfig.Contracts.Governance, err)
+	}
+	tokenBridge, err := alephium.ToContractAddress(chainConfig.Contracts.TokenBridge)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token bridge contract id %s: %w", chainConfig.Contracts.TokenBridge, err)
+	}
+	tokenBridgeID, err := alephium.HexToByte32(chainConfig.Contracts.TokenBridge)
+	if err != nil {
+		return nil, fmt.Errorf("invalid token bridge contract id %s: %w", chainConfig.Contracts.TokenBridge, err)
+	}
+	return &AlephiumClient{
+		Client:        alephium.NewClient(url, apiKey, 10),
+		Governance:    *governance,
+		TokenBridge:   *tokenBridge,
+		TokenBridgeID: tokenBridgeID,
+		GroupIndex:    chainConfig.GroupIndex,
+	}, nil
+}
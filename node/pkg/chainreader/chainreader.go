@@ -0,0 +1,62 @@
+// Package chainreader holds the parts shared by the components which periodically read the state of the contracts of
+// every chain: the guardian set monitor, the token bridge registration checker and the governance tracker.
+//
+// Each component compares what the contracts of the chains report with what the guardian expects, publishes the
+// differences in metrics and keeps the most recent view of each chain for the admin RPC. The chains are read over the
+// RPC endpoints of the watchers, one query at a time, and a chain which can't be read keeps its last known state until
+// the next check. The clients are created once per chain and shared by the components.
+package chainreader
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// QueryTimeout is the timeout of a single query to a chain.
+const QueryTimeout = 30 * time.Second
+
+// Query runs query with a context limited to QueryTimeout.
+func Query[T any](ctx context.Context, query func(ctx context.Context) (T, error)) (T, error) {
+	timeout, cancel := context.WithTimeout(ctx, QueryTimeout)
+	defer cancel()
+	return query(timeout)
+}
+
+// Run calls check immediately and then every interval until ctx is done.
+func Run(ctx context.Context, interval time.Duration, check func(ctx context.Context)) error {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		check(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+		}
+	}
+}
+
+// Snapshot holds the most recent view of the chains, which is replaced by every check and read concurrently by the
+// admin RPC. Stored values must not be modified afterwards.
+type Snapshot[T any] struct {
+	mutex sync.Mutex
+	value T
+}
+
+func NewSnapshot[T any](value T) *Snapshot[T] {
+	return &Snapshot[T]{value: value}
+}
+
+func (s *Snapshot[T]) Load() T {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.value
+}
+
+func (s *Snapshot[T]) Store(value T) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.value = value
+}
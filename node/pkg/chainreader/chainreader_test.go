@@ -0,0 +1,39 @@
+package chainreader
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuery(t *testing.T) {
+	deadline, err := Query(context.Background(), func(ctx context.Context) (time.Time, error) {
+		deadline, ok := ctx.Deadline()
+		require.True(t, ok)
+		return deadline, nil
+	})
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(QueryTimeout), deadline, time.Second)
+}
+
+func TestRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	checks := 0
+	// The first check runs without waiting for the interval.
+	err := Run(ctx, time.Hour, func(ctx context.Context) {
+		checks++
+		cancel()
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, checks)
+}
+
+func TestSnapshot(t *testing.T) {
+	s := NewSnapshot([]int{1})
+	assert.Equal(t, []int{1}, s.Load())
+	s.Store([]int{2, 3})
+	assert.Equal(t, []int{2, 3}, s.Load())
+}
@@ -0,0 +1,82 @@
+package chainreader
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethclient "github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	ethabi "github.com/alephium/wormhole-fork/node/pkg/ethereum/abi"
+	"github.com/alephium/wormhole-fork/node/pkg/ethereum/abi/tokenbridge"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+// EvmClient reads the core contract and the token bridge of an EVM chain. The connection is established on the first
+// query, and again on the next query if it failed. It is safe for concurrent use.
+type EvmClient struct {
+	chainID     vaa.ChainID
+	networkName string
+	rpc         string
+	governance  ethcommon.Address
+	tokenBridge ethcommon.Address
+
+	mutex  sync.Mutex
+	core   *ethabi.AbiCaller
+	bridge *tokenbridge.TokenbridgeCaller
+}
+
+func NewEvmClient(chain *common.EvmChainConfig) *EvmClient {
+	return &EvmClient{
+		chainID:     chain.WormholeChainId(),
+		networkName: chain.NetworkName,
+		rpc:         chain.Rpc,
+		governance:  ethcommon.HexToAddress(chain.Contracts.Governance),
+		tokenBridge: ethcommon.HexToAddress(chain.Contracts.TokenBridge),
+	}
+}
+
+func (c *EvmClient) ChainID() vaa.ChainID {
+	return c.chainID
+}
+
+// Core returns the binding of the core contract.
+func (c *EvmClient) Core(ctx context.Context) (*ethabi.AbiCaller, error) {
+	if err := c.connect(ctx); err != nil {
+		return nil, err
+	}
+	return c.core, nil
+}
+
+// TokenBridge returns the binding of the token bridge.
+func (c *EvmClient) TokenBridge(ctx context.Context) (*tokenbridge.TokenbridgeCaller, error) {
+	if err := c.connect(ctx); err != nil {
+		return nil, err
+	}
+	return c.bridge, nil
+}
+
+func (c *EvmClient) connect(ctx context.Context) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.core != nil {
+		return nil
+	}
+	client, err := ethclient.DialContext(ctx, c.rpc)
+	if err != nil {
+		return fmt.Errorf("failed to connect to %s: %w", c.networkName, err)
+	}
+	core, err := ethabi.NewAbiCaller(c.governance, client)
+	if err != nil {
+		return err
+	}
+	bridge, err := tokenbridge.NewTokenbridgeCaller(c.tokenBridge, client)
+	if err != nil {
+		return err
+	}
+	c.core, c.bridge = core, bridge
+	return nil
+}
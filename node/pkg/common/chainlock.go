@@ -1,6 +1,10 @@
 package common
 
 import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
 	"time"
 
 	"github.com/alephium/wormhole-fork/node/pkg/vaa"
@@ -20,3 +24,73 @@ type MessagePublication struct {
 	EmitterAddress   vaa.Address
 	Payload          []byte
 }
+
+// MessageIDString returns the message id of the VAA which will be created from this message publication.
+func (msg *MessagePublication) MessageIDString() string {
+	return fmt.Sprintf("%d/%s/%d/%d", msg.EmitterChain, msg.EmitterAddress, msg.TargetChain, msg.Sequence)
+}
+
+// Marshal serializes the message publication, it is used to persist messages in the local database.
+func (msg *MessagePublication) Marshal() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	buf.Write(msg.TxHash[:])
+	vaa.MustWrite(buf, binary.BigEndian, uint32(msg.Timestamp.Unix()))
+	vaa.MustWrite(buf, binary.BigEndian, msg.Nonce)
+	vaa.MustWrite(buf, binary.BigEndian, msg.Sequence)
+	vaa.MustWrite(buf, binary.BigEndian, msg.ConsistencyLevel)
+	vaa.MustWrite(buf, binary.BigEndian, msg.EmitterChain)
+	vaa.MustWrite(buf, binary.BigEndian, msg.TargetChain)
+	buf.Write(msg.EmitterAddress[:])
+	buf.Write(msg.Payload)
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalMessagePublication deserializes a message publication serialized by MessagePublication.Marshal.
+func UnmarshalMessagePublication(data []byte) (*MessagePublication, error) {
+	msg := &MessagePublication{}
+	reader := bytes.NewReader(data)
+
+	if n, err := reader.Read(msg.TxHash[:]); err != nil || n != common.HashLength {
+		return nil, fmt.Errorf("failed to read tx hash [%d]: %w", n, err)
+	}
+
+	unixSeconds := uint32(0)
+	if err := binary.Read(reader, binary.BigEndian, &unixSeconds); err != nil {
+		return nil, fmt.Errorf("failed to read timestamp: %w", err)
+	}
+	msg.Timestamp = time.Unix(int64(unixSeconds), 0)
+
+	if err := binary.Read(reader, binary.BigEndian, &msg.Nonce); err != nil {
+		return nil, fmt.Errorf("failed to read nonce: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &msg.Sequence); err != nil {
+		return nil, fmt.Errorf("failed to read sequence: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &msg.ConsistencyLevel); err != nil {
+		return nil, fmt.Errorf("failed to read consistency level: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &msg.EmitterChain); err != nil {
+		return nil, fmt.Errorf("failed to read emitter chain: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &msg.TargetChain); err != nil {
+		return nil, fmt.Errorf("failed to read target chain: %w", err)
+	}
+
+	if n, err := reader.Read(msg.EmitterAddress[:]); err != nil || n != len(msg.EmitterAddress) {
+		return nil, fmt.Errorf("failed to read emitter address [%d]: %w", n, err)
+	}
+
+	payload, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read payload: %w", err)
+	}
+	msg.Payload = payload
+
+	return msg, nil
+}
@@ -18,6 +18,7 @@ const DEVNET NetworkId = 2
 const UNKNOWN_NETWORK NetworkId = 3
 
 type ChainConfig struct {
+	ChainId    uint16    `json:"chainId"`
 	GroupIndex uint8     `json:"groupIndex,omitempty"`
 	NodeUrl    string    `json:"nodeUrl"`
 	Contracts  Contracts `json:"contracts"`
@@ -32,9 +33,46 @@ type Contracts struct {
 }
 
 type GuardianConfig struct {
-	GovernanceChainId        uint16   `json:"governanceChainId"`
-	GovernanceEmitterAddress string   `json:"governanceEmitterAddress"`
-	GuardianUrls             []string `json:"guardianUrls"`
+	GovernanceChainId        uint16             `json:"governanceChainId"`
+	GovernanceEmitterAddress string             `json:"governanceEmitterAddress"`
+	GuardianUrls             []string           `json:"guardianUrls"`
+	EvmChains                []EvmWatcherConfig `json:"evmChains"`
+}
+
+const (
+	// EvmFinalityFinalized waits for blocks to be finalized by the consensus layer.
+	EvmFinalityFinalized = "finalized"
+	// EvmFinalityLatest waits for the number of confirmations of the consistency level, counted from the latest block.
+	EvmFinalityLatest = "latest"
+)
+
+const defaultEvmPollIntervalMs = 3000
+
+// EvmWatcherConfig describes how the guardian watches an EVM chain.
+type EvmWatcherConfig struct {
+	// Name of the chain config directory, e.g. "ethereum" for configs/ethereum/<network>.json
+	Chain string `json:"chain"`
+	// Human-readable name of the network, used for logging, metrics and readiness, e.g. "eth"
+	NetworkName string `json:"networkName"`
+	// Default RPC url, it can be overridden by the --evmRPC flag of the guardian
+	Rpc                  string `json:"rpc,omitempty"`
+	Finality             string `json:"finality"`
+	PollIntervalMs       uint   `json:"pollIntervalMs,omitempty"`
+	WaitForConfirmations bool   `json:"waitForConfirmations"`
+	// Maximum number of confirmations to wait before declaring a transaction abandoned, 0 to use the watcher default
+	MaxWaitConfirmations uint64 `json:"maxWaitConfirmations,omitempty"`
+	// Maximum number of blocks scanned for missed messages on startup, 0 to use the watcher default
+	MaxCatchupBlocks uint64 `json:"maxCatchupBlocks,omitempty"`
+}
+
+// EvmChainConfig is an entry of the EVM chain registry.
+type EvmChainConfig struct {
+	*ChainConfig
+	EvmWatcherConfig
+}
+
+func (c *EvmChainConfig) WormholeChainId() vaa.ChainID {
+	return vaa.ChainID(c.ChainId)
 }
 
 type EmitterAddress struct {
@@ -43,14 +81,28 @@ type EmitterAddress struct {
 }
 
 type BridgeConfig struct {
-	Network          NetworkId
-	Alephium         *ChainConfig
+	Network  NetworkId
+	Alephium *ChainConfig
+	// EvmChains is the list of EVM chains watched by the guardian.
+	EvmChains []*EvmChainConfig
+	// Ethereum and Bsc are shortcuts to the configs of the corresponding EvmChains entries,
+	// they are nil if the chain is not in the registry.
 	Ethereum         *ChainConfig
 	Bsc              *ChainConfig
 	Guardian         *GuardianConfig
 	EmitterAddresses []EmitterAddress
 }
 
+// EvmChain returns the EVM chain registry entry of the given chain, or nil if the chain is not in the registry.
+func (c *BridgeConfig) EvmChain(chainId vaa.ChainID) *EvmChainConfig {
+	for _, chain := range c.EvmChains {
+		if chain.WormholeChainId() == chainId {
+			return chain
+		}
+	}
+	return nil
+}
+
 var configPath *string
 
 func getConfigPath() (*string, error) {
@@ -144,6 +196,51 @@ func ReadBscConfig(network string) (*ChainConfig, error) {
 	return readAndValidateChainConfig("bsc", fileName)
 }
 
+func validateEvmWatcherConfig(config *EvmWatcherConfig) error {
+	if config.Chain == "" {
+		return fmt.Errorf("empty chain in evm chain config")
+	}
+	if config.NetworkName == "" {
+		return fmt.Errorf("empty network name in evm chain config %s", config.Chain)
+	}
+	if config.Finality != EvmFinalityFinalized && config.Finality != EvmFinalityLatest {
+		return fmt.Errorf("invalid finality %s in evm chain config %s", config.Finality, config.Chain)
+	}
+	if config.PollIntervalMs == 0 {
+		config.PollIntervalMs = defaultEvmPollIntervalMs
+	}
+	return nil
+}
+
+// ReadEvmChainConfigs reads the configs of all EVM chains listed in the guardian config.
+func ReadEvmChainConfigs(network string, guardianConfig *GuardianConfig) ([]*EvmChainConfig, error) {
+	fileName := fmt.Sprintf("%s.json", network)
+	chains := make([]*EvmChainConfig, 0, len(guardianConfig.EvmChains))
+	for _, watcherConfig := range guardianConfig.EvmChains {
+		if err := validateEvmWatcherConfig(&watcherConfig); err != nil {
+			return nil, err
+		}
+		chainConfig, err := readAndValidateChainConfig(watcherConfig.Chain, fileName)
+		if err != nil {
+			return nil, err
+		}
+		chain := &EvmChainConfig{ChainConfig: chainConfig, EvmWatcherConfig: watcherConfig}
+		if chain.WormholeChainId() == vaa.ChainIDUnset {
+			return nil, fmt.Errorf("empty chain id in config %s/%s", watcherConfig.Chain, fileName)
+		}
+		for _, c := range chains {
+			if c.WormholeChainId() == chain.WormholeChainId() {
+				return nil, fmt.Errorf("duplicate evm chain id %v in guardian config", chain.WormholeChainId())
+			}
+			if c.NetworkName == chain.NetworkName {
+				return nil, fmt.Errorf("duplicate evm network name %s in guardian config", chain.NetworkName)
+			}
+		}
+		chains = append(chains, chain)
+	}
+	return chains, nil
+}
+
 func toNetworkId(network string) (NetworkId, error) {
 	switch network {
 	case "mainnet":
@@ -166,28 +263,29 @@ func ReadConfigsByNetwork(network string) (*BridgeConfig, error) {
 	if err != nil {
 		return nil, err
 	}
-	ethConfig, err := ReadEthereumConfig(network)
+	guardianConfig, err := ReadGuardianConfig(network)
 	if err != nil {
 		return nil, err
 	}
-	bscConfig, err := ReadBscConfig(network)
+	evmChains, err := ReadEvmChainConfigs(network, guardianConfig)
 	if err != nil {
 		return nil, err
 	}
-	guardianConfig, err := ReadGuardianConfig(network)
-	if err != nil {
-		return nil, err
+	bridgeConfig := &BridgeConfig{
+		Network:   networkId,
+		Alephium:  alphConfig,
+		EvmChains: evmChains,
+		Guardian:  guardianConfig,
+	}
+	if ethereum := bridgeConfig.EvmChain(vaa.ChainIDEthereum); ethereum != nil {
+		bridgeConfig.Ethereum = ethereum.ChainConfig
+		bridgeConfig.EmitterAddresses = []EmitterAddress{
+			{vaa.ChainIDEthereum, alphConfig.TokenBridgeEmitterAddress},
+			{vaa.ChainIDAlephium, ethereum.TokenBridgeEmitterAddress},
+		}
+	}
+	if bsc := bridgeConfig.EvmChain(vaa.ChainIDBSC); bsc != nil {
+		bridgeConfig.Bsc = bsc.ChainConfig
 	}
-	emitterAddresses := []EmitterAddress{
-		{vaa.ChainIDEthereum, alphConfig.TokenBridgeEmitterAddress},
-		{vaa.ChainIDAlephium, ethConfig.TokenBridgeEmitterAddress},
-	}
-	return &BridgeConfig{
-		Network:          networkId,
-		Alephium:         alphConfig,
-		Ethereum:         ethConfig,
-		Bsc:              bscConfig,
-		Guardian:         guardianConfig,
-		EmitterAddresses: emitterAddresses,
-	}, nil
+	return bridgeConfig, nil
 }
@@ -0,0 +1,65 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/stretchr/testify/assert"
+)
+
+func setTestConfigPath() {
+	path := "../../../configs"
+	configPath = &path
+}
+
+func TestReadConfigsByNetwork(t *testing.T) {
+	setTestConfigPath()
+
+	for _, network := range []string{"devnet", "testnet", "mainnet"} {
+		config, err := ReadConfigsByNetwork(network)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, len(config.EvmChains))
+
+		eth := config.EvmChain(vaa.ChainIDEthereum)
+		assert.NotNil(t, eth)
+		assert.Equal(t, "eth", eth.NetworkName)
+		assert.Equal(t, eth.ChainConfig, config.Ethereum)
+
+		bsc := config.EvmChain(vaa.ChainIDBSC)
+		assert.NotNil(t, bsc)
+		assert.Equal(t, "bsc", bsc.NetworkName)
+		assert.Equal(t, bsc.ChainConfig, config.Bsc)
+
+		assert.Nil(t, config.EvmChain(vaa.ChainIDSolana))
+	}
+}
+
+func TestReadEvmChainConfigs(t *testing.T) {
+	setTestConfigPath()
+
+	guardianConfig := &GuardianConfig{EvmChains: []EvmWatcherConfig{
+		{Chain: "ethereum", NetworkName: "eth", Finality: EvmFinalityFinalized},
+	}}
+	chains, err := ReadEvmChainConfigs("devnet", guardianConfig)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(chains))
+	assert.Equal(t, uint(defaultEvmPollIntervalMs), chains[0].PollIntervalMs)
+
+	guardianConfig.EvmChains[0].Finality = "safe"
+	_, err = ReadEvmChainConfigs("devnet", guardianConfig)
+	assert.NotNil(t, err)
+
+	guardianConfig.EvmChains = []EvmWatcherConfig{
+		{Chain: "ethereum", NetworkName: "eth", Finality: EvmFinalityFinalized},
+		{Chain: "ethereum", NetworkName: "eth2", Finality: EvmFinalityFinalized},
+	}
+	_, err = ReadEvmChainConfigs("devnet", guardianConfig)
+	assert.NotNil(t, err)
+
+	guardianConfig.EvmChains = []EvmWatcherConfig{
+		{Chain: "ethereum", NetworkName: "eth", Finality: EvmFinalityFinalized},
+		{Chain: "bsc", NetworkName: "eth", Finality: EvmFinalityLatest},
+	}
+	_, err = ReadEvmChainConfigs("devnet", guardianConfig)
+	assert.NotNil(t, err)
+}
@@ -9,8 +9,8 @@ import (
 	"google.golang.org/grpc"
 )
 
-func NewInstrumentedGRPCServer(logger *zap.Logger) *grpc.Server {
-	server := grpc.NewServer(
+func NewInstrumentedGRPCServer(logger *zap.Logger, opts ...grpc.ServerOption) *grpc.Server {
+	opts = append(opts,
 		grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
 			grpc_ctxtags.StreamServerInterceptor(),
 			grpc_prometheus.StreamServerInterceptor,
@@ -22,6 +22,7 @@ func NewInstrumentedGRPCServer(logger *zap.Logger) *grpc.Server {
 			grpc_zap.UnaryServerInterceptor(logger),
 		)),
 	)
+	server := grpc.NewServer(opts...)
 
 	grpc_prometheus.EnableHandlingTimeHistogram()
 	grpc_prometheus.Register(server)
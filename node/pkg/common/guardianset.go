@@ -51,6 +51,9 @@ type GuardianSet struct {
 	Keys []common.Address
 	// On-chain set index
 	Index uint32
+	// ExpirationTime is when a replaced guardian set stops being accepted by the core contract it was read from,
+	// zero for the current guardian set.
+	ExpirationTime time.Time
 }
 
 func (g *GuardianSet) KeysAsHexStrings() []string {
@@ -78,6 +81,10 @@ func (g *GuardianSet) KeyIndex(addr common.Address) (int, bool) {
 type GuardianSetState struct {
 	mu      sync.Mutex
 	current *GuardianSet
+	// previous is the guardian set replaced by current, which remains valid until previousExpiry during a
+	// guardian set transition. Nil outside of transitions.
+	previous       *GuardianSet
+	previousExpiry time.Time
 
 	// Last heartbeat message received per guardian per p2p node. Maintained
 	// across guardian set updates - these values don't change.
@@ -108,6 +115,23 @@ func (st *GuardianSetState) Get() *GuardianSet {
 	return st.current
 }
 
+// SetPrevious sets the previous guardian set during a guardian set transition, or nil when the transition ended.
+func (st *GuardianSetState) SetPrevious(set *GuardianSet, expiry time.Time) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.previous = set
+	st.previousExpiry = expiry
+}
+
+// GetPrevious returns the previous guardian set and its expiry, or nil if there is no guardian set transition.
+func (st *GuardianSetState) GetPrevious() (*GuardianSet, time.Time) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	return st.previous, st.previousExpiry
+}
+
 // LastHeartbeat returns the most recent heartbeat message received for
 // a given guardian node, or nil if none have been received.
 func (st *GuardianSetState) LastHeartbeat(addr common.Address) map[peer.ID]*gossipv1.Heartbeat {
@@ -3,11 +3,9 @@ package common
 import "github.com/alephium/wormhole-fork/node/pkg/readiness"
 
 const (
-	ReadinessEthSyncing        readiness.Component = "ethSyncing"
 	ReadinessSolanaSyncing     readiness.Component = "solanaSyncing"
 	ReadinessTerraSyncing      readiness.Component = "terraSyncing"
 	ReadinessAlgorandSyncing   readiness.Component = "algorandSyncing"
-	ReadinessBSCSyncing        readiness.Component = "bscSyncing"
 	ReadinessPolygonSyncing    readiness.Component = "polygonSyncing"
 	ReadinessEthRopstenSyncing readiness.Component = "ethRopstenSyncing"
 	ReadinessAvalancheSyncing  readiness.Component = "avalancheSyncing"
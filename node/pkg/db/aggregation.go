@@ -0,0 +1,71 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const aggregationStatePrefix = "aggregation/"
+
+// AggregationState is the persisted form of the processor's local view of a VAA which has not been
+// expired yet. It is used to restore partially collected signatures after a restart.
+type AggregationState struct {
+	Digest        string
+	FirstObserved time.Time
+	LastRetry     time.Time
+	Submitted     bool
+	Settled       bool
+	Source        string
+	RetryCount    uint
+	// Serialized unsigned VAA we constructed when we made our own observation, nil if we haven't observed it.
+	OurVAA []byte
+	OurMsg []byte
+	TxHash []byte
+	// Signatures seen by guardian, keyed by hex-encoded guardian address.
+	Signatures map[string][]byte
+	// Guardian set valid at observation time, GuardianSetKeys is nil if unknown.
+	GuardianSetIndex uint32
+	GuardianSetKeys  []string
+	// Emitter chains and transaction hashes reported by each guardian for a message we haven't observed, keyed by
+	// hex-encoded guardian address.
+	RemoteObservations map[string][]RemoteObservation
+	SelfReobserved     bool
+}
+
+type RemoteObservation struct {
+	Chain  uint16
+	TxHash []byte
+}
+
+func aggregationStateKey(digest string) []byte {
+	return []byte(aggregationStatePrefix + digest)
+}
+
+func (d *Database) StoreAggregationState(s *AggregationState) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("failed to marshal aggregation state: %w", err)
+	}
+	return d.set(aggregationStateKey(s.Digest), b)
+}
+
+func (d *Database) DeleteAggregationState(digest string) error {
+	return d.delete(aggregationStateKey(digest))
+}
+
+func (d *Database) GetAggregationStates() ([]*AggregationState, error) {
+	states := make([]*AggregationState, 0)
+	err := d.iteratePrefix([]byte(aggregationStatePrefix), func(key []byte, value []byte) error {
+		var s AggregationState
+		if err := json.Unmarshal(value, &s); err != nil {
+			return fmt.Errorf("failed to unmarshal aggregation state for %s: %w", string(key), err)
+		}
+		states = append(states, &s)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return states, nil
+}
@@ -0,0 +1,85 @@
+package db
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/dgraph-io/badger/v3"
+)
+
+const (
+	lastProcessedBlockPrefix      = "checkpoint/block/"
+	contractEventCheckpointPrefix = "checkpoint/events/"
+)
+
+var (
+	ErrCheckpointNotFound = errors.New("checkpoint not found in store")
+)
+
+func lastProcessedBlockKey(chainID vaa.ChainID) []byte {
+	return []byte(fmt.Sprintf("%s%d", lastProcessedBlockPrefix, chainID))
+}
+
+// StoreLastProcessedBlock stores the height of the last block of the given chain for which all messages have been
+// handed to the processor. Watchers resume from the next block after a restart.
+func (d *Database) StoreLastProcessedBlock(chainID vaa.ChainID, height uint64) error {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, height)
+	return d.set(lastProcessedBlockKey(chainID), b)
+}
+
+// GetLastProcessedBlock returns the last processed block of the given chain, or ErrCheckpointNotFound if the
+// watcher has never stored one.
+func (d *Database) GetLastProcessedBlock(chainID vaa.ChainID) (uint64, error) {
+	b, err := d.get(lastProcessedBlockKey(chainID))
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return 0, ErrCheckpointNotFound
+		}
+		return 0, err
+	}
+	if len(b) != 8 {
+		return 0, fmt.Errorf("invalid checkpoint of chain %v: %x", chainID, b)
+	}
+	return binary.BigEndian.Uint64(b), nil
+}
+
+// ContractEventCheckpoint is the progress of a watcher which fetches the events of the bridge contract by index.
+type ContractEventCheckpoint struct {
+	// Index of the next contract event to fetch, all previous events are either confirmed, dropped or pending.
+	NextIndex int32
+	// Serialized events which have been fetched but are not confirmed yet.
+	PendingEvents [][]byte
+}
+
+func contractEventCheckpointKey(chainID vaa.ChainID) []byte {
+	return []byte(fmt.Sprintf("%s%d", contractEventCheckpointPrefix, chainID))
+}
+
+func (d *Database) StoreContractEventCheckpoint(chainID vaa.ChainID, c *ContractEventCheckpoint) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to marshal contract event checkpoint: %w", err)
+	}
+	return d.set(contractEventCheckpointKey(chainID), b)
+}
+
+// GetContractEventCheckpoint returns the contract event checkpoint of the given chain, or ErrCheckpointNotFound if
+// the watcher has never stored one.
+func (d *Database) GetContractEventCheckpoint(chainID vaa.ChainID) (*ContractEventCheckpoint, error) {
+	b, err := d.get(contractEventCheckpointKey(chainID))
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return nil, ErrCheckpointNotFound
+		}
+		return nil, err
+	}
+	var c ContractEventCheckpoint
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal contract event checkpoint of chain %v: %w", chainID, err)
+	}
+	return &c, nil
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -41,6 +42,59 @@ func (d *Database) Close() error {
 	return d.db.Close()
 }
 
+func (d *Database) set(key []byte, value []byte) error {
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(key, value)
+	}); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+	return nil
+}
+
+// get returns a copy of the value of the given key, or badger.ErrKeyNotFound if the key doesn't exist.
+func (d *Database) get(key []byte) (value []byte, err error) {
+	err = d.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+		value, err = item.ValueCopy(nil)
+		return err
+	})
+	return
+}
+
+func (d *Database) delete(key []byte) error {
+	if err := d.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	}); err != nil {
+		return fmt.Errorf("failed to commit tx: %w", err)
+	}
+	return nil
+}
+
+// iteratePrefix calls f with a copy of the value of every key which starts with prefix.
+func (d *Database) iteratePrefix(prefix []byte, f func(key []byte, value []byte) error) error {
+	return d.db.View(func(txn *badger.Txn) error {
+		iteratorOpts := badger.DefaultIteratorOptions
+		iteratorOpts.Prefix = prefix
+		it := txn.NewIterator(iteratorOpts)
+		defer it.Close()
+
+		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+			item := it.Item()
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			if err := f(item.KeyCopy(nil), value); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
 func (d *Database) StoreSignedVAA(v *vaa.VAA) error {
 	if len(v.Signatures) == 0 {
 		panic("StoreSignedVAA called for unsigned VAA")
@@ -156,6 +210,50 @@ func (d *Database) GetSignedVAABytes(id vaa.VAAID) (b []byte, err error) {
 	return
 }
 
+// GetSignedVAAsFromSequence returns the stored VAAs of the emitter and target chain of the given id, whose sequence
+// is greater than or equal to id.Sequence, sorted by sequence.
+func (d *Database) GetSignedVAAsFromSequence(id vaa.VAAID) ([]*vaa.VAA, error) {
+	vaas := make([]*vaa.VAA, 0)
+	prefix := append(id.EmitterPrefixBytes(), '/')
+	err := d.iteratePrefix(prefix, func(key []byte, value []byte) error {
+		sequence, err := strconv.ParseUint(string(key[len(prefix):]), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid vaa key: %s", string(key))
+		}
+		if sequence < id.Sequence {
+			return nil
+		}
+		v, err := vaa.Unmarshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal VAA for %s: %w", string(key), err)
+		}
+		vaas = append(vaas, v)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Keys are ordered lexicographically, rather than numerically
+	sort.Slice(vaas, func(i, j int) bool {
+		return vaas[i].Sequence < vaas[j].Sequence
+	})
+	return vaas, nil
+}
+
+// IterateSignedVAAs calls f with every stored VAA of the given emitter, for all target chains. VAAs are ordered
+// by target chain and lexicographically by sequence.
+func (d *Database) IterateSignedVAAs(emitterChain vaa.ChainID, emitterAddress vaa.Address, f func(v *vaa.VAA) error) error {
+	id := &vaa.VAAID{EmitterChain: emitterChain, EmitterAddress: emitterAddress}
+	prefix := append(id.GovernanceEmitterPrefixBytes(), '/')
+	return d.iteratePrefix(prefix, func(key []byte, value []byte) error {
+		v, err := vaa.Unmarshal(value)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal VAA for %s: %w", string(key), err)
+		}
+		return f(v)
+	})
+}
+
 func (d *Database) FindEmitterSequenceGap(prefix vaa.VAAID) (resp []uint64, firstSeq uint64, lastSeq uint64, err error) {
 	resp = make([]uint64, 0)
 	if err = d.db.View(func(txn *badger.Txn) error {
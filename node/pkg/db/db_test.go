@@ -288,3 +288,174 @@ func TestGetSignedVAABytes(t *testing.T) {
 
 	assert.Equal(t, testVaaBytes, vaaBytes)
 }
+
+func TestLastProcessedBlock(t *testing.T) {
+	dbPath := t.TempDir()
+	db, err := Open(dbPath)
+	if err != nil {
+		t.Error("failed to open database")
+	}
+	defer db.Close()
+	defer os.Remove(dbPath)
+
+	_, err = db.GetLastProcessedBlock(vaa.ChainIDEthereum)
+	assert.Equal(t, ErrCheckpointNotFound, err)
+
+	assert.NoError(t, db.StoreLastProcessedBlock(vaa.ChainIDEthereum, 100))
+	assert.NoError(t, db.StoreLastProcessedBlock(vaa.ChainIDBSC, 200))
+	assert.NoError(t, db.StoreLastProcessedBlock(vaa.ChainIDEthereum, 101))
+
+	height, err := db.GetLastProcessedBlock(vaa.ChainIDEthereum)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(101), height)
+
+	height, err = db.GetLastProcessedBlock(vaa.ChainIDBSC)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(200), height)
+}
+
+func TestContractEventCheckpoint(t *testing.T) {
+	dbPath := t.TempDir()
+	db, err := Open(dbPath)
+	if err != nil {
+		t.Error("failed to open database")
+	}
+	defer db.Close()
+	defer os.Remove(dbPath)
+
+	_, err = db.GetContractEventCheckpoint(vaa.ChainIDAlephium)
+	assert.Equal(t, ErrCheckpointNotFound, err)
+
+	checkpoint := &ContractEventCheckpoint{NextIndex: 10, PendingEvents: [][]byte{{1, 2}, {3}}}
+	assert.NoError(t, db.StoreContractEventCheckpoint(vaa.ChainIDAlephium, checkpoint))
+
+	stored, err := db.GetContractEventCheckpoint(vaa.ChainIDAlephium)
+	assert.NoError(t, err)
+	assert.Equal(t, checkpoint, stored)
+}
+
+func TestGetSignedVAAsFromSequence(t *testing.T) {
+	dbPath := t.TempDir()
+	db, err := Open(dbPath)
+	if err != nil {
+		t.Error("failed to open database")
+	}
+	defer db.Close()
+	defer os.Remove(dbPath)
+
+	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	store := func(targetChain vaa.ChainID, sequence uint64) {
+		v := getVAA()
+		v.TargetChain = targetChain
+		v.Sequence = sequence
+		v.AddSignature(privKey, 0)
+		assert.NoError(t, db.StoreSignedVAA(&v))
+	}
+	for _, sequence := range []uint64{1, 2, 9, 10, 11, 100} {
+		store(vaa.ChainIDEthereum, sequence)
+	}
+	// same emitter, other target chains
+	store(vaa.ChainIDBSC, 5)
+	store(vaa.ChainID(20), 6)
+
+	v := getVAA()
+	id := *VaaIDFromVAA(&v)
+	id.Sequence = 2
+	vaas, err := db.GetSignedVAAsFromSequence(id)
+	assert.NoError(t, err)
+
+	sequences := make([]uint64, 0)
+	for _, v := range vaas {
+		assert.Equal(t, vaa.ChainIDEthereum, v.TargetChain)
+		sequences = append(sequences, v.Sequence)
+	}
+	assert.Equal(t, []uint64{2, 9, 10, 11, 100}, sequences)
+}
+
+func TestIterateSignedVAAs(t *testing.T) {
+	dbPath := t.TempDir()
+	db, err := Open(dbPath)
+	if err != nil {
+		t.Error("failed to open database")
+	}
+	defer db.Close()
+	defer os.Remove(dbPath)
+
+	privKey, _ := ecdsa.GenerateKey(crypto.S256(), rand.Reader)
+	store := func(emitterChain vaa.ChainID, targetChain vaa.ChainID, sequence uint64) {
+		v := getVAA()
+		v.EmitterChain = emitterChain
+		v.TargetChain = targetChain
+		v.Sequence = sequence
+		v.AddSignature(privKey, 0)
+		assert.NoError(t, db.StoreSignedVAA(&v))
+	}
+	store(vaa.ChainIDSolana, vaa.ChainIDEthereum, 1)
+	store(vaa.ChainIDSolana, vaa.ChainIDEthereum, 2)
+	store(vaa.ChainIDSolana, vaa.ChainIDBSC, 3)
+	// other emitter chain
+	store(vaa.ChainIDEthereum, vaa.ChainIDBSC, 4)
+
+	v := getVAA()
+	ids := make([]string, 0)
+	err = db.IterateSignedVAAs(vaa.ChainIDSolana, v.EmitterAddress, func(v *vaa.VAA) error {
+		ids = append(ids, v.MessageID())
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{
+		"1/0000000000000000000000000000000000000000000000000000000000000004/2/1",
+		"1/0000000000000000000000000000000000000000000000000000000000000004/2/2",
+		"1/0000000000000000000000000000000000000000000000000000000000000004/4/3",
+	}, ids)
+}
+
+func TestEvidence(t *testing.T) {
+	dbPath := t.TempDir()
+	db, err := Open(dbPath)
+	if err != nil {
+		t.Error("failed to open database")
+	}
+	defer db.Close()
+	defer os.Remove(dbPath)
+
+	evidence, err := db.GetEvidence()
+	assert.NoError(t, err)
+	assert.Empty(t, evidence)
+
+	e0 := &Evidence{MessageID: "2/0001/255/1", Guardian: "0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe", DetectedAt: time.Unix(1, 0).UTC(), Observations: [][]byte{{5}, {6}}, VAAs: [][]byte{{1}, {2}}}
+	e1 := &Evidence{MessageID: "2/0001/255/2", Guardian: "0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe", DetectedAt: time.Unix(2, 0).UTC(), Observations: [][]byte{{7}, {8}}, VAAs: [][]byte{{3}, {4}}}
+	assert.NoError(t, db.StoreEvidence(e1))
+	assert.NoError(t, db.StoreEvidence(e0))
+
+	evidence, err = db.GetEvidence()
+	assert.NoError(t, err)
+	assert.Equal(t, []*Evidence{e0, e1}, evidence)
+}
+
+func TestSigningPauses(t *testing.T) {
+	dbPath := t.TempDir()
+	db, err := Open(dbPath)
+	if err != nil {
+		t.Error("failed to open database")
+	}
+	defer db.Close()
+	defer os.Remove(dbPath)
+
+	p0 := &SigningPause{Scope: SigningPauseEmitter, ChainID: 2, EmitterAddress: "0001", Reason: "exploit", PausedAt: time.Unix(1, 0).UTC()}
+	p1 := &SigningPause{Scope: SigningPauseEmitterChain, ChainID: 2, PausedAt: time.Unix(2, 0).UTC()}
+	assert.NoError(t, db.StoreSigningPause(p0))
+	assert.NoError(t, db.StoreSigningPause(p1))
+	// pausing again overrides the existing pause
+	p1.Reason = "reorg"
+	assert.NoError(t, db.StoreSigningPause(p1))
+
+	pauses, err := db.GetSigningPauses()
+	assert.NoError(t, err)
+	assert.Equal(t, []*SigningPause{p0, p1}, pauses)
+
+	assert.NoError(t, db.DeleteSigningPause(p0.ID()))
+	pauses, err = db.GetSigningPauses()
+	assert.NoError(t, err)
+	assert.Equal(t, []*SigningPause{p1}, pauses)
+}
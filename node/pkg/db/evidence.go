@@ -0,0 +1,51 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const evidencePrefix = "evidence/"
+
+// Evidence records two conflicting observations signed by the same guardian for the same message ID.
+type Evidence struct {
+	// Message ID (emitterChainId/emitterAddress/targetChainId/sequence) of both bodies.
+	MessageID string
+	// Hex-encoded address of the guardian which signed both bodies.
+	Guardian   string
+	DetectedAt time.Time
+	// Conflicting observations, as serialized gossip SignedObservations.
+	Observations [][]byte
+	// Conflicting bodies known to the node, as serialized VAAs which only hold the signature of the guardian.
+	VAAs [][]byte
+}
+
+func evidenceKey(e *Evidence) []byte {
+	return []byte(fmt.Sprintf("%s%s/%s/%d", evidencePrefix, e.MessageID, e.Guardian, e.DetectedAt.UnixNano()))
+}
+
+func (d *Database) StoreEvidence(e *Evidence) error {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to marshal evidence: %w", err)
+	}
+	return d.set(evidenceKey(e), b)
+}
+
+// GetEvidence returns all the stored evidence, ordered by message ID.
+func (d *Database) GetEvidence() ([]*Evidence, error) {
+	evidence := make([]*Evidence, 0)
+	err := d.iteratePrefix([]byte(evidencePrefix), func(key []byte, value []byte) error {
+		var e Evidence
+		if err := json.Unmarshal(value, &e); err != nil {
+			return fmt.Errorf("failed to unmarshal evidence for %s: %w", string(key), err)
+		}
+		evidence = append(evidence, &e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return evidence, nil
+}
@@ -0,0 +1,183 @@
+package db
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+const (
+	governorTransferPrefix = "gov/transfer/"
+	governorPendingPrefix  = "gov/pending/"
+)
+
+// GovernorTransfer is a token bridge transfer which has been approved by the governor and
+// counts against the daily limit of its emitter chain.
+type GovernorTransfer struct {
+	Timestamp      time.Time
+	Value          uint64
+	OriginChain    vaa.ChainID
+	OriginAddress  vaa.Address
+	EmitterChain   vaa.ChainID
+	EmitterAddress vaa.Address
+	MsgID          string
+}
+
+func (t *GovernorTransfer) Marshal() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	vaa.MustWrite(buf, binary.BigEndian, uint32(t.Timestamp.Unix()))
+	vaa.MustWrite(buf, binary.BigEndian, t.Value)
+	vaa.MustWrite(buf, binary.BigEndian, t.OriginChain)
+	buf.Write(t.OriginAddress[:])
+	vaa.MustWrite(buf, binary.BigEndian, t.EmitterChain)
+	buf.Write(t.EmitterAddress[:])
+	buf.Write([]byte(t.MsgID))
+
+	return buf.Bytes(), nil
+}
+
+func UnmarshalGovernorTransfer(data []byte) (*GovernorTransfer, error) {
+	t := &GovernorTransfer{}
+	reader := bytes.NewReader(data)
+
+	unixSeconds := uint32(0)
+	if err := binary.Read(reader, binary.BigEndian, &unixSeconds); err != nil {
+		return nil, fmt.Errorf("failed to read timestamp: %w", err)
+	}
+	t.Timestamp = time.Unix(int64(unixSeconds), 0)
+
+	if err := binary.Read(reader, binary.BigEndian, &t.Value); err != nil {
+		return nil, fmt.Errorf("failed to read value: %w", err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &t.OriginChain); err != nil {
+		return nil, fmt.Errorf("failed to read origin chain: %w", err)
+	}
+
+	if n, err := reader.Read(t.OriginAddress[:]); err != nil || n != len(t.OriginAddress) {
+		return nil, fmt.Errorf("failed to read origin address [%d]: %w", n, err)
+	}
+
+	if err := binary.Read(reader, binary.BigEndian, &t.EmitterChain); err != nil {
+		return nil, fmt.Errorf("failed to read emitter chain: %w", err)
+	}
+
+	if n, err := reader.Read(t.EmitterAddress[:]); err != nil || n != len(t.EmitterAddress) {
+		return nil, fmt.Errorf("failed to read emitter address [%d]: %w", n, err)
+	}
+
+	msgID, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read message id: %w", err)
+	}
+	t.MsgID = string(msgID)
+
+	return t, nil
+}
+
+// GovernorPendingTransfer is a message which has been held by the governor and will not be signed before its release time.
+type GovernorPendingTransfer struct {
+	ReleaseTime time.Time
+	Msg         common.MessagePublication
+}
+
+func (p *GovernorPendingTransfer) Marshal() ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	vaa.MustWrite(buf, binary.BigEndian, uint32(p.ReleaseTime.Unix()))
+
+	b, err := p.Msg.Marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal pending message: %w", err)
+	}
+	buf.Write(b)
+
+	return buf.Bytes(), nil
+}
+
+func UnmarshalGovernorPendingTransfer(data []byte) (*GovernorPendingTransfer, error) {
+	if len(data) < 4 {
+		return nil, fmt.Errorf("pending transfer too short")
+	}
+
+	releaseTime := binary.BigEndian.Uint32(data[:4])
+	msg, err := common.UnmarshalMessagePublication(data[4:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &GovernorPendingTransfer{
+		ReleaseTime: time.Unix(int64(releaseTime), 0),
+		Msg:         *msg,
+	}, nil
+}
+
+func governorTransferKey(msgID string) []byte {
+	return []byte(governorTransferPrefix + msgID)
+}
+
+func governorPendingKey(msgID string) []byte {
+	return []byte(governorPendingPrefix + msgID)
+}
+
+func (d *Database) StoreGovernorTransfer(t *GovernorTransfer) error {
+	b, err := t.Marshal()
+	if err != nil {
+		return err
+	}
+	return d.set(governorTransferKey(t.MsgID), b)
+}
+
+func (d *Database) DeleteGovernorTransfer(msgID string) error {
+	return d.delete(governorTransferKey(msgID))
+}
+
+func (d *Database) StoreGovernorPendingTransfer(p *GovernorPendingTransfer) error {
+	b, err := p.Marshal()
+	if err != nil {
+		return err
+	}
+	return d.set(governorPendingKey(p.Msg.MessageIDString()), b)
+}
+
+func (d *Database) DeleteGovernorPendingTransfer(msgID string) error {
+	return d.delete(governorPendingKey(msgID))
+}
+
+// GetGovernorData loads all approved transfers and pending transfers of the governor.
+func (d *Database) GetGovernorData() (transfers []*GovernorTransfer, pending []*GovernorPendingTransfer, err error) {
+	transfers = make([]*GovernorTransfer, 0)
+	pending = make([]*GovernorPendingTransfer, 0)
+
+	err = d.iteratePrefix([]byte(governorTransferPrefix), func(key []byte, value []byte) error {
+		t, err := UnmarshalGovernorTransfer(value)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal governor transfer for %s: %w", string(key), err)
+		}
+		transfers = append(transfers, t)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = d.iteratePrefix([]byte(governorPendingPrefix), func(key []byte, value []byte) error {
+		p, err := UnmarshalGovernorPendingTransfer(value)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal governor pending transfer for %s: %w", string(key), err)
+		}
+		pending = append(pending, p)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return transfers, pending, nil
+}
@@ -0,0 +1,68 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const signingPausePrefix = "pause/"
+
+// Scopes of a SigningPause.
+const (
+	SigningPauseEmitterChain = "emitter_chain"
+	SigningPauseTargetChain  = "target_chain"
+	SigningPauseEmitter      = "emitter"
+)
+
+// SigningPause stops the node from signing the messages of an emitter chain, a target chain or an emitter.
+type SigningPause struct {
+	Scope string
+	// Emitter chain for the emitter_chain and emitter scopes, target chain for the target_chain scope.
+	ChainID uint16
+	// Hex-encoded emitter address, only set for the emitter scope.
+	EmitterAddress string
+	Reason         string
+	PausedAt       time.Time
+}
+
+// ID identifies the messages covered by the pause, there is at most one pause per ID.
+func (p *SigningPause) ID() string {
+	if p.Scope == SigningPauseEmitter {
+		return fmt.Sprintf("%s/%d/%s", p.Scope, p.ChainID, p.EmitterAddress)
+	}
+	return fmt.Sprintf("%s/%d", p.Scope, p.ChainID)
+}
+
+func signingPauseKey(id string) []byte {
+	return []byte(signingPausePrefix + id)
+}
+
+func (d *Database) StoreSigningPause(p *SigningPause) error {
+	b, err := json.Marshal(p)
+	if err != nil {
+		return fmt.Errorf("failed to marshal signing pause: %w", err)
+	}
+	return d.set(signingPauseKey(p.ID()), b)
+}
+
+func (d *Database) DeleteSigningPause(id string) error {
+	return d.delete(signingPauseKey(id))
+}
+
+// GetSigningPauses returns all the stored signing pauses, ordered by ID.
+func (d *Database) GetSigningPauses() ([]*SigningPause, error) {
+	pauses := make([]*SigningPause, 0)
+	err := d.iteratePrefix([]byte(signingPausePrefix), func(key []byte, value []byte) error {
+		var p SigningPause
+		if err := json.Unmarshal(value, &p); err != nil {
+			return fmt.Errorf("failed to unmarshal signing pause for %s: %w", string(key), err)
+		}
+		pauses = append(pauses, &p)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return pauses, nil
+}
@@ -0,0 +1,21 @@
+package devnet
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+)
+
+// InsecureDeterministicGuardianSet returns a guardian set of n guardians with deterministic keys, and their keys.
+// Guardian sets with different indexes have different guardians.
+func InsecureDeterministicGuardianSet(index uint32, n int) (*common.GuardianSet, []*ecdsa.PrivateKey) {
+	gs := &common.GuardianSet{Index: index}
+	keys := make([]*ecdsa.PrivateKey, n)
+	for i := range keys {
+		keys[i] = InsecureDeterministicEcdsaKeyByIndex(crypto.S256(), uint64(index)*common.MaxGuardianCount+uint64(i))
+		gs.Keys = append(gs.Keys, crypto.PubkeyToAddress(keys[i].PublicKey))
+	}
+	return gs, keys
+}
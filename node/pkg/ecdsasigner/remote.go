@@ -0,0 +1,120 @@
+package ecdsasigner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	signerv1 "github.com/alephium/wormhole-fork/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const remoteSignTimeout = 5 * time.Second
+
+// RemoteSigner signs digests with a guardian key held by a remote signer, see RemoteSignerServer.
+type RemoteSigner struct {
+	conn      *grpc.ClientConn
+	client    signerv1.RemoteSignerServiceClient
+	publicKey *ecdsa.PublicKey
+}
+
+// NewRemoteSigner connects to the remote signer at addr, which is either a Unix socket (unix:///path/to/socket)
+// or a TCP address. TCP connections must use mutual TLS, see NewMutualTLSConfig.
+func NewRemoteSigner(ctx context.Context, addr string, tlsConfig *tls.Config) (*RemoteSigner, error) {
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	} else if !strings.HasPrefix(addr, "unix:") {
+		return nil, fmt.Errorf("connections to the remote signer over TCP require TLS")
+	}
+
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the remote signer: %w", err)
+	}
+	client := signerv1.NewRemoteSignerServiceClient(conn)
+
+	timeout, cancel := context.WithTimeout(ctx, remoteSignTimeout)
+	defer cancel()
+	resp, err := client.GetPublicKey(timeout, &signerv1.GetPublicKeyRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to get public key from the remote signer: %w", err)
+	}
+	publicKey, err := ethcrypto.UnmarshalPubkey(resp.PublicKey)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("invalid public key from the remote signer: %w", err)
+	}
+
+	return &RemoteSigner{
+		conn:      conn,
+		client:    client,
+		publicKey: publicKey,
+	}, nil
+}
+
+// ECDSASigner methods
+func (s *RemoteSigner) Sign(digest []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignTimeout)
+	defer cancel()
+	resp, err := s.client.Sign(ctx, &signerv1.SignRequest{Digest: digest})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with the remote signer: %w", err)
+	}
+
+	pubKey, err := ethcrypto.SigToPub(digest, resp.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signature from the remote signer: %w", err)
+	}
+	if ethcrypto.PubkeyToAddress(*pubKey) != ethcrypto.PubkeyToAddress(*s.publicKey) {
+		return nil, fmt.Errorf("signature from the remote signer doesn't match its public key")
+	}
+	return resp.Signature, nil
+}
+
+func (s *RemoteSigner) PublicKey() ecdsa.PublicKey {
+	return *s.publicKey
+}
+
+// Close closes the connection to the remote signer.
+func (s *RemoteSigner) Close() error {
+	return s.conn.Close()
+}
+
+// NewMutualTLSConfig returns the TLS config of a remote signer server or client, which only accepts peers with a
+// certificate signed by the CA at caPath.
+func NewMutualTLSConfig(certPath, keyPath, caPath string, server bool) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate: %w", err)
+	}
+	caPem, err := ioutil.ReadFile(caPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPem) {
+		return nil, fmt.Errorf("no CA certificate found in %s", caPath)
+	}
+
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS13,
+	}
+	if server {
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	} else {
+		config.RootCAs = pool
+	}
+	return config, nil
+}
@@ -0,0 +1,147 @@
+package ecdsasigner
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	signerv1 "github.com/alephium/wormhole-fork/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+func startRemoteSigner(t *testing.T, network, addr string, maxSignaturesPerSecond int, opts ...grpc.ServerOption) (*ecdsa.PrivateKey, string) {
+	gk, err := ethcrypto.GenerateKey()
+	require.NoError(t, err)
+
+	l, err := net.Listen(network, addr)
+	require.NoError(t, err)
+	server := grpc.NewServer(opts...)
+	signerv1.RegisterRemoteSignerServiceServer(server, NewRemoteSignerServer(zap.NewNop(), &ECDSAPrivateKey{Value: gk}, maxSignaturesPerSecond))
+	go func() { _ = server.Serve(l) }()
+	t.Cleanup(server.Stop)
+	return gk, l.Addr().String()
+}
+
+func TestRemoteSignerUnixSocket(t *testing.T) {
+	gk, socketPath := startRemoteSigner(t, "unix", filepath.Join(t.TempDir(), "signer.sock"), 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	signer, err := NewRemoteSigner(ctx, "unix://"+socketPath, nil)
+	require.NoError(t, err)
+	defer signer.Close()
+
+	address := ethcrypto.PubkeyToAddress(gk.PublicKey)
+	assert.Equal(t, address, ethcrypto.PubkeyToAddress(signer.PublicKey()))
+
+	for i := 0; i < 10; i++ {
+		random, err := randomBytes(1000)
+		require.NoError(t, err)
+		digest := ethcrypto.Keccak256Hash(random)
+		signature, err := signer.Sign(digest.Bytes())
+		require.NoError(t, err)
+		verifySignature(t, digest.Bytes(), signature, address)
+	}
+
+	// Only 32-byte digests are signed
+	_, err = signer.Sign([]byte("not a digest"))
+	assert.Error(t, err)
+}
+
+func TestRemoteSignerRequiresTLSOverTCP(t *testing.T) {
+	_, err := NewRemoteSigner(context.Background(), "127.0.0.1:1234", nil)
+	assert.Error(t, err)
+}
+
+func TestRemoteSignerRateLimit(t *testing.T) {
+	server := NewRemoteSignerServer(zap.NewNop(), nil, 2)
+	now := time.Now()
+	assert.True(t, server.allow(now))
+	assert.True(t, server.allow(now.Add(100*time.Millisecond)))
+	assert.False(t, server.allow(now.Add(200*time.Millisecond)))
+	assert.True(t, server.allow(now.Add(time.Second)))
+}
+
+func TestRemoteSignerMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	caKey, caCert := generateCertificate(t, "ca", nil, nil)
+	writeCertificate(t, dir, "ca", caKey, caCert)
+	serverKey, serverCert := generateCertificate(t, "server", caKey, caCert)
+	writeCertificate(t, dir, "server", serverKey, serverCert)
+	clientKey, clientCert := generateCertificate(t, "client", caKey, caCert)
+	writeCertificate(t, dir, "client", clientKey, clientCert)
+	otherCAKey, otherCACert := generateCertificate(t, "other-ca", nil, nil)
+	otherKey, otherCert := generateCertificate(t, "other", otherCAKey, otherCACert)
+	writeCertificate(t, dir, "other", otherKey, otherCert)
+
+	serverTLS, err := NewMutualTLSConfig(filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"), filepath.Join(dir, "ca.crt"), true)
+	require.NoError(t, err)
+	gk, addr := startRemoteSigner(t, "tcp", "127.0.0.1:0", 0, grpc.Creds(credentials.NewTLS(serverTLS)))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	clientTLS, err := NewMutualTLSConfig(filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key"), filepath.Join(dir, "ca.crt"), false)
+	require.NoError(t, err)
+	clientTLS.ServerName = "server"
+	signer, err := NewRemoteSigner(ctx, addr, clientTLS)
+	require.NoError(t, err)
+	defer signer.Close()
+	digest := ethcrypto.Keccak256Hash([]byte("message"))
+	signature, err := signer.Sign(digest.Bytes())
+	require.NoError(t, err)
+	verifySignature(t, digest.Bytes(), signature, ethcrypto.PubkeyToAddress(gk.PublicKey))
+
+	// Clients with a certificate of another CA are rejected
+	otherTLS, err := NewMutualTLSConfig(filepath.Join(dir, "other.crt"), filepath.Join(dir, "other.key"), filepath.Join(dir, "ca.crt"), false)
+	require.NoError(t, err)
+	otherTLS.ServerName = "server"
+	_, err = NewRemoteSigner(ctx, addr, otherTLS)
+	assert.Error(t, err)
+}
+
+func generateCertificate(t *testing.T, name string, parentKey *ecdsa.PrivateKey, parent *x509.Certificate) (*ecdsa.PrivateKey, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return key, cert
+}
+
+func writeCertificate(t *testing.T, dir, name string, key *ecdsa.PrivateKey, cert *x509.Certificate) {
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}), 0600))
+}
@@ -0,0 +1,88 @@
+package ecdsasigner
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	signerv1 "github.com/alephium/wormhole-fork/node/pkg/proto/signer/v1"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RemoteSignerServer serves the signatures of a guardian key to RemoteSigner clients. It only signs 32-byte
+// digests, at most maxSignaturesPerSecond per second, and logs every signature.
+type RemoteSignerServer struct {
+	signerv1.UnimplementedRemoteSignerServiceServer
+	logger *zap.Logger
+	signer ECDSASigner
+
+	mu                     sync.Mutex
+	maxSignaturesPerSecond int
+	windowStart            time.Time
+	windowCount            int
+}
+
+// NewRemoteSignerServer returns a server signing with signer. Signatures are not rate limited if
+// maxSignaturesPerSecond is 0.
+func NewRemoteSignerServer(logger *zap.Logger, signer ECDSASigner, maxSignaturesPerSecond int) *RemoteSignerServer {
+	return &RemoteSignerServer{
+		logger:                 logger,
+		signer:                 signer,
+		maxSignaturesPerSecond: maxSignaturesPerSecond,
+	}
+}
+
+func (s *RemoteSignerServer) GetPublicKey(ctx context.Context, req *signerv1.GetPublicKeyRequest) (*signerv1.GetPublicKeyResponse, error) {
+	publicKey := s.signer.PublicKey()
+	return &signerv1.GetPublicKeyResponse{PublicKey: ethcrypto.FromECDSAPub(&publicKey)}, nil
+}
+
+func (s *RemoteSignerServer) Sign(ctx context.Context, req *signerv1.SignRequest) (*signerv1.SignResponse, error) {
+	client := "unknown"
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		client = p.Addr.String()
+	}
+	digest := hex.EncodeToString(req.Digest)
+
+	if len(req.Digest) != 32 {
+		s.logger.Warn("rejected signing request with invalid digest length",
+			zap.String("client", client), zap.String("digest", digest))
+		return nil, status.Errorf(codes.InvalidArgument, "digest must be 32 bytes, got %d", len(req.Digest))
+	}
+	if !s.allow(time.Now()) {
+		s.logger.Warn("rejected signing request, rate limit exceeded",
+			zap.String("client", client), zap.String("digest", digest))
+		return nil, status.Error(codes.ResourceExhausted, "rate limit exceeded")
+	}
+
+	sig, err := s.signer.Sign(req.Digest)
+	if err != nil {
+		s.logger.Error("failed to sign digest", zap.String("client", client), zap.String("digest", digest), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to sign digest")
+	}
+	s.logger.Info("signed digest", zap.String("client", client), zap.String("digest", digest))
+	return &signerv1.SignResponse{Signature: sig}, nil
+}
+
+// allow returns true if a signature can be made at the given time without exceeding the rate limit.
+func (s *RemoteSignerServer) allow(now time.Time) bool {
+	if s.maxSignaturesPerSecond == 0 {
+		return true
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if now.Sub(s.windowStart) >= time.Second {
+		s.windowStart = now
+		s.windowCount = 0
+	}
+	if s.windowCount >= s.maxSignaturesPerSecond {
+		return false
+	}
+	s.windowCount++
+	return true
+}
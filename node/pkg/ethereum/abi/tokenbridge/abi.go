@@ -0,0 +1,583 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package tokenbridge
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// TokenbridgeMetaData contains all meta data concerning the Tokenbridge contract.
+var TokenbridgeMetaData = &bind.MetaData{
+	ABI: "[{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"hash\",\"type\":\"bytes32\"}],\"name\":\"governanceActionIsConsumed\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"impl\",\"type\":\"address\"}],\"name\":\"isInitialized\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"hash\",\"type\":\"bytes32\"}],\"name\":\"isTransferCompleted\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"wormhole\",\"outputs\":[{\"internalType\":\"contractIWormhole\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"chainId\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"governanceChainId\",\"outputs\":[{\"internalType\":\"uint16\",\"name\":\"\",\"type\":\"uint16\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"governanceContract\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint16\",\"name\":\"tokenChainId\",\"type\":\"uint16\"},{\"internalType\":\"bytes32\",\"name\":\"tokenAddress\",\"type\":\"bytes32\"}],\"name\":\"wrappedAsset\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"uint16\",\"name\":\"chainId_\",\"type\":\"uint16\"}],\"name\":\"bridgeContracts\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"tokenImplementation\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"WETH\",\"outputs\":[{\"internalType\":\"contractIWETH\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"}],\"name\":\"outstandingBridged\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"token\",\"type\":\"address\"}],\"name\":\"isWrappedAsset\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// TokenbridgeABI is the input ABI used to generate the binding from.
+// Deprecated: Use TokenbridgeMetaData.ABI instead.
+var TokenbridgeABI = TokenbridgeMetaData.ABI
+
+// Tokenbridge is an auto generated Go binding around an Ethereum contract.
+type Tokenbridge struct {
+	TokenbridgeCaller     // Read-only binding to the contract
+	TokenbridgeTransactor // Write-only binding to the contract
+	TokenbridgeFilterer   // Log filterer for contract events
+}
+
+// TokenbridgeCaller is an auto generated read-only Go binding around an Ethereum contract.
+type TokenbridgeCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TokenbridgeTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type TokenbridgeTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TokenbridgeFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type TokenbridgeFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// TokenbridgeSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type TokenbridgeSession struct {
+	Contract     *Tokenbridge      // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// TokenbridgeCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type TokenbridgeCallerSession struct {
+	Contract *TokenbridgeCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts      // Call options to use throughout this session
+}
+
+// TokenbridgeTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type TokenbridgeTransactorSession struct {
+	Contract     *TokenbridgeTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// TokenbridgeRaw is an auto generated low-level Go binding around an Ethereum contract.
+type TokenbridgeRaw struct {
+	Contract *Tokenbridge // Generic contract binding to access the raw methods on
+}
+
+// TokenbridgeCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type TokenbridgeCallerRaw struct {
+	Contract *TokenbridgeCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// TokenbridgeTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type TokenbridgeTransactorRaw struct {
+	Contract *TokenbridgeTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewTokenbridge creates a new instance of Tokenbridge, bound to a specific deployed contract.
+func NewTokenbridge(address common.Address, backend bind.ContractBackend) (*Tokenbridge, error) {
+	contract, err := bindTokenbridge(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Tokenbridge{TokenbridgeCaller: TokenbridgeCaller{contract: contract}, TokenbridgeTransactor: TokenbridgeTransactor{contract: contract}, TokenbridgeFilterer: TokenbridgeFilterer{contract: contract}}, nil
+}
+
+// NewTokenbridgeCaller creates a new read-only instance of Tokenbridge, bound to a specific deployed contract.
+func NewTokenbridgeCaller(address common.Address, caller bind.ContractCaller) (*TokenbridgeCaller, error) {
+	contract, err := bindTokenbridge(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenbridgeCaller{contract: contract}, nil
+}
+
+// NewTokenbridgeTransactor creates a new write-only instance of Tokenbridge, bound to a specific deployed contract.
+func NewTokenbridgeTransactor(address common.Address, transactor bind.ContractTransactor) (*TokenbridgeTransactor, error) {
+	contract, err := bindTokenbridge(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenbridgeTransactor{contract: contract}, nil
+}
+
+// NewTokenbridgeFilterer creates a new log filterer instance of Tokenbridge, bound to a specific deployed contract.
+func NewTokenbridgeFilterer(address common.Address, filterer bind.ContractFilterer) (*TokenbridgeFilterer, error) {
+	contract, err := bindTokenbridge(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenbridgeFilterer{contract: contract}, nil
+}
+
+// bindTokenbridge binds a generic wrapper to an already deployed contract.
+func bindTokenbridge(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(TokenbridgeABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Tokenbridge *TokenbridgeRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Tokenbridge.Contract.TokenbridgeCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Tokenbridge *TokenbridgeRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Tokenbridge.Contract.TokenbridgeTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Tokenbridge *TokenbridgeRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Tokenbridge.Contract.TokenbridgeTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Tokenbridge *TokenbridgeCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Tokenbridge.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Tokenbridge *TokenbridgeTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Tokenbridge.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Tokenbridge *TokenbridgeTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Tokenbridge.Contract.contract.Transact(opts, method, params...)
+}
+
+// WETH is a free data retrieval call binding the contract method 0xad5c4648.
+//
+// Solidity: function WETH() view returns(address)
+func (_Tokenbridge *TokenbridgeCaller) WETH(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Tokenbridge.contract.Call(opts, &out, "WETH")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// WETH is a free data retrieval call binding the contract method 0xad5c4648.
+//
+// Solidity: function WETH() view returns(address)
+func (_Tokenbridge *TokenbridgeSession) WETH() (common.Address, error) {
+	return _Tokenbridge.Contract.WETH(&_Tokenbridge.CallOpts)
+}
+
+// WETH is a free data retrieval call binding the contract method 0xad5c4648.
+//
+// Solidity: function WETH() view returns(address)
+func (_Tokenbridge *TokenbridgeCallerSession) WETH() (common.Address, error) {
+	return _Tokenbridge.Contract.WETH(&_Tokenbridge.CallOpts)
+}
+
+// BridgeContracts is a free data retrieval call binding the contract method 0xad66a5f1.
+//
+// Solidity: function bridgeContracts(uint16 chainId_) view returns(bytes32)
+func (_Tokenbridge *TokenbridgeCaller) BridgeContracts(opts *bind.CallOpts, chainId_ uint16) ([32]byte, error) {
+	var out []interface{}
+	err := _Tokenbridge.contract.Call(opts, &out, "bridgeContracts", chainId_)
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// BridgeContracts is a free data retrieval call binding the contract method 0xad66a5f1.
+//
+// Solidity: function bridgeContracts(uint16 chainId_) view returns(bytes32)
+func (_Tokenbridge *TokenbridgeSession) BridgeContracts(chainId_ uint16) ([32]byte, error) {
+	return _Tokenbridge.Contract.BridgeContracts(&_Tokenbridge.CallOpts, chainId_)
+}
+
+// BridgeContracts is a free data retrieval call binding the contract method 0xad66a5f1.
+//
+// Solidity: function bridgeContracts(uint16 chainId_) view returns(bytes32)
+func (_Tokenbridge *TokenbridgeCallerSession) BridgeContracts(chainId_ uint16) ([32]byte, error) {
+	return _Tokenbridge.Contract.BridgeContracts(&_Tokenbridge.CallOpts, chainId_)
+}
+
+// ChainId is a free data retrieval call binding the contract method 0x9a8a0592.
+//
+// Solidity: function chainId() view returns(uint16)
+func (_Tokenbridge *TokenbridgeCaller) ChainId(opts *bind.CallOpts) (uint16, error) {
+	var out []interface{}
+	err := _Tokenbridge.contract.Call(opts, &out, "chainId")
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+// ChainId is a free data retrieval call binding the contract method 0x9a8a0592.
+//
+// Solidity: function chainId() view returns(uint16)
+func (_Tokenbridge *TokenbridgeSession) ChainId() (uint16, error) {
+	return _Tokenbridge.Contract.ChainId(&_Tokenbridge.CallOpts)
+}
+
+// ChainId is a free data retrieval call binding the contract method 0x9a8a0592.
+//
+// Solidity: function chainId() view returns(uint16)
+func (_Tokenbridge *TokenbridgeCallerSession) ChainId() (uint16, error) {
+	return _Tokenbridge.Contract.ChainId(&_Tokenbridge.CallOpts)
+}
+
+// GovernanceActionIsConsumed is a free data retrieval call binding the contract method 0x2c3c02a4.
+//
+// Solidity: function governanceActionIsConsumed(bytes32 hash) view returns(bool)
+func (_Tokenbridge *TokenbridgeCaller) GovernanceActionIsConsumed(opts *bind.CallOpts, hash [32]byte) (bool, error) {
+	var out []interface{}
+	err := _Tokenbridge.contract.Call(opts, &out, "governanceActionIsConsumed", hash)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// GovernanceActionIsConsumed is a free data retrieval call binding the contract method 0x2c3c02a4.
+//
+// Solidity: function governanceActionIsConsumed(bytes32 hash) view returns(bool)
+func (_Tokenbridge *TokenbridgeSession) GovernanceActionIsConsumed(hash [32]byte) (bool, error) {
+	return _Tokenbridge.Contract.GovernanceActionIsConsumed(&_Tokenbridge.CallOpts, hash)
+}
+
+// GovernanceActionIsConsumed is a free data retrieval call binding the contract method 0x2c3c02a4.
+//
+// Solidity: function governanceActionIsConsumed(bytes32 hash) view returns(bool)
+func (_Tokenbridge *TokenbridgeCallerSession) GovernanceActionIsConsumed(hash [32]byte) (bool, error) {
+	return _Tokenbridge.Contract.GovernanceActionIsConsumed(&_Tokenbridge.CallOpts, hash)
+}
+
+// GovernanceChainId is a free data retrieval call binding the contract method 0xfbe3c2cd.
+//
+// Solidity: function governanceChainId() view returns(uint16)
+func (_Tokenbridge *TokenbridgeCaller) GovernanceChainId(opts *bind.CallOpts) (uint16, error) {
+	var out []interface{}
+	err := _Tokenbridge.contract.Call(opts, &out, "governanceChainId")
+
+	if err != nil {
+		return *new(uint16), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint16)).(*uint16)
+
+	return out0, err
+
+}
+
+// GovernanceChainId is a free data retrieval call binding the contract method 0xfbe3c2cd.
+//
+// Solidity: function governanceChainId() view returns(uint16)
+func (_Tokenbridge *TokenbridgeSession) GovernanceChainId() (uint16, error) {
+	return _Tokenbridge.Contract.GovernanceChainId(&_Tokenbridge.CallOpts)
+}
+
+// GovernanceChainId is a free data retrieval call binding the contract method 0xfbe3c2cd.
+//
+// Solidity: function governanceChainId() view returns(uint16)
+func (_Tokenbridge *TokenbridgeCallerSession) GovernanceChainId() (uint16, error) {
+	return _Tokenbridge.Contract.GovernanceChainId(&_Tokenbridge.CallOpts)
+}
+
+// GovernanceContract is a free data retrieval call binding the contract method 0xb172b222.
+//
+// Solidity: function governanceContract() view returns(bytes32)
+func (_Tokenbridge *TokenbridgeCaller) GovernanceContract(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Tokenbridge.contract.Call(opts, &out, "governanceContract")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// GovernanceContract is a free data retrieval call binding the contract method 0xb172b222.
+//
+// Solidity: function governanceContract() view returns(bytes32)
+func (_Tokenbridge *TokenbridgeSession) GovernanceContract() ([32]byte, error) {
+	return _Tokenbridge.Contract.GovernanceContract(&_Tokenbridge.CallOpts)
+}
+
+// GovernanceContract is a free data retrieval call binding the contract method 0xb172b222.
+//
+// Solidity: function governanceContract() view returns(bytes32)
+func (_Tokenbridge *TokenbridgeCallerSession) GovernanceContract() ([32]byte, error) {
+	return _Tokenbridge.Contract.GovernanceContract(&_Tokenbridge.CallOpts)
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0xd60b347f.
+//
+// Solidity: function isInitialized(address impl) view returns(bool)
+func (_Tokenbridge *TokenbridgeCaller) IsInitialized(opts *bind.CallOpts, impl common.Address) (bool, error) {
+	var out []interface{}
+	err := _Tokenbridge.contract.Call(opts, &out, "isInitialized", impl)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0xd60b347f.
+//
+// Solidity: function isInitialized(address impl) view returns(bool)
+func (_Tokenbridge *TokenbridgeSession) IsInitialized(impl common.Address) (bool, error) {
+	return _Tokenbridge.Contract.IsInitialized(&_Tokenbridge.CallOpts, impl)
+}
+
+// IsInitialized is a free data retrieval call binding the contract method 0xd60b347f.
+//
+// Solidity: function isInitialized(address impl) view returns(bool)
+func (_Tokenbridge *TokenbridgeCallerSession) IsInitialized(impl common.Address) (bool, error) {
+	return _Tokenbridge.Contract.IsInitialized(&_Tokenbridge.CallOpts, impl)
+}
+
+// IsTransferCompleted is a free data retrieval call binding the contract method 0xaa4efa5b.
+//
+// Solidity: function isTransferCompleted(bytes32 hash) view returns(bool)
+func (_Tokenbridge *TokenbridgeCaller) IsTransferCompleted(opts *bind.CallOpts, hash [32]byte) (bool, error) {
+	var out []interface{}
+	err := _Tokenbridge.contract.Call(opts, &out, "isTransferCompleted", hash)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsTransferCompleted is a free data retrieval call binding the contract method 0xaa4efa5b.
+//
+// Solidity: function isTransferCompleted(bytes32 hash) view returns(bool)
+func (_Tokenbridge *TokenbridgeSession) IsTransferCompleted(hash [32]byte) (bool, error) {
+	return _Tokenbridge.Contract.IsTransferCompleted(&_Tokenbridge.CallOpts, hash)
+}
+
+// IsTransferCompleted is a free data retrieval call binding the contract method 0xaa4efa5b.
+//
+// Solidity: function isTransferCompleted(bytes32 hash) view returns(bool)
+func (_Tokenbridge *TokenbridgeCallerSession) IsTransferCompleted(hash [32]byte) (bool, error) {
+	return _Tokenbridge.Contract.IsTransferCompleted(&_Tokenbridge.CallOpts, hash)
+}
+
+// IsWrappedAsset is a free data retrieval call binding the contract method 0x1a2be4da.
+//
+// Solidity: function isWrappedAsset(address token) view returns(bool)
+func (_Tokenbridge *TokenbridgeCaller) IsWrappedAsset(opts *bind.CallOpts, token common.Address) (bool, error) {
+	var out []interface{}
+	err := _Tokenbridge.contract.Call(opts, &out, "isWrappedAsset", token)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// IsWrappedAsset is a free data retrieval call binding the contract method 0x1a2be4da.
+//
+// Solidity: function isWrappedAsset(address token) view returns(bool)
+func (_Tokenbridge *TokenbridgeSession) IsWrappedAsset(token common.Address) (bool, error) {
+	return _Tokenbridge.Contract.IsWrappedAsset(&_Tokenbridge.CallOpts, token)
+}
+
+// IsWrappedAsset is a free data retrieval call binding the contract method 0x1a2be4da.
+//
+// Solidity: function isWrappedAsset(address token) view returns(bool)
+func (_Tokenbridge *TokenbridgeCallerSession) IsWrappedAsset(token common.Address) (bool, error) {
+	return _Tokenbridge.Contract.IsWrappedAsset(&_Tokenbridge.CallOpts, token)
+}
+
+// OutstandingBridged is a free data retrieval call binding the contract method 0xb96c7e4d.
+//
+// Solidity: function outstandingBridged(address token) view returns(uint256)
+func (_Tokenbridge *TokenbridgeCaller) OutstandingBridged(opts *bind.CallOpts, token common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Tokenbridge.contract.Call(opts, &out, "outstandingBridged", token)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// OutstandingBridged is a free data retrieval call binding the contract method 0xb96c7e4d.
+//
+// Solidity: function outstandingBridged(address token) view returns(uint256)
+func (_Tokenbridge *TokenbridgeSession) OutstandingBridged(token common.Address) (*big.Int, error) {
+	return _Tokenbridge.Contract.OutstandingBridged(&_Tokenbridge.CallOpts, token)
+}
+
+// OutstandingBridged is a free data retrieval call binding the contract method 0xb96c7e4d.
+//
+// Solidity: function outstandingBridged(address token) view returns(uint256)
+func (_Tokenbridge *TokenbridgeCallerSession) OutstandingBridged(token common.Address) (*big.Int, error) {
+	return _Tokenbridge.Contract.OutstandingBridged(&_Tokenbridge.CallOpts, token)
+}
+
+// TokenImplementation is a free data retrieval call binding the contract method 0x2f3a3d5d.
+//
+// Solidity: function tokenImplementation() view returns(address)
+func (_Tokenbridge *TokenbridgeCaller) TokenImplementation(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Tokenbridge.contract.Call(opts, &out, "tokenImplementation")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// TokenImplementation is a free data retrieval call binding the contract method 0x2f3a3d5d.
+//
+// Solidity: function tokenImplementation() view returns(address)
+func (_Tokenbridge *TokenbridgeSession) TokenImplementation() (common.Address, error) {
+	return _Tokenbridge.Contract.TokenImplementation(&_Tokenbridge.CallOpts)
+}
+
+// TokenImplementation is a free data retrieval call binding the contract method 0x2f3a3d5d.
+//
+// Solidity: function tokenImplementation() view returns(address)
+func (_Tokenbridge *TokenbridgeCallerSession) TokenImplementation() (common.Address, error) {
+	return _Tokenbridge.Contract.TokenImplementation(&_Tokenbridge.CallOpts)
+}
+
+// Wormhole is a free data retrieval call binding the contract method 0x84acd1bb.
+//
+// Solidity: function wormhole() view returns(address)
+func (_Tokenbridge *TokenbridgeCaller) Wormhole(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Tokenbridge.contract.Call(opts, &out, "wormhole")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Wormhole is a free data retrieval call binding the contract method 0x84acd1bb.
+//
+// Solidity: function wormhole() view returns(address)
+func (_Tokenbridge *TokenbridgeSession) Wormhole() (common.Address, error) {
+	return _Tokenbridge.Contract.Wormhole(&_Tokenbridge.CallOpts)
+}
+
+// Wormhole is a free data retrieval call binding the contract method 0x84acd1bb.
+//
+// Solidity: function wormhole() view returns(address)
+func (_Tokenbridge *TokenbridgeCallerSession) Wormhole() (common.Address, error) {
+	return _Tokenbridge.Contract.Wormhole(&_Tokenbridge.CallOpts)
+}
+
+// WrappedAsset is a free data retrieval call binding the contract method 0x1ff1e286.
+//
+// Solidity: function wrappedAsset(uint16 tokenChainId, bytes32 tokenAddress) view returns(address)
+func (_Tokenbridge *TokenbridgeCaller) WrappedAsset(opts *bind.CallOpts, tokenChainId uint16, tokenAddress [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := _Tokenbridge.contract.Call(opts, &out, "wrappedAsset", tokenChainId, tokenAddress)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// WrappedAsset is a free data retrieval call binding the contract method 0x1ff1e286.
+//
+// Solidity: function wrappedAsset(uint16 tokenChainId, bytes32 tokenAddress) view returns(address)
+func (_Tokenbridge *TokenbridgeSession) WrappedAsset(tokenChainId uint16, tokenAddress [32]byte) (common.Address, error) {
+	return _Tokenbridge.Contract.WrappedAsset(&_Tokenbridge.CallOpts, tokenChainId, tokenAddress)
+}
+
+// WrappedAsset is a free data retrieval call binding the contract method 0x1ff1e286.
+//
+// Solidity: function wrappedAsset(uint16 tokenChainId, bytes32 tokenAddress) view returns(address)
+func (_Tokenbridge *TokenbridgeCallerSession) WrappedAsset(tokenChainId uint16, tokenAddress [32]byte) (common.Address, error) {
+	return _Tokenbridge.Contract.WrappedAsset(&_Tokenbridge.CallOpts, tokenChainId, tokenAddress)
+}
@@ -0,0 +1,158 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/ethereum/abi"
+	"github.com/alephium/wormhole-fork/node/pkg/p2p"
+	"go.uber.org/zap"
+)
+
+const (
+	// defaultMaxCatchupBlocks is the default number of blocks scanned for missed messages on startup.
+	defaultMaxCatchupBlocks = 10000
+	// catchupBatchSize is the number of blocks requested per eth_getLogs call, most providers limit the range of a query.
+	catchupBatchSize = 1000
+	// checkpointInterval is the interval at which the last processed block is persisted.
+	checkpointInterval = 15 * time.Second
+)
+
+// runCheckpoint scans the blocks published since the last processed block for missed messages, and then periodically
+// persists the last processed block. Missed messages are sent to messageC, and go through the same confirmation flow
+// as live messages.
+//
+// A block is considered processed once all its messages have either been confirmed or dropped. Since logs may be
+// delivered shortly after the head, the block height fetched at a tick is only persisted at the next tick, and only
+// if there is no pending message at or below that height.
+func (w *Watcher) runCheckpoint(ctx context.Context, logger *zap.Logger, messageC chan<- *abi.AbiLogMessagePublished) error {
+	lastProcessed, err := w.db.GetLastProcessedBlock(w.chainID)
+	if err == db.ErrCheckpointNotFound {
+		logger.Info("no last processed block found, skipping catch-up", zap.String("eth_network", w.networkName))
+	} else if err != nil {
+		return fmt.Errorf("failed to get last processed block: %w", err)
+	} else {
+		lastProcessedEthHeight.WithLabelValues(w.networkName).Set(float64(lastProcessed))
+		if err := w.catchUp(ctx, logger, lastProcessed, messageC); err != nil {
+			return err
+		}
+	}
+
+	var candidate uint64
+	t := time.NewTicker(checkpointInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			head, err := w.getBlockNumber(logger, ctx)
+			if err != nil {
+				logger.Warn("failed to get block number for checkpoint", zap.String("eth_network", w.networkName), zap.Error(err))
+				continue
+			}
+
+			checkpoint, ok := w.nextCheckpoint(candidate)
+			candidate = head
+			if !ok || checkpoint <= lastProcessed {
+				continue
+			}
+			if err := w.db.StoreLastProcessedBlock(w.chainID, checkpoint); err != nil {
+				logger.Error("failed to store last processed block", zap.String("eth_network", w.networkName), zap.Error(err))
+				continue
+			}
+			lastProcessed = checkpoint
+			lastProcessedEthHeight.WithLabelValues(w.networkName).Set(float64(lastProcessed))
+		}
+	}
+}
+
+// catchUp scans the blocks after lastProcessed up to the current head in ranges of catchupBatchSize blocks. At most
+// maxCatchupBlocks are scanned, older blocks are skipped.
+func (w *Watcher) catchUp(ctx context.Context, logger *zap.Logger, lastProcessed uint64, messageC chan<- *abi.AbiLogMessagePublished) error {
+	head, err := w.getBlockNumber(logger, ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get block number for catch-up: %w", err)
+	}
+	if lastProcessed >= head {
+		return nil
+	}
+
+	from := lastProcessed + 1
+	if head-lastProcessed > w.maxCatchupBlocks {
+		from = head - w.maxCatchupBlocks + 1
+		logger.Warn("last processed block is too old, skipping blocks",
+			zap.Uint64("last_processed_block", lastProcessed),
+			zap.Uint64("current_block", head),
+			zap.Uint64("max_catchup_blocks", w.maxCatchupBlocks),
+			zap.String("eth_network", w.networkName))
+	}
+
+	logger.Info("catching up from last processed block",
+		zap.Uint64("from", from), zap.Uint64("to", head), zap.String("eth_network", w.networkName))
+
+	for start := from; start <= head; start += catchupBatchSize {
+		end := start + catchupBatchSize - 1
+		if end > head {
+			end = head
+		}
+
+		msm := time.Now()
+		timeout, cancel := context.WithTimeout(ctx, 30*time.Second)
+		events, err := w.ethConn.FilterLogMessagePublished(timeout, start, end)
+		cancel()
+		queryLatency.WithLabelValues(w.networkName, "filter_logs").Observe(time.Since(msm).Seconds())
+		if err != nil {
+			ethConnectionErrors.WithLabelValues(w.networkName, "filter_logs_error").Inc()
+			p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+			return fmt.Errorf("failed to filter message publications from block %d to %d: %w", start, end, err)
+		}
+
+		for _, ev := range events {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case messageC <- ev:
+				ethMessagesCaughtUp.WithLabelValues(w.networkName).Inc()
+			}
+		}
+
+		logger.Info("caught up blocks",
+			zap.Uint64("from", start),
+			zap.Uint64("to", end),
+			zap.Int("messages", len(events)),
+			zap.String("eth_network", w.networkName))
+	}
+	return nil
+}
+
+// nextCheckpoint returns the last processed block given the head fetched at the previous tick, which is the block
+// before the lowest pending message if there is one. It returns false if the first block has a pending message.
+func (w *Watcher) nextCheckpoint(candidate uint64) (uint64, bool) {
+	lowest, ok := w.lowestPendingHeight()
+	if !ok || lowest > candidate {
+		return candidate, true
+	}
+	if lowest == 0 {
+		return 0, false
+	}
+	return lowest - 1, true
+}
+
+// lowestPendingHeight returns the lowest block height of the pending messages, false if there is no pending message.
+func (w *Watcher) lowestPendingHeight() (uint64, bool) {
+	w.pendingMu.Lock()
+	defer w.pendingMu.Unlock()
+
+	var lowest uint64
+	found := false
+	for _, p := range w.pending {
+		if !found || p.height < lowest {
+			lowest = p.height
+			found = true
+		}
+	}
+	return lowest, found
+}
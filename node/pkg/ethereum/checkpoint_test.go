@@ -0,0 +1,113 @@
+package ethereum
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/alephium/wormhole-fork/node/pkg/ethereum/abi"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+type blockRange struct {
+	start uint64
+	end   uint64
+}
+
+// catchupConnector returns a fixed head and one message per filtered range.
+type catchupConnector struct {
+	DummyConnector
+	head   uint64
+	ranges []blockRange
+}
+
+func (c *catchupConnector) FilterLogMessagePublished(ctx context.Context, start uint64, end uint64) ([]*abi.AbiLogMessagePublished, error) {
+	c.ranges = append(c.ranges, blockRange{start, end})
+	return []*abi.AbiLogMessagePublished{{Sequence: start, Raw: types.Log{BlockNumber: start}}}, nil
+}
+
+func (c *catchupConnector) RawCallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
+	rawJson := fmt.Sprintf(`{"Number": "%s", "Hash": "%s"}`, hexutil.EncodeUint64(c.head), common.Hash{}.Hex())
+	return json.Unmarshal([]byte(rawJson), result)
+}
+
+func newCatchupWatcher(conn *catchupConnector, maxCatchupBlocks uint64) *Watcher {
+	w := NewEthWatcher("", common.Address{}, "eth", "", vaa.ChainIDEthereum, nil, nil, nil, false, nil, false, 0, false, nil, maxCatchupBlocks)
+	w.ethConn = &BlockPollConnector{Connector: conn}
+	return w
+}
+
+func collectMessages(messageC chan *abi.AbiLogMessagePublished) []uint64 {
+	sequences := make([]uint64, 0)
+	for {
+		select {
+		case ev := <-messageC:
+			sequences = append(sequences, ev.Sequence)
+		default:
+			return sequences
+		}
+	}
+}
+
+func TestCatchUpInBatches(t *testing.T) {
+	conn := &catchupConnector{head: 2500}
+	w := newCatchupWatcher(conn, 0)
+	messageC := make(chan *abi.AbiLogMessagePublished, 10)
+
+	assert.Nil(t, w.catchUp(context.Background(), zap.NewNop(), 100, messageC))
+	assert.Equal(t, []blockRange{{101, 1100}, {1101, 2100}, {2101, 2500}}, conn.ranges)
+	assert.Equal(t, []uint64{101, 1101, 2101}, collectMessages(messageC))
+}
+
+func TestCatchUpIsCapped(t *testing.T) {
+	conn := &catchupConnector{head: 10000}
+	w := newCatchupWatcher(conn, 1500)
+	messageC := make(chan *abi.AbiLogMessagePublished, 10)
+
+	assert.Nil(t, w.catchUp(context.Background(), zap.NewNop(), 100, messageC))
+	assert.Equal(t, []blockRange{{8501, 9500}, {9501, 10000}}, conn.ranges)
+}
+
+func TestCatchUpUpToDate(t *testing.T) {
+	conn := &catchupConnector{head: 100}
+	w := newCatchupWatcher(conn, 0)
+	messageC := make(chan *abi.AbiLogMessagePublished, 10)
+
+	assert.Nil(t, w.catchUp(context.Background(), zap.NewNop(), 100, messageC))
+	assert.Equal(t, 0, len(conn.ranges))
+}
+
+func TestLowestPendingHeight(t *testing.T) {
+	w := newCatchupWatcher(&catchupConnector{}, 0)
+	_, ok := w.lowestPendingHeight()
+	assert.False(t, ok)
+
+	w.pending[pendingKey{Sequence: 1}] = &pendingMessage{height: 20}
+	w.pending[pendingKey{Sequence: 2}] = &pendingMessage{height: 10}
+	lowest, ok := w.lowestPendingHeight()
+	assert.True(t, ok)
+	assert.Equal(t, uint64(10), lowest)
+}
+
+func TestNextCheckpoint(t *testing.T) {
+	w := newCatchupWatcher(&catchupConnector{}, 0)
+	checkpoint, ok := w.nextCheckpoint(100)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(100), checkpoint)
+
+	w.pending[pendingKey{Sequence: 1}] = &pendingMessage{height: 50}
+	checkpoint, ok = w.nextCheckpoint(100)
+	assert.True(t, ok)
+	assert.Equal(t, uint64(49), checkpoint)
+
+	// A message pending in the first block doesn't underflow
+	w.pending[pendingKey{Sequence: 2}] = &pendingMessage{height: 0}
+	_, ok = w.nextCheckpoint(100)
+	assert.False(t, ok)
+}
@@ -28,6 +28,7 @@ type Connector interface {
 	GetCurrentGuardianSetIndex(ctx context.Context) (uint32, error)
 	GetGuardianSet(ctx context.Context, index uint32) (abi.StructsGuardianSet, error)
 	WatchLogMessagePublished(ctx context.Context, sink chan<- *abi.AbiLogMessagePublished) (event.Subscription, error)
+	FilterLogMessagePublished(ctx context.Context, start uint64, end uint64) ([]*abi.AbiLogMessagePublished, error)
 	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
 	TimeOfBlockByHash(ctx context.Context, hash common.Hash) (uint64, error)
 	ParseLogMessagePublished(log types.Log) (*abi.AbiLogMessagePublished, error)
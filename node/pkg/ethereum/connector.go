@@ -80,6 +80,23 @@ func (e *EthereumConnector) WatchLogMessagePublished(ctx context.Context, sink c
 	return e.filterer.WatchLogMessagePublished(&ethBind.WatchOpts{Context: timeout}, sink, nil)
 }
 
+func (e *EthereumConnector) FilterLogMessagePublished(ctx context.Context, start uint64, end uint64) ([]*ethAbi.AbiLogMessagePublished, error) {
+	it, err := e.filterer.FilterLogMessagePublished(&ethBind.FilterOpts{Start: start, End: &end, Context: ctx}, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	events := make([]*ethAbi.AbiLogMessagePublished, 0)
+	for it.Next() {
+		events = append(events, it.Event)
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
 func (e *EthereumConnector) TransactionReceipt(ctx context.Context, txHash ethCommon.Hash) (*ethTypes.Receipt, error) {
 	return e.client.TransactionReceipt(ctx, txHash)
 }
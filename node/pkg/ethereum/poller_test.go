@@ -47,6 +47,10 @@ func (c *DummyConnector) WatchLogMessagePublished(ctx context.Context, sink chan
 	return nil, nil
 }
 
+func (c *DummyConnector) FilterLogMessagePublished(ctx context.Context, start uint64, end uint64) ([]*abi.AbiLogMessagePublished, error) {
+	return nil, nil
+}
+
 func (c *DummyConnector) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
 	return nil, nil
 }
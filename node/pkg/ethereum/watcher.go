@@ -18,6 +18,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
 	"github.com/alephium/wormhole-fork/node/pkg/readiness"
 	"github.com/alephium/wormhole-fork/node/pkg/supervisor"
 	"github.com/alephium/wormhole-fork/node/pkg/vaa"
@@ -55,6 +56,16 @@ var (
 			Name: "wormhole_eth_query_latency",
 			Help: "Latency histogram for Ethereum calls (note that most interactions are streaming queries, NOT calls, and we cannot measure latency for those",
 		}, []string{"eth_network", "operation"})
+	lastProcessedEthHeight = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_eth_last_processed_height",
+			Help: "Height of the last Ethereum block for which all messages have been processed",
+		}, []string{"eth_network"})
+	ethMessagesCaughtUp = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_eth_messages_caught_up_total",
+			Help: "Total number of Eth messages found while catching up from the last processed block",
+		}, []string{"eth_network"})
 )
 
 type (
@@ -104,6 +115,18 @@ type (
 		// parameter defaults to 60, which should be plenty long enough for most chains. If not, this parameter can be set.
 		maxWaitConfirmations uint64
 
+		// useFinalizedBlocks indicates if we should only process blocks which have been finalized by the consensus layer.
+		// It is ignored in dev mode, since the devnet chains don't support the finalized block tag.
+		useFinalizedBlocks bool
+
+		// db is used to persist the last processed block, so that messages published while the guardian was down are
+		// observed on startup. The checkpoint is disabled if db is nil.
+		db *db.Database
+
+		// maxCatchupBlocks is the maximum number of blocks scanned for missed messages on startup. Messages in older
+		// blocks have to be recovered with observation requests.
+		maxCatchupBlocks uint64
+
 		// Interface to the chain specific ethereum library.
 		ethConn       *BlockPollConnector
 		unsafeDevMode bool
@@ -136,7 +159,17 @@ func NewEthWatcher(
 	unsafeDevMode bool,
 	pollIntervalMs *uint,
 	waitForConfirmations bool,
+	maxWaitConfirmations uint64,
+	useFinalizedBlocks bool,
+	db *db.Database,
+	maxCatchupBlocks uint64,
 ) *Watcher {
+	if maxWaitConfirmations == 0 {
+		maxWaitConfirmations = 60
+	}
+	if maxCatchupBlocks == 0 {
+		maxCatchupBlocks = defaultMaxCatchupBlocks
+	}
 
 	return &Watcher{
 		url:                  url,
@@ -144,7 +177,10 @@ func NewEthWatcher(
 		networkName:          networkName,
 		readiness:            readiness,
 		waitForConfirmations: waitForConfirmations,
-		maxWaitConfirmations: 60,
+		maxWaitConfirmations: maxWaitConfirmations,
+		useFinalizedBlocks:   useFinalizedBlocks,
+		db:                   db,
+		maxCatchupBlocks:     maxCatchupBlocks,
 		chainID:              chainID,
 		msgChan:              messageEvents,
 		setChan:              setEvents,
@@ -170,7 +206,7 @@ func (w *Watcher) Run(ctx context.Context) error {
 	timeout, cancel := context.WithTimeout(ctx, 15*time.Second)
 	defer cancel()
 
-	useFinalizedBlocks := (w.chainID == vaa.ChainIDEthereum && (!w.unsafeDevMode))
+	useFinalizedBlocks := w.useFinalizedBlocks && !w.unsafeDevMode
 	logger.Info("starting evm watcher", zap.String("chainName", w.chainID.String()), zap.Bool("useFinalizedBlocks", useFinalizedBlocks))
 
 	baseConnector, err := NewEthereumConnector(timeout, w.networkName, w.url, w.contract, logger)
@@ -563,6 +599,14 @@ func (w *Watcher) Run(ctx context.Context) error {
 		}
 	}()
 
+	if w.db != nil {
+		go func() {
+			if err := w.runCheckpoint(ctx, logger, messageC); err != nil && err != ctx.Err() {
+				errC <- err
+			}
+		}()
+	}
+
 	// Now that the init is complete, peg readiness. That will also happen when we process a new head, but chains
 	// that wait for finality may take a while to receive the first block and we don't want to hold up the init.
 	readiness.SetReady(w.readiness)
@@ -607,6 +651,15 @@ func (w *Watcher) fetchAndUpdateGuardianSet(
 		return nil
 	}
 
+	// The previous guardian set remains valid until it expires, which also covers upgrades made while the
+	// guardian was down.
+	prev, err := FetchPreviousGuardianSet(timeout, ethConn, idx)
+	if err != nil {
+		ethConnectionErrors.WithLabelValues(w.networkName, "guardian_set_fetch_error").Inc()
+		p2p.DefaultRegistry.AddErrorCount(w.chainID, 1)
+		return err
+	}
+
 	logger.Info("updated guardian set found",
 		zap.Any("value", gs), zap.Uint32("index", idx),
 		zap.String("eth_network", w.networkName))
@@ -618,6 +671,9 @@ func (w *Watcher) fetchAndUpdateGuardianSet(
 			Keys:  gs.Keys,
 			Index: idx,
 		}
+		if prev != nil {
+			w.setChan <- prev
+		}
 	}
 
 	return nil
@@ -638,6 +694,23 @@ func fetchCurrentGuardianSet(ctx context.Context, ethConn Connector) (uint32, *a
 	return currentIndex, &gs, nil
 }
 
+// FetchPreviousGuardianSet fetches the guardian set replaced by the guardian set currentIndex, with the expiration
+// time set by the core contract on upgrade. It returns nil if there is none or if it already expired.
+func FetchPreviousGuardianSet(ctx context.Context, ethConn Connector, currentIndex uint32) (*common.GuardianSet, error) {
+	if currentIndex == 0 {
+		return nil, nil
+	}
+	gs, err := ethConn.GetGuardianSet(ctx, currentIndex-1)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting previous guardian set value: %w", err)
+	}
+	expiry := time.Unix(int64(gs.ExpirationTime), 0)
+	if gs.ExpirationTime == 0 || !time.Now().Before(expiry) {
+		return nil, nil
+	}
+	return &common.GuardianSet{Keys: gs.Keys, Index: currentIndex - 1, ExpirationTime: expiry}, nil
+}
+
 // SetWaitForConfirmations is used to override whether we should wait for the number of confirmations specified by the consistencyLevel in the message.
 func (w *Watcher) SetWaitForConfirmations(waitForConfirmations bool) {
 	w.waitForConfirmations = waitForConfirmations
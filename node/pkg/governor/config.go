@@ -0,0 +1,64 @@
+package governor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"time"
+)
+
+// TokenConfig describes a token whose transfers are governed. Address is the hex encoded 32 bytes
+// token address on its origin chain, Price is the notional value of one whole token in USD.
+type TokenConfig struct {
+	Chain    uint16  `json:"chain"`
+	Address  string  `json:"address"`
+	Symbol   string  `json:"symbol"`
+	Decimals uint8   `json:"decimals"`
+	Price    float64 `json:"price"`
+}
+
+// ChainConfig describes the limits of an emitter chain. DailyLimit is the maximum notional value in USD
+// that can leave the chain in a rolling 24h window, and any single transfer whose notional value is
+// greater than or equal to BigTransactionSize is delayed. A BigTransactionSize of 0 disables the check.
+type ChainConfig struct {
+	EmitterChain       uint16 `json:"emitterChain"`
+	DailyLimit         uint64 `json:"dailyLimit"`
+	BigTransactionSize uint64 `json:"bigTransactionSize"`
+}
+
+type Config struct {
+	Chains []ChainConfig `json:"chains"`
+	Tokens []TokenConfig `json:"tokens"`
+
+	// Delay is the time a held transfer waits before it is released regardless of the daily limit,
+	// e.g. "24h". Defaults to 24h if empty.
+	Delay string `json:"delay,omitempty"`
+}
+
+const defaultDelay = 24 * time.Hour
+
+func (c *Config) delay() (time.Duration, error) {
+	if c.Delay == "" {
+		return defaultDelay, nil
+	}
+	d, err := time.ParseDuration(c.Delay)
+	if err != nil {
+		return 0, fmt.Errorf("invalid governor delay %s: %w", c.Delay, err)
+	}
+	if d <= 0 {
+		return 0, fmt.Errorf("invalid governor delay %s", c.Delay)
+	}
+	return d, nil
+}
+
+func ReadConfig(path string) (*Config, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config Config
+	if err := json.Unmarshal(bytes, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse governor config %s: %w", path, err)
+	}
+	return &config, nil
+}
@@ -0,0 +1,550 @@
+// Package governor limits the notional value of token bridge transfers which leave an emitter chain.
+//
+// Every transfer emitted by a governed token bridge is valued using the configured token prices. A transfer
+// is approved and signed immediately if the sum of all transfers approved in the last 24h plus the new
+// transfer stays within the daily limit of its emitter chain. Otherwise, or if the transfer itself is
+// larger than the big transaction threshold, it is held and released once the rolling window has enough
+// room, or when the configured delay elapses. Transfers and held messages are persisted in the local
+// database so that they survive restarts.
+package governor
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+var (
+	governorPendingTransfers = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_governor_pending_transfers",
+			Help: "Current number of transfers held by the governor",
+		}, []string{"emitter_chain"})
+
+	governorNotionalUsage = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_governor_notional_usage",
+			Help: "Notional value in USD of the transfers approved by the governor in the last 24h",
+		}, []string{"emitter_chain"})
+
+	governorTransfersHeldTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_governor_transfers_held_total",
+			Help: "Total number of transfers held by the governor",
+		}, []string{"emitter_chain", "reason"})
+)
+
+const transferWindow = 24 * time.Hour
+
+type tokenKey struct {
+	chain   vaa.ChainID
+	address vaa.Address
+}
+
+type tokenEntry struct {
+	symbol   string
+	decimals uint8
+	price    *big.Float
+}
+
+type pendingEntry struct {
+	db.GovernorPendingTransfer
+	value uint64
+}
+
+type chainEntry struct {
+	emitterChain       vaa.ChainID
+	emitterAddress     vaa.Address
+	dailyLimit         uint64
+	bigTransactionSize uint64
+
+	transfers []*db.GovernorTransfer
+	pending   []*pendingEntry
+}
+
+// PendingTransfer describes a message which is currently held by the governor.
+type PendingTransfer struct {
+	MsgID        string
+	EmitterChain vaa.ChainID
+	TargetChain  vaa.ChainID
+	TxHash       string
+	Value        uint64
+	ReleaseTime  time.Time
+}
+
+// ChainStatus describes the current usage of an emitter chain.
+type ChainStatus struct {
+	EmitterChain       vaa.ChainID
+	DailyLimit         uint64
+	BigTransactionSize uint64
+	NotionalUsage      uint64
+	PendingCount       int
+}
+
+type ChainGovernor struct {
+	db     *db.Database
+	logger *zap.Logger
+	delay  time.Duration
+
+	mutex  sync.Mutex
+	tokens map[tokenKey]*tokenEntry
+	chains map[vaa.ChainID]*chainEntry
+	// msgsApproved contains the id of all approved transfers in the current window, it allows
+	// the governor to handle re-observations in an idempotent fashion.
+	msgsApproved map[string]bool
+	// orphaned contains the pending messages loaded from the database whose emitter is no longer governed.
+	orphaned []*db.GovernorPendingTransfer
+
+	// timeFunc returns the current time, it is replaced in tests.
+	timeFunc func() time.Time
+}
+
+// NewChainGovernor creates a governor for the chains in config. emitters maps an emitter chain to the address
+// of its token bridge emitter, chains without a token bridge emitter are rejected.
+func NewChainGovernor(logger *zap.Logger, database *db.Database, config *Config, emitters map[vaa.ChainID]vaa.Address) (*ChainGovernor, error) {
+	delay, err := config.delay()
+	if err != nil {
+		return nil, err
+	}
+
+	gov := &ChainGovernor{
+		db:           database,
+		logger:       logger,
+		delay:        delay,
+		tokens:       make(map[tokenKey]*tokenEntry),
+		chains:       make(map[vaa.ChainID]*chainEntry),
+		msgsApproved: make(map[string]bool),
+		timeFunc:     time.Now,
+	}
+
+	for _, t := range config.Tokens {
+		addrBytes, err := hex.DecodeString(t.Address)
+		if err != nil || len(addrBytes) != 32 {
+			return nil, fmt.Errorf("invalid governor token address %s", t.Address)
+		}
+		if t.Price <= 0 || math.IsInf(t.Price, 0) || math.IsNaN(t.Price) {
+			return nil, fmt.Errorf("invalid price %v for governor token %s", t.Price, t.Symbol)
+		}
+		key := tokenKey{chain: vaa.ChainID(t.Chain)}
+		copy(key.address[:], addrBytes)
+		if _, exists := gov.tokens[key]; exists {
+			return nil, fmt.Errorf("duplicate governor token %d/%s", t.Chain, t.Address)
+		}
+		gov.tokens[key] = &tokenEntry{
+			symbol:   t.Symbol,
+			decimals: t.Decimals,
+			price:    big.NewFloat(t.Price),
+		}
+	}
+
+	for _, c := range config.Chains {
+		chainId := vaa.ChainID(c.EmitterChain)
+		emitter, ok := emitters[chainId]
+		if !ok {
+			return nil, fmt.Errorf("no token bridge emitter for governed chain %v", chainId)
+		}
+		if _, exists := gov.chains[chainId]; exists {
+			return nil, fmt.Errorf("duplicate governor chain %v", chainId)
+		}
+		gov.chains[chainId] = &chainEntry{
+			emitterChain:       chainId,
+			emitterAddress:     emitter,
+			dailyLimit:         c.DailyLimit,
+			bigTransactionSize: c.BigTransactionSize,
+			transfers:          make([]*db.GovernorTransfer, 0),
+			pending:            make([]*pendingEntry, 0),
+		}
+	}
+
+	if err := gov.loadFromDB(); err != nil {
+		return nil, err
+	}
+
+	return gov, nil
+}
+
+func (gov *ChainGovernor) loadFromDB() error {
+	transfers, pending, err := gov.db.GetGovernorData()
+	if err != nil {
+		return fmt.Errorf("failed to load governor data: %w", err)
+	}
+
+	sort.SliceStable(transfers, func(i, j int) bool {
+		return transfers[i].Timestamp.Before(transfers[j].Timestamp)
+	})
+	for _, t := range transfers {
+		ce, ok := gov.chains[t.EmitterChain]
+		if !ok || ce.emitterAddress != t.EmitterAddress {
+			gov.logger.Info("governor: ignoring transfer of a chain which is no longer governed", zap.String("msgID", t.MsgID))
+			continue
+		}
+		ce.transfers = append(ce.transfers, t)
+		gov.msgsApproved[t.MsgID] = true
+	}
+
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].Msg.Timestamp.Before(pending[j].Msg.Timestamp)
+	})
+	for _, p := range pending {
+		msgID := p.Msg.MessageIDString()
+		ce, ok := gov.isGoverned(&p.Msg)
+		if !ok {
+			// The emitter is no longer governed, the message will be released on the next check.
+			gov.logger.Warn("governor: pending message of an emitter which is no longer governed, releasing it", zap.String("msgID", msgID))
+			gov.orphaned = append(gov.orphaned, p)
+			continue
+		}
+		value, err := gov.transferValue(&p.Msg)
+		if err != nil {
+			gov.logger.Error("governor: failed to compute value of pending message", zap.String("msgID", msgID), zap.Error(err))
+		}
+		ce.pending = append(ce.pending, &pendingEntry{GovernorPendingTransfer: *p, value: value})
+		gov.logger.Info("governor: reloaded pending message", zap.String("msgID", msgID), zap.Time("releaseTime", p.ReleaseTime))
+	}
+
+	gov.updateMetrics()
+	return nil
+}
+
+// transferValue returns the notional value in USD of a token bridge transfer, or 0 if the token is not governed.
+func (gov *ChainGovernor) transferValue(msg *common.MessagePublication) (uint64, error) {
+	hdr, err := vaa.DecodeTransferPayloadHdr(msg.Payload)
+	if err != nil {
+		return 0, err
+	}
+	token, ok := gov.tokens[tokenKey{chain: hdr.OriginChain, address: hdr.OriginAddress}]
+	if !ok {
+		return 0, nil
+	}
+	return token.value(hdr.Amount), nil
+}
+
+// value computes the notional value of an amount of tokens. The token bridge normalizes amounts to at most 8 decimals.
+func (t *tokenEntry) value(amount *big.Int) uint64 {
+	decimals := t.decimals
+	if decimals > 8 {
+		decimals = 8
+	}
+	divisor := new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	v := new(big.Float).SetInt(amount)
+	v.Quo(v, divisor)
+	v.Mul(v, t.price)
+
+	// Uint64 truncates the fractional part and saturates at math.MaxUint64.
+	result, _ := v.Uint64()
+	return result
+}
+
+// isGoverned returns the chain entry of the message if it is emitted by a governed token bridge.
+func (gov *ChainGovernor) isGoverned(msg *common.MessagePublication) (*chainEntry, bool) {
+	ce, ok := gov.chains[msg.EmitterChain]
+	if !ok || ce.emitterAddress != msg.EmitterAddress {
+		return nil, false
+	}
+	return ce, true
+}
+
+// ProcessMsg checks a message against the governor limits. It returns true if the message can be signed
+// immediately, otherwise the message is held (or dropped if it is invalid) and false is returned.
+func (gov *ChainGovernor) ProcessMsg(msg *common.MessagePublication) bool {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	ce, ok := gov.isGoverned(msg)
+	if !ok {
+		return true
+	}
+
+	if !vaa.IsTransfer(msg.Payload) {
+		return true
+	}
+
+	msgID := msg.MessageIDString()
+	hdr, err := vaa.DecodeTransferPayloadHdr(msg.Payload)
+	if err != nil {
+		gov.logger.Error("governor: failed to decode transfer, dropping it", zap.String("msgID", msgID), zap.Error(err))
+		return false
+	}
+
+	token, ok := gov.tokens[tokenKey{chain: hdr.OriginChain, address: hdr.OriginAddress}]
+	if !ok {
+		return true
+	}
+
+	if gov.msgsApproved[msgID] {
+		gov.logger.Info("governor: transfer has already been approved", zap.String("msgID", msgID))
+		return true
+	}
+
+	for _, p := range ce.pending {
+		if p.Msg.MessageIDString() == msgID {
+			gov.logger.Info("governor: transfer is already pending", zap.String("msgID", msgID), zap.Time("releaseTime", p.ReleaseTime))
+			return false
+		}
+	}
+
+	now := gov.timeFunc()
+	gov.pruneTransfers(ce, now)
+
+	value := token.value(hdr.Amount)
+	usage := ce.notionalUsage()
+
+	reason := ""
+	if ce.bigTransactionSize != 0 && value >= ce.bigTransactionSize {
+		reason = "big_transaction"
+	} else if value > ce.dailyLimit || usage+value > ce.dailyLimit {
+		reason = "daily_limit"
+	}
+
+	if reason != "" {
+		pending := &pendingEntry{
+			GovernorPendingTransfer: db.GovernorPendingTransfer{
+				ReleaseTime: now.Add(gov.delay),
+				Msg:         *msg,
+			},
+			value: value,
+		}
+		if err := gov.db.StoreGovernorPendingTransfer(&pending.GovernorPendingTransfer); err != nil {
+			gov.logger.Error("governor: failed to store pending transfer", zap.String("msgID", msgID), zap.Error(err))
+		}
+		ce.pending = append(ce.pending, pending)
+		governorTransfersHeldTotal.WithLabelValues(ce.emitterChain.String(), reason).Inc()
+		gov.logger.Info("governor: holding transfer",
+			zap.String("msgID", msgID),
+			zap.String("reason", reason),
+			zap.String("token", token.symbol),
+			zap.Uint64("value", value),
+			zap.Uint64("notionalUsage", usage),
+			zap.Uint64("dailyLimit", ce.dailyLimit),
+			zap.Stringer("txHash", msg.TxHash),
+			zap.Time("releaseTime", pending.ReleaseTime),
+		)
+		gov.updateMetrics()
+		return false
+	}
+
+	gov.approve(ce, msg, hdr, value, now)
+	gov.logger.Info("governor: approved transfer",
+		zap.String("msgID", msgID),
+		zap.String("token", token.symbol),
+		zap.Uint64("value", value),
+		zap.Uint64("notionalUsage", usage+value),
+		zap.Uint64("dailyLimit", ce.dailyLimit),
+		zap.Stringer("txHash", msg.TxHash),
+	)
+	gov.updateMetrics()
+	return true
+}
+
+func (gov *ChainGovernor) approve(ce *chainEntry, msg *common.MessagePublication, hdr *vaa.TransferPayloadHdr, value uint64, now time.Time) {
+	transfer := &db.GovernorTransfer{
+		Timestamp:      now,
+		Value:          value,
+		EmitterChain:   msg.EmitterChain,
+		EmitterAddress: msg.EmitterAddress,
+		MsgID:          msg.MessageIDString(),
+	}
+	if hdr != nil {
+		transfer.OriginChain = hdr.OriginChain
+		transfer.OriginAddress = hdr.OriginAddress
+	}
+	if err := gov.db.StoreGovernorTransfer(transfer); err != nil {
+		gov.logger.Error("governor: failed to store transfer", zap.String("msgID", transfer.MsgID), zap.Error(err))
+	}
+	ce.transfers = append(ce.transfers, transfer)
+	gov.msgsApproved[transfer.MsgID] = true
+}
+
+// pruneTransfers removes the transfers which are out of the rolling window.
+func (gov *ChainGovernor) pruneTransfers(ce *chainEntry, now time.Time) {
+	startTime := now.Add(-transferWindow)
+	remaining := make([]*db.GovernorTransfer, 0, len(ce.transfers))
+	for _, t := range ce.transfers {
+		if t.Timestamp.After(startTime) {
+			remaining = append(remaining, t)
+			continue
+		}
+		if err := gov.db.DeleteGovernorTransfer(t.MsgID); err != nil {
+			gov.logger.Error("governor: failed to delete transfer", zap.String("msgID", t.MsgID), zap.Error(err))
+		}
+		delete(gov.msgsApproved, t.MsgID)
+	}
+	ce.transfers = remaining
+}
+
+func (ce *chainEntry) notionalUsage() uint64 {
+	sum := uint64(0)
+	for _, t := range ce.transfers {
+		if sum+t.Value < sum {
+			return math.MaxUint64
+		}
+		sum += t.Value
+	}
+	return sum
+}
+
+// CheckPending returns the held messages which can be released, either because their release time
+// has passed or because the daily limit of their emitter chain allows it now.
+func (gov *ChainGovernor) CheckPending() []*common.MessagePublication {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	now := gov.timeFunc()
+	released := make([]*common.MessagePublication, 0)
+	for _, p := range gov.orphaned {
+		msg := p.Msg
+		if err := gov.db.DeleteGovernorPendingTransfer(msg.MessageIDString()); err != nil {
+			gov.logger.Error("governor: failed to delete pending transfer", zap.String("msgID", msg.MessageIDString()), zap.Error(err))
+		}
+		released = append(released, &msg)
+	}
+	gov.orphaned = nil
+
+	for _, ce := range gov.chains {
+		gov.pruneTransfers(ce, now)
+		remaining := make([]*pendingEntry, 0, len(ce.pending))
+		for _, p := range ce.pending {
+			isBigTransaction := ce.bigTransactionSize != 0 && p.value >= ce.bigTransactionSize
+			usage := ce.notionalUsage()
+			fitsInLimit := !isBigTransaction && p.value <= ce.dailyLimit && usage+p.value <= ce.dailyLimit
+			if !now.Before(p.ReleaseTime) || fitsInLimit {
+				msg := p.Msg
+				msgID := msg.MessageIDString()
+				if err := gov.db.DeleteGovernorPendingTransfer(msgID); err != nil {
+					gov.logger.Error("governor: failed to delete pending transfer", zap.String("msgID", msgID), zap.Error(err))
+				}
+				hdr, err := vaa.DecodeTransferPayloadHdr(msg.Payload)
+				if err != nil {
+					hdr = nil
+				}
+				gov.approve(ce, &msg, hdr, p.value, now)
+				gov.logger.Info("governor: releasing pending transfer",
+					zap.String("msgID", msgID),
+					zap.Uint64("value", p.value),
+					zap.Bool("releaseTimeElapsed", !now.Before(p.ReleaseTime)),
+					zap.Stringer("txHash", msg.TxHash),
+				)
+				released = append(released, &msg)
+				continue
+			}
+			remaining = append(remaining, p)
+		}
+		ce.pending = remaining
+	}
+
+	gov.updateMetrics()
+	return released
+}
+
+func (gov *ChainGovernor) findPending(msgID string) (*chainEntry, int) {
+	for _, ce := range gov.chains {
+		for idx, p := range ce.pending {
+			if p.Msg.MessageIDString() == msgID {
+				return ce, idx
+			}
+		}
+	}
+	return nil, -1
+}
+
+// ReleasePendingTransfer marks a held message to be released on the next check, regardless of the limits.
+func (gov *ChainGovernor) ReleasePendingTransfer(msgID string) error {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	ce, idx := gov.findPending(msgID)
+	if ce == nil {
+		return fmt.Errorf("pending transfer %s not found", msgID)
+	}
+	p := ce.pending[idx]
+	p.ReleaseTime = gov.timeFunc()
+	if err := gov.db.StoreGovernorPendingTransfer(&p.GovernorPendingTransfer); err != nil {
+		return fmt.Errorf("failed to store pending transfer: %w", err)
+	}
+	gov.logger.Info("governor: pending transfer will be released", zap.String("msgID", msgID))
+	return nil
+}
+
+// DropPendingTransfer removes a held message, the message will not be signed unless it is observed again.
+func (gov *ChainGovernor) DropPendingTransfer(msgID string) error {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	ce, idx := gov.findPending(msgID)
+	if ce == nil {
+		return fmt.Errorf("pending transfer %s not found", msgID)
+	}
+	if err := gov.db.DeleteGovernorPendingTransfer(msgID); err != nil {
+		return fmt.Errorf("failed to delete pending transfer: %w", err)
+	}
+	ce.pending = append(ce.pending[:idx], ce.pending[idx+1:]...)
+	gov.logger.Info("governor: dropped pending transfer", zap.String("msgID", msgID))
+	gov.updateMetrics()
+	return nil
+}
+
+// GetPendingTransfers returns all messages which are currently held, ordered by release time.
+func (gov *ChainGovernor) GetPendingTransfers() []*PendingTransfer {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	result := make([]*PendingTransfer, 0)
+	for _, ce := range gov.chains {
+		for _, p := range ce.pending {
+			result = append(result, &PendingTransfer{
+				MsgID:        p.Msg.MessageIDString(),
+				EmitterChain: p.Msg.EmitterChain,
+				TargetChain:  p.Msg.TargetChain,
+				TxHash:       p.Msg.TxHash.Hex(),
+				Value:        p.value,
+				ReleaseTime:  p.ReleaseTime,
+			})
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].ReleaseTime.Before(result[j].ReleaseTime)
+	})
+	return result
+}
+
+// GetChainStatus returns the limits and current usage of all governed chains.
+func (gov *ChainGovernor) GetChainStatus() []*ChainStatus {
+	gov.mutex.Lock()
+	defer gov.mutex.Unlock()
+
+	now := gov.timeFunc()
+	result := make([]*ChainStatus, 0, len(gov.chains))
+	for _, ce := range gov.chains {
+		gov.pruneTransfers(ce, now)
+		result = append(result, &ChainStatus{
+			EmitterChain:       ce.emitterChain,
+			DailyLimit:         ce.dailyLimit,
+			BigTransactionSize: ce.bigTransactionSize,
+			NotionalUsage:      ce.notionalUsage(),
+			PendingCount:       len(ce.pending),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].EmitterChain < result[j].EmitterChain
+	})
+	return result
+}
+
+func (gov *ChainGovernor) updateMetrics() {
+	for _, ce := range gov.chains {
+		governorPendingTransfers.WithLabelValues(ce.emitterChain.String()).Set(float64(len(ce.pending)))
+		governorNotionalUsage.WithLabelValues(ce.emitterChain.String()).Set(float64(ce.notionalUsage()))
+	}
+}
@@ -0,0 +1,201 @@
+package governor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+var (
+	tokenBridgeEmitter = vaa.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x67, 0xb5, 0x65, 0x6d, 0x60, 0xa8, 0x09, 0x91, 0x53, 0x23, 0xbf, 0x2c, 0x40, 0xa8, 0xbe, 0xf1, 0x5a, 0x15, 0x2e, 0x3e}
+	tokenAddress       = vaa.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x95, 0x61, 0xc1, 0x33, 0xdd, 0x85, 0x80, 0x86, 0x0b, 0x6b, 0x7e, 0x50, 0x4b, 0xc5, 0xaa, 0x50, 0x0f, 0x0f, 0x06, 0xa7}
+)
+
+func transferPayload(amount uint64, tokenChain vaa.ChainID, token vaa.Address) []byte {
+	buf := new(bytes.Buffer)
+	buf.WriteByte(1)
+	buf.Write(ethcommon.LeftPadBytes(new(big.Int).SetUint64(amount).Bytes(), 32))
+	buf.Write(token[:])
+	vaa.MustWrite(buf, binary.BigEndian, tokenChain)
+	recipient := make([]byte, 32)
+	vaa.MustWrite(buf, binary.BigEndian, uint16(len(recipient)))
+	buf.Write(recipient)
+	buf.Write(make([]byte, 32))
+	return buf.Bytes()
+}
+
+func transferMsg(sequence uint64, amount uint64) *common.MessagePublication {
+	return &common.MessagePublication{
+		Timestamp:      time.Unix(int64(1654516425+sequence), 0),
+		Nonce:          1,
+		Sequence:       sequence,
+		EmitterChain:   vaa.ChainIDEthereum,
+		TargetChain:    vaa.ChainIDAlephium,
+		EmitterAddress: tokenBridgeEmitter,
+		Payload:        transferPayload(amount, vaa.ChainIDEthereum, tokenAddress),
+	}
+}
+
+func newTestGovernor(t *testing.T, database *db.Database, now *time.Time, delay string) *ChainGovernor {
+	config := &Config{
+		Chains: []ChainConfig{{EmitterChain: uint16(vaa.ChainIDEthereum), DailyLimit: 10000, BigTransactionSize: 5000}},
+		Tokens: []TokenConfig{{Chain: uint16(vaa.ChainIDEthereum), Address: hex.EncodeToString(tokenAddress[:]), Symbol: "WETH", Decimals: 18, Price: 1000}},
+		Delay:  delay,
+	}
+	emitters := map[vaa.ChainID]vaa.Address{vaa.ChainIDEthereum: tokenBridgeEmitter}
+	gov, err := NewChainGovernor(zap.NewNop(), database, config, emitters)
+	assert.Nil(t, err)
+	gov.timeFunc = func() time.Time { return *now }
+	return gov
+}
+
+// oneToken is one whole token, the token bridge normalizes amounts to 8 decimals.
+const oneToken = 100000000
+
+func TestTokenValue(t *testing.T) {
+	token := &tokenEntry{decimals: 18, price: big.NewFloat(1.5)}
+	assert.Equal(t, uint64(15), token.value(big.NewInt(10*oneToken)))
+	assert.Equal(t, uint64(0), token.value(big.NewInt(oneToken/2)))
+
+	token = &tokenEntry{decimals: 6, price: big.NewFloat(1)}
+	assert.Equal(t, uint64(3), token.value(big.NewInt(3000000)))
+}
+
+func TestUngovernedMessagesAreApproved(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+	now := time.Unix(1654516425, 0)
+	gov := newTestGovernor(t, database, &now, "1h")
+
+	// non-transfer payload
+	msg := transferMsg(0, 100*oneToken)
+	msg.Payload[0] = 2
+	assert.True(t, gov.ProcessMsg(msg))
+
+	// other emitter
+	msg = transferMsg(1, 100*oneToken)
+	msg.EmitterAddress = vaa.Address{1}
+	assert.True(t, gov.ProcessMsg(msg))
+
+	// ungoverned token
+	msg = transferMsg(2, 100*oneToken)
+	msg.Payload = transferPayload(100*oneToken, vaa.ChainIDEthereum, vaa.Address{2})
+	assert.True(t, gov.ProcessMsg(msg))
+
+	assert.Equal(t, uint64(0), gov.GetChainStatus()[0].NotionalUsage)
+}
+
+func TestDailyLimit(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+	now := time.Unix(1654516425, 0)
+	gov := newTestGovernor(t, database, &now, "48h")
+
+	assert.True(t, gov.ProcessMsg(transferMsg(0, 4*oneToken)))
+	assert.True(t, gov.ProcessMsg(transferMsg(1, 4*oneToken)))
+	// re-observation of an approved transfer
+	assert.True(t, gov.ProcessMsg(transferMsg(1, 4*oneToken)))
+	assert.Equal(t, uint64(8000), gov.GetChainStatus()[0].NotionalUsage)
+
+	// exceeds the daily limit
+	assert.False(t, gov.ProcessMsg(transferMsg(2, 3*oneToken)))
+	assert.False(t, gov.ProcessMsg(transferMsg(2, 3*oneToken)))
+	pending := gov.GetPendingTransfers()
+	assert.Equal(t, 1, len(pending))
+	assert.Equal(t, transferMsg(2, 0).MessageIDString(), pending[0].MsgID)
+	assert.Equal(t, uint64(3000), pending[0].Value)
+	assert.Equal(t, 0, len(gov.CheckPending()))
+
+	// the first transfers leave the window
+	now = now.Add(transferWindow)
+	released := gov.CheckPending()
+	assert.Equal(t, 1, len(released))
+	assert.Equal(t, uint64(2), released[0].Sequence)
+	assert.Equal(t, 0, len(gov.GetPendingTransfers()))
+	assert.Equal(t, uint64(3000), gov.GetChainStatus()[0].NotionalUsage)
+}
+
+func TestBigTransaction(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+	now := time.Unix(1654516425, 0)
+	gov := newTestGovernor(t, database, &now, "1h")
+
+	assert.False(t, gov.ProcessMsg(transferMsg(0, 5*oneToken)))
+	assert.Equal(t, 0, len(gov.CheckPending()))
+
+	now = now.Add(59 * time.Minute)
+	assert.Equal(t, 0, len(gov.CheckPending()))
+
+	now = now.Add(time.Minute)
+	released := gov.CheckPending()
+	assert.Equal(t, 1, len(released))
+	assert.Equal(t, uint64(5000), gov.GetChainStatus()[0].NotionalUsage)
+}
+
+func TestReleaseAndDropPendingTransfers(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+	now := time.Unix(1654516425, 0)
+	gov := newTestGovernor(t, database, &now, "1h")
+
+	assert.False(t, gov.ProcessMsg(transferMsg(0, 6*oneToken)))
+	assert.False(t, gov.ProcessMsg(transferMsg(1, 7*oneToken)))
+
+	assert.NotNil(t, gov.ReleasePendingTransfer("invalid"))
+	assert.NotNil(t, gov.DropPendingTransfer("invalid"))
+
+	assert.Nil(t, gov.DropPendingTransfer(transferMsg(0, 0).MessageIDString()))
+	assert.Nil(t, gov.ReleasePendingTransfer(transferMsg(1, 0).MessageIDString()))
+
+	// The release survives a restart
+	gov = newTestGovernor(t, database, &now, "1h")
+	pending := gov.GetPendingTransfers()
+	assert.Equal(t, 1, len(pending))
+	assert.Equal(t, now.Unix(), pending[0].ReleaseTime.Unix())
+
+	released := gov.CheckPending()
+	assert.Equal(t, 1, len(released))
+	assert.Equal(t, uint64(1), released[0].Sequence)
+	assert.Equal(t, 0, len(gov.GetPendingTransfers()))
+}
+
+func TestReloadFromDB(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+	now := time.Unix(1654516425, 0)
+	gov := newTestGovernor(t, database, &now, "1h")
+
+	assert.True(t, gov.ProcessMsg(transferMsg(0, 4*oneToken)))
+	assert.False(t, gov.ProcessMsg(transferMsg(1, 7*oneToken)))
+
+	gov = newTestGovernor(t, database, &now, "1h")
+	assert.Equal(t, uint64(4000), gov.GetChainStatus()[0].NotionalUsage)
+	pending := gov.GetPendingTransfers()
+	assert.Equal(t, 1, len(pending))
+	assert.Equal(t, uint64(7000), pending[0].Value)
+	assert.Equal(t, now.Add(time.Hour).Unix(), pending[0].ReleaseTime.Unix())
+	assert.True(t, gov.ProcessMsg(transferMsg(0, 4*oneToken)))
+
+	released := gov.CheckPending()
+	assert.Equal(t, 0, len(released))
+	now = now.Add(time.Hour)
+	released = gov.CheckPending()
+	assert.Equal(t, 1, len(released))
+	assert.Equal(t, transferMsg(1, 7*oneToken).Payload, released[0].Payload)
+}
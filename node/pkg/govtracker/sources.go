@@ -0,0 +1,100 @@
+package govtracker
+
+import (
+	"context"
+	"fmt"
+
+	ethbind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/alephium/wormhole-fork/node/pkg/chainreader"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+// evmSource reads the governance state of the EVM core contract and token bridge, which record executed governance
+// VAAs by hash.
+type evmSource struct {
+	*chainreader.EvmClient
+}
+
+func NewEvmSource(client *chainreader.EvmClient) Source {
+	return &evmSource{client}
+}
+
+func (s *evmSource) State(ctx context.Context) (*ChainState, error) {
+	core, err := s.Core(ctx)
+	if err != nil {
+		return nil, err
+	}
+	opts := &ethbind.CallOpts{Context: ctx}
+	index, err := core.GetCurrentGuardianSetIndex(opts)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting current guardian set index: %w", err)
+	}
+	messageFee, err := core.MessageFee(opts)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting message fee: %w", err)
+	}
+	return &ChainState{GuardianSetIndex: index, MessageFee: messageFee}, nil
+}
+
+func (s *evmSource) Executed(ctx context.Context, state *ChainState, v *vaa.VAA) (bool, error) {
+	tokenBridgeAction, err := isTokenBridgeAction(v.Payload)
+	if err != nil {
+		return false, err
+	}
+	opts := &ethbind.CallOpts{Context: ctx}
+	if tokenBridgeAction {
+		bridge, err := s.TokenBridge(ctx)
+		if err != nil {
+			return false, err
+		}
+		return bridge.GovernanceActionIsConsumed(opts, v.SigningMsg())
+	}
+	core, err := s.Core(ctx)
+	if err != nil {
+		return false, err
+	}
+	return core.GovernanceActionIsConsumed(opts, v.SigningMsg())
+}
+
+// alephiumSource reads the governance state of the Alephium governance contract and token bridge, which only accept
+// governance VAAs with sequences from their received sequence on.
+type alephiumSource struct {
+	*chainreader.AlephiumClient
+}
+
+func NewAlephiumSource(client *chainreader.AlephiumClient) Source {
+	return &alephiumSource{client}
+}
+
+func (s *alephiumSource) ChainID() vaa.ChainID {
+	return vaa.ChainIDAlephium
+}
+
+func (s *alephiumSource) State(ctx context.Context) (*ChainState, error) {
+	governance, err := s.GetGovernanceState(ctx, s.Governance, int32(s.GroupIndex))
+	if err != nil {
+		return nil, fmt.Errorf("error requesting governance contract state: %w", err)
+	}
+	tokenBridgeSequence, err := s.GetTokenBridgeReceivedSequence(ctx, s.TokenBridge, int32(s.GroupIndex))
+	if err != nil {
+		return nil, fmt.Errorf("error requesting token bridge contract state: %w", err)
+	}
+	return &ChainState{
+		GuardianSetIndex:    governance.GuardianSetIndex,
+		MessageFee:          governance.MessageFee,
+		CoreSequence:        governance.ReceivedSequence,
+		TokenBridgeSequence: tokenBridgeSequence,
+	}, nil
+}
+
+func (s *alephiumSource) Executed(ctx context.Context, state *ChainState, v *vaa.VAA) (bool, error) {
+	tokenBridgeAction, err := isTokenBridgeAction(v.Payload)
+	if err != nil {
+		return false, err
+	}
+	if tokenBridgeAction {
+		return v.Sequence < state.TokenBridgeSequence, nil
+	}
+	return v.Sequence < state.CoreSequence, nil
+}
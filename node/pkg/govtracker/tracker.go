@@ -0,0 +1,282 @@
+// Package govtracker checks whether the governance VAAs stored in the database were executed on their target chains.
+//
+// A governance VAA only takes effect once it is submitted to the contracts of its target chain, or of every chain if
+// it doesn't target a specific one. The tracker reads the governance state of the contracts of each chain and records,
+// for every governance VAA and target chain, whether it was executed or is still pending. Executed VAAs are not queried
+// again.
+package govtracker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+
+	"github.com/alephium/wormhole-fork/node/pkg/chainreader"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+var (
+	governanceVAAsPending = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_governance_vaas_pending",
+			Help: "Number of governance VAAs not executed yet on a chain",
+		}, []string{"chain"})
+	governanceQueryErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_governance_tracker_query_errors_total",
+			Help: "Total number of errors reading the governance state of a chain",
+		}, []string{"chain"})
+)
+
+// ChainState is the governance state of the contracts of a chain.
+type ChainState struct {
+	GuardianSetIndex uint32
+	MessageFee       *big.Int
+	// CoreSequence and TokenBridgeSequence are the next governance sequences accepted by the governance and token
+	// bridge contracts, zero on chains which track executed governance VAAs by hash.
+	CoreSequence        uint64
+	TokenBridgeSequence uint64
+}
+
+// Source reads the governance state of the contracts of a chain.
+type Source interface {
+	ChainID() vaa.ChainID
+	State(ctx context.Context) (*ChainState, error)
+	// Executed returns whether the governance VAA was executed on the chain, or can't be executed anymore, given the
+	// state read in the same check.
+	Executed(ctx context.Context, state *ChainState, v *vaa.VAA) (bool, error)
+}
+
+// ChainStatus is the most recent view of the governance state of a chain.
+type ChainStatus struct {
+	ChainID vaa.ChainID
+	// State is the last state read from the chain, nil if it was never read.
+	State *ChainState
+	// Error is the error of the last query, empty if it succeeded.
+	Error     string
+	CheckedAt time.Time
+}
+
+// Execution is the status of a governance VAA on one of its target chains.
+type Execution struct {
+	ChainID  vaa.ChainID
+	Executed bool
+	// Error is the error of the last query, empty if it succeeded.
+	Error string
+}
+
+// GovernanceVAA is the most recent view of the execution of a governance VAA.
+type GovernanceVAA struct {
+	Sequence    uint64
+	TargetChain vaa.ChainID
+	Digest      string
+	Action      string
+	// Executions has an entry for each target chain of the VAA with a source, in the order of the sources.
+	Executions []*Execution
+}
+
+type Tracker struct {
+	logger            *zap.Logger
+	db                *db.Database
+	governanceChainId vaa.ChainID
+	governanceEmitter vaa.Address
+	sources           []Source
+	interval          time.Duration
+
+	snapshot *chainreader.Snapshot[snapshot]
+}
+
+// snapshot is the result of a check.
+type snapshot struct {
+	chains []*ChainStatus
+	vaas   []*GovernanceVAA
+}
+
+// NewTracker creates a tracker of the governance VAAs of the governance emitter stored in the database.
+func NewTracker(logger *zap.Logger, database *db.Database, governanceChainId vaa.ChainID, governanceEmitter vaa.Address, sources []Source, interval time.Duration) *Tracker {
+	chains := make([]*ChainStatus, len(sources))
+	for i, s := range sources {
+		chains[i] = &ChainStatus{ChainID: s.ChainID()}
+	}
+	return &Tracker{
+		logger:            logger,
+		db:                database,
+		governanceChainId: governanceChainId,
+		governanceEmitter: governanceEmitter,
+		sources:           sources,
+		interval:          interval,
+		snapshot:          chainreader.NewSnapshot(snapshot{chains: chains}),
+	}
+}
+
+// Run checks the governance VAAs every interval until ctx is done.
+func (t *Tracker) Run(ctx context.Context) error {
+	return chainreader.Run(ctx, t.interval, func(ctx context.Context) {
+		if err := t.check(ctx); err != nil {
+			t.logger.Error("failed to check governance VAAs", zap.Error(err))
+		}
+	})
+}
+
+func (t *Tracker) check(ctx context.Context) error {
+	previousChains, previousVAAs := t.Statuses()
+
+	chains := make([]*ChainStatus, len(t.sources))
+	for i, source := range t.sources {
+		chain := source.ChainID().String()
+		status := &ChainStatus{ChainID: source.ChainID(), CheckedAt: time.Now()}
+
+		state, err := chainreader.Query(ctx, source.State)
+		if err != nil {
+			t.logger.Warn("failed to read governance state", zap.String("chain", chain), zap.Error(err))
+			governanceQueryErrors.WithLabelValues(chain).Inc()
+			status.Error = err.Error()
+			status.State = previousChains[i].State
+		} else {
+			status.State = state
+		}
+		chains[i] = status
+	}
+
+	var stored []*vaa.VAA
+	if err := t.db.IterateSignedVAAs(t.governanceChainId, t.governanceEmitter, func(v *vaa.VAA) error {
+		stored = append(stored, v)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to read governance VAAs: %w", err)
+	}
+	sort.Slice(stored, func(i, j int) bool {
+		if stored[i].TargetChain != stored[j].TargetChain {
+			return stored[i].TargetChain < stored[j].TargetChain
+		}
+		return stored[i].Sequence < stored[j].Sequence
+	})
+
+	previous := make(map[string]*GovernanceVAA, len(previousVAAs))
+	for _, g := range previousVAAs {
+		previous[g.Digest] = g
+	}
+
+	pending := make(map[vaa.ChainID]int)
+	vaas := make([]*GovernanceVAA, len(stored))
+	for i, v := range stored {
+		g := &GovernanceVAA{
+			Sequence:    v.Sequence,
+			TargetChain: v.TargetChain,
+			Digest:      v.HexDigest(),
+			Action:      actionName(v.Payload),
+		}
+		for j, source := range t.sources {
+			if v.TargetChain != vaa.ChainIDUnset && v.TargetChain != source.ChainID() {
+				continue
+			}
+			execution := t.execution(ctx, source, chains[j], previous[g.Digest], v)
+			if !execution.Executed {
+				pending[source.ChainID()]++
+			}
+			g.Executions = append(g.Executions, execution)
+		}
+		vaas[i] = g
+	}
+	for _, source := range t.sources {
+		governanceVAAsPending.WithLabelValues(source.ChainID().String()).Set(float64(pending[source.ChainID()]))
+	}
+
+	t.snapshot.Store(snapshot{chains: chains, vaas: vaas})
+	return nil
+}
+
+// execution checks whether v was executed on the chain of source. Executions are final, so VAAs which were executed
+// in a previous check are not queried again.
+func (t *Tracker) execution(ctx context.Context, source Source, chain *ChainStatus, previous *GovernanceVAA, v *vaa.VAA) *Execution {
+	execution := &Execution{ChainID: source.ChainID()}
+	if previous != nil {
+		for _, e := range previous.Executions {
+			if e.ChainID == source.ChainID() && e.Executed {
+				execution.Executed = true
+				return execution
+			}
+		}
+	}
+	if chain.Error != "" || chain.State == nil {
+		execution.Error = chain.Error
+		return execution
+	}
+
+	executed, err := chainreader.Query(ctx, func(ctx context.Context) (bool, error) {
+		return source.Executed(ctx, chain.State, v)
+	})
+	if err != nil {
+		t.logger.Warn("failed to check governance VAA execution",
+			zap.String("chain", source.ChainID().String()),
+			zap.String("digest", v.HexDigest()),
+			zap.Error(err))
+		governanceQueryErrors.WithLabelValues(source.ChainID().String()).Inc()
+		execution.Error = err.Error()
+		return execution
+	}
+	if executed {
+		t.logger.Info("governance VAA executed",
+			zap.String("chain", source.ChainID().String()),
+			zap.Uint64("sequence", v.Sequence),
+			zap.String("digest", v.HexDigest()))
+	}
+	execution.Executed = executed
+	return execution
+}
+
+// Statuses returns the most recent view of each chain, in the order of the sources, and of each governance VAA,
+// ordered by target chain and sequence.
+func (t *Tracker) Statuses() ([]*ChainStatus, []*GovernanceVAA) {
+	s := t.snapshot.Load()
+	return s.chains, s.vaas
+}
+
+// isTokenBridgeAction returns whether the governance payload is executed by the token bridge, or by the core contract
+// otherwise.
+func isTokenBridgeAction(payload []byte) (bool, error) {
+	if len(payload) >= 32 && bytes.Equal(payload[:32], vaa.CoreModule) {
+		return false, nil
+	}
+	if len(payload) >= 32 && bytes.Equal(payload[:32], vaa.TokenBridgeModule) {
+		return true, nil
+	}
+	return false, fmt.Errorf("unknown governance module")
+}
+
+// actionName returns the name of the governance action of the payload.
+func actionName(payload []byte) string {
+	body, err := vaa.DecodePayload(payload)
+	if err != nil {
+		return "Unknown"
+	}
+	switch body.(type) {
+	case *vaa.BodyContractUpgrade:
+		return "ContractUpgrade"
+	case *vaa.BodyGuardianSetUpgrade:
+		return "GuardianSetUpgrade"
+	case *vaa.BodyUpdateMessageFee:
+		return "UpdateMessageFee"
+	case *vaa.BodyTransferFee:
+		return "TransferFee"
+	case *vaa.BodyTokenBridgeRegisterChain:
+		return "RegisterChain"
+	case *vaa.BodyTokenBridgeUpgradeContract:
+		return "TokenBridgeUpgradeContract"
+	case *vaa.BodyTokenBridgeDestroyContracts:
+		return "DestroyUnexecutedSequenceContracts"
+	case *vaa.BodyTokenBridgeUpdateMinimalConsistencyLevel:
+		return "UpdateMinimalConsistencyLevel"
+	case *vaa.BodyTokenBridgeUpdateRefundAddress:
+		return "UpdateRefundAddress"
+	}
+	return "Unknown"
+}
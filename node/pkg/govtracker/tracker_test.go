@@ -0,0 +1,114 @@
+package govtracker
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+var governanceEmitter = vaa.Address{4}
+
+// sequenceSource executes the governance VAAs with a sequence lower than its state, like the Alephium contracts.
+type sequenceSource struct {
+	chainID vaa.ChainID
+	state   ChainState
+	err     error
+	queries int
+}
+
+func (s *sequenceSource) ChainID() vaa.ChainID {
+	return s.chainID
+}
+
+func (s *sequenceSource) State(ctx context.Context) (*ChainState, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	state := s.state
+	return &state, nil
+}
+
+func (s *sequenceSource) Executed(ctx context.Context, state *ChainState, v *vaa.VAA) (bool, error) {
+	s.queries++
+	tokenBridgeAction, err := isTokenBridgeAction(v.Payload)
+	if err != nil {
+		return false, err
+	}
+	if tokenBridgeAction {
+		return v.Sequence < state.TokenBridgeSequence, nil
+	}
+	return v.Sequence < state.CoreSequence, nil
+}
+
+func storeGovernanceVAA(t *testing.T, database *db.Database, sequence uint64, targetChain vaa.ChainID, payload vaa.Payload) {
+	v := vaa.CreateGovernanceVAA(vaa.ChainIDUnset, governanceEmitter, time.Unix(0, 0), 0, sequence, targetChain, 0, payload.Serialize())
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	v.AddSignature(key, 0)
+	require.NoError(t, database.StoreSignedVAA(v))
+}
+
+func TestActionName(t *testing.T) {
+	assert.Equal(t, "UpdateMessageFee", actionName((&vaa.BodyUpdateMessageFee{NewMessageFee: make([]byte, 32)}).Serialize()))
+	assert.Equal(t, "RegisterChain", actionName((&vaa.BodyTokenBridgeRegisterChain{Module: "TokenBridge", ChainID: vaa.ChainIDEthereum}).Serialize()))
+	assert.Equal(t, "Unknown", actionName([]byte{1, 2, 3}))
+}
+
+func TestTrackerCheck(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	storeGovernanceVAA(t, database, 0, vaa.ChainIDAlephium, &vaa.BodyUpdateMessageFee{NewMessageFee: make([]byte, 32)})
+	storeGovernanceVAA(t, database, 1, vaa.ChainIDUnset, &vaa.BodyTokenBridgeRegisterChain{Module: "TokenBridge", ChainID: vaa.ChainIDBSC})
+	storeGovernanceVAA(t, database, 2, vaa.ChainIDEthereum, &vaa.BodyUpdateMessageFee{NewMessageFee: make([]byte, 32)})
+
+	alephium := &sequenceSource{chainID: vaa.ChainIDAlephium, state: ChainState{MessageFee: big.NewInt(1), CoreSequence: 1}}
+	ethereum := &sequenceSource{chainID: vaa.ChainIDEthereum, state: ChainState{MessageFee: big.NewInt(0), CoreSequence: 3, TokenBridgeSequence: 2}}
+	tracker := NewTracker(zap.NewNop(), database, vaa.ChainIDUnset, governanceEmitter, []Source{alephium, ethereum}, 0)
+
+	require.NoError(t, tracker.check(context.Background()))
+	chains, vaas := tracker.Statuses()
+	assert.Equal(t, 2, len(chains))
+	assert.Equal(t, big.NewInt(1), chains[0].State.MessageFee)
+	require.Equal(t, 3, len(vaas))
+
+	// Ordered by target chain, the VAA without target chain is tracked on every chain
+	assert.Equal(t, vaa.ChainIDUnset, vaas[0].TargetChain)
+	assert.Equal(t, "RegisterChain", vaas[0].Action)
+	assert.Equal(t, []*Execution{
+		{ChainID: vaa.ChainIDAlephium, Executed: false},
+		{ChainID: vaa.ChainIDEthereum, Executed: true},
+	}, vaas[0].Executions)
+	assert.Equal(t, vaa.ChainIDEthereum, vaas[1].TargetChain)
+	assert.Equal(t, []*Execution{{ChainID: vaa.ChainIDEthereum, Executed: true}}, vaas[1].Executions)
+	assert.Equal(t, vaa.ChainIDAlephium, vaas[2].TargetChain)
+	assert.Equal(t, []*Execution{{ChainID: vaa.ChainIDAlephium, Executed: true}}, vaas[2].Executions)
+
+	// Failed queries keep the last known state and executed VAAs are not queried again
+	alephium.err = errors.New("unavailable")
+	queries := ethereum.queries
+	require.NoError(t, tracker.check(context.Background()))
+	chains, vaas = tracker.Statuses()
+	assert.Equal(t, "unavailable", chains[0].Error)
+	assert.NotNil(t, chains[0].State)
+	assert.Equal(t, &Execution{ChainID: vaa.ChainIDAlephium, Error: "unavailable"}, vaas[0].Executions[0])
+	assert.True(t, vaas[2].Executions[0].Executed)
+	assert.Equal(t, queries, ethereum.queries)
+
+	alephium.err = nil
+	alephium.state.TokenBridgeSequence = 2
+	require.NoError(t, tracker.check(context.Background()))
+	_, vaas = tracker.Statuses()
+	assert.True(t, vaas[0].Executions[0].Executed)
+}
@@ -0,0 +1,165 @@
+// Package gsmonitor checks that the core contracts of all chains are on the same guardian set.
+//
+// The guardian set is only upgraded on a chain once its guardian set upgrade VAA is submitted there, so an incomplete
+// governance rollout leaves chains on different guardian sets. The guardian sets are compared with the one of the
+// reference chain, the first source, which is the chain the guardian reads its own guardian set from. Mismatches are
+// also sent to the notifier.
+package gsmonitor
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+
+	"github.com/alephium/wormhole-fork/node/pkg/chainreader"
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/notify/discord"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+var (
+	chainGuardianSetIndex = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_chain_guardian_set_index",
+			Help: "Current guardian set index of the core contract of each chain",
+		}, []string{"chain"})
+	chainGuardianSetMismatch = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_chain_guardian_set_mismatch",
+			Help: "Whether the guardian set of a chain differs from the one of the reference chain (1) or not (0)",
+		}, []string{"chain"})
+	chainGuardianSetQueryErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_chain_guardian_set_query_errors_total",
+			Help: "Total number of errors reading the guardian set of a chain",
+		}, []string{"chain"})
+)
+
+// Source reads the current guardian set of the core contract of a chain.
+type Source interface {
+	ChainID() vaa.ChainID
+	CurrentGuardianSet(ctx context.Context) (*common.GuardianSet, error)
+}
+
+// ChainStatus is the most recent view of the guardian set of a chain.
+type ChainStatus struct {
+	ChainID vaa.ChainID
+	// GuardianSet is the last guardian set read from the chain, nil if it was never read.
+	GuardianSet *common.GuardianSet
+	// Error is the error of the last query, empty if it succeeded.
+	Error string
+	// Mismatch describes how GuardianSet differs from the guardian set of the reference chain, empty if it matches.
+	Mismatch  string
+	CheckedAt time.Time
+}
+
+type Monitor struct {
+	logger   *zap.Logger
+	sources  []Source
+	notifier *discord.DiscordNotifier
+	interval time.Duration
+
+	statuses *chainreader.Snapshot[[]*ChainStatus]
+}
+
+// NewMonitor creates a monitor of the guardian sets of sources, the first of which is the reference chain. The notifier
+// may be nil.
+func NewMonitor(logger *zap.Logger, sources []Source, notifier *discord.DiscordNotifier, interval time.Duration) *Monitor {
+	statuses := make([]*ChainStatus, len(sources))
+	for i, s := range sources {
+		statuses[i] = &ChainStatus{ChainID: s.ChainID()}
+	}
+	return &Monitor{
+		logger:   logger,
+		sources:  sources,
+		notifier: notifier,
+		interval: interval,
+		statuses: chainreader.NewSnapshot(statuses),
+	}
+}
+
+// Run checks the guardian sets every interval until ctx is done.
+func (m *Monitor) Run(ctx context.Context) error {
+	return chainreader.Run(ctx, m.interval, m.check)
+}
+
+func (m *Monitor) check(ctx context.Context) {
+	previous := m.Statuses()
+	statuses := make([]*ChainStatus, len(m.sources))
+	for i, source := range m.sources {
+		chain := source.ChainID().String()
+		status := &ChainStatus{ChainID: source.ChainID(), CheckedAt: time.Now()}
+
+		gs, err := chainreader.Query(ctx, source.CurrentGuardianSet)
+		if err != nil {
+			m.logger.Warn("failed to read guardian set", zap.String("chain", chain), zap.Error(err))
+			chainGuardianSetQueryErrors.WithLabelValues(chain).Inc()
+			status.Error = err.Error()
+			// Keep comparing the last known guardian set.
+			status.GuardianSet = previous[i].GuardianSet
+		} else {
+			chainGuardianSetIndex.WithLabelValues(chain).Set(float64(gs.Index))
+			status.GuardianSet = gs
+		}
+		statuses[i] = status
+	}
+
+	reference := statuses[0]
+	for i, status := range statuses {
+		if status.GuardianSet == nil || reference.GuardianSet == nil {
+			continue
+		}
+		chain := status.ChainID.String()
+		status.Mismatch = compareGuardianSets(status.GuardianSet, reference.GuardianSet)
+		if status.Mismatch == "" {
+			chainGuardianSetMismatch.WithLabelValues(chain).Set(0)
+			if previous[i].Mismatch != "" {
+				m.logger.Info("guardian set mismatch resolved", zap.String("chain", chain), zap.Uint32("index", status.GuardianSet.Index))
+			}
+			continue
+		}
+
+		chainGuardianSetMismatch.WithLabelValues(chain).Set(1)
+		if previous[i].Mismatch == status.Mismatch {
+			continue
+		}
+		m.logger.Error("guardian set mismatch",
+			zap.String("chain", chain),
+			zap.String("reference_chain", reference.ChainID.String()),
+			zap.String("mismatch", status.Mismatch))
+		if m.notifier != nil {
+			go func(chain, referenceChain, mismatch string) {
+				if err := m.notifier.GuardianSetMismatch(chain, referenceChain, mismatch); err != nil {
+					m.logger.Error("failed to send notification", zap.Error(err))
+				}
+			}(chain, reference.ChainID.String(), status.Mismatch)
+		}
+	}
+
+	m.statuses.Store(statuses)
+}
+
+// Statuses returns the most recent view of each chain, the reference chain first.
+func (m *Monitor) Statuses() []*ChainStatus {
+	return m.statuses.Load()
+}
+
+// compareGuardianSets describes how gs differs from reference, or returns an empty string if they match.
+func compareGuardianSets(gs *common.GuardianSet, reference *common.GuardianSet) string {
+	if gs.Index != reference.Index {
+		return fmt.Sprintf("guardian set index %d, reference chain is on %d", gs.Index, reference.Index)
+	}
+	if len(gs.Keys) != len(reference.Keys) {
+		return fmt.Sprintf("guardian set %d has %d guardians, %d on the reference chain", gs.Index, len(gs.Keys), len(reference.Keys))
+	}
+	for i, key := range gs.Keys {
+		if key != reference.Keys[i] {
+			return fmt.Sprintf("guardian %d of guardian set %d is %s, %s on the reference chain", i, gs.Index, key.Hex(), reference.Keys[i].Hex())
+		}
+	}
+	return ""
+}
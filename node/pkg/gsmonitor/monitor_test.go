@@ -0,0 +1,69 @@
+package gsmonitor
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+type staticSource struct {
+	chainID vaa.ChainID
+	gs      *common.GuardianSet
+	err     error
+}
+
+func (s *staticSource) ChainID() vaa.ChainID {
+	return s.chainID
+}
+
+func (s *staticSource) CurrentGuardianSet(ctx context.Context) (*common.GuardianSet, error) {
+	return s.gs, s.err
+}
+
+func TestCompareGuardianSets(t *testing.T) {
+	reference := &common.GuardianSet{Index: 1, Keys: []ethcommon.Address{{1}, {2}}}
+
+	assert.Empty(t, compareGuardianSets(&common.GuardianSet{Index: 1, Keys: []ethcommon.Address{{1}, {2}}}, reference))
+	assert.Equal(t, "guardian set index 0, reference chain is on 1",
+		compareGuardianSets(&common.GuardianSet{Index: 0, Keys: []ethcommon.Address{{1}, {2}}}, reference))
+	assert.Equal(t, "guardian set 1 has 1 guardians, 2 on the reference chain",
+		compareGuardianSets(&common.GuardianSet{Index: 1, Keys: []ethcommon.Address{{1}}}, reference))
+	assert.Contains(t, compareGuardianSets(&common.GuardianSet{Index: 1, Keys: []ethcommon.Address{{1}, {3}}}, reference),
+		"guardian 1 of guardian set 1")
+}
+
+func TestMonitorCheck(t *testing.T) {
+	gs := &common.GuardianSet{Index: 1, Keys: []ethcommon.Address{{1}, {2}}}
+	ethereum := &staticSource{chainID: vaa.ChainIDEthereum, gs: gs}
+	bsc := &staticSource{chainID: vaa.ChainIDBSC, gs: &common.GuardianSet{Index: 0, Keys: []ethcommon.Address{{1}}}}
+	alephium := &staticSource{chainID: vaa.ChainIDAlephium, gs: gs}
+	m := NewMonitor(zap.NewNop(), []Source{ethereum, bsc, alephium}, nil, 0)
+
+	m.check(context.Background())
+	statuses := m.Statuses()
+	assert.Equal(t, 3, len(statuses))
+	assert.Empty(t, statuses[0].Mismatch)
+	assert.Equal(t, "guardian set index 0, reference chain is on 1", statuses[1].Mismatch)
+	assert.Empty(t, statuses[2].Mismatch)
+
+	// Failed queries keep the last known guardian set
+	bsc.gs, bsc.err = nil, errors.New("unavailable")
+	m.check(context.Background())
+	statuses = m.Statuses()
+	assert.Equal(t, "unavailable", statuses[1].Error)
+	assert.Equal(t, uint32(0), statuses[1].GuardianSet.Index)
+	assert.NotEmpty(t, statuses[1].Mismatch)
+
+	bsc.gs, bsc.err = gs, nil
+	m.check(context.Background())
+	statuses = m.Statuses()
+	assert.Empty(t, statuses[1].Error)
+	assert.Empty(t, statuses[1].Mismatch)
+}
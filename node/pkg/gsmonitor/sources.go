@@ -0,0 +1,55 @@
+package gsmonitor
+
+import (
+	"context"
+	"fmt"
+
+	ethbind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/alephium/wormhole-fork/node/pkg/chainreader"
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+// evmSource reads the guardian set of an EVM core contract.
+type evmSource struct {
+	*chainreader.EvmClient
+}
+
+func NewEvmSource(client *chainreader.EvmClient) Source {
+	return &evmSource{client}
+}
+
+func (s *evmSource) CurrentGuardianSet(ctx context.Context) (*common.GuardianSet, error) {
+	core, err := s.Core(ctx)
+	if err != nil {
+		return nil, err
+	}
+	opts := &ethbind.CallOpts{Context: ctx}
+	index, err := core.GetCurrentGuardianSetIndex(opts)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting current guardian set index: %w", err)
+	}
+	gs, err := core.GetGuardianSet(opts, index)
+	if err != nil {
+		return nil, fmt.Errorf("error requesting current guardian set value: %w", err)
+	}
+	return &common.GuardianSet{Keys: gs.Keys, Index: index}, nil
+}
+
+// alephiumSource reads the guardian set from the state of the Alephium governance contract.
+type alephiumSource struct {
+	*chainreader.AlephiumClient
+}
+
+func NewAlephiumSource(client *chainreader.AlephiumClient) Source {
+	return &alephiumSource{client}
+}
+
+func (s *alephiumSource) ChainID() vaa.ChainID {
+	return vaa.ChainIDAlephium
+}
+
+func (s *alephiumSource) CurrentGuardianSet(ctx context.Context) (*common.GuardianSet, error) {
+	return s.GetCurrentGuardianSet(ctx, s.Governance, int32(s.GroupIndex))
+}
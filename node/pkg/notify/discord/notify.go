@@ -145,3 +145,71 @@ func (d *DiscordNotifier) MissingSignaturesOnTransaction(v *vaa.VAA, hasSigs, wa
 
 	return nil
 }
+
+func (d *DiscordNotifier) ConflictingObservations(messageId string, guardian string, digests []string) error {
+	digestsText := &bytes.Buffer{}
+	for _, digest := range digests {
+		if _, err := fmt.Fprintf(digestsText, "- %s\n", wrapCode(digest)); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, cn := range d.chans {
+		if _, err := d.c.SendMessage(cn.ID, "🚨️ **CONFLICTING OBSERVATIONS** - a guardian signed different digests for the same message @here",
+			discord.Embed{
+				Title: "Conflicting observations",
+				Fields: []discord.EmbedField{
+					{Name: "Message ID", Value: wrapCode(messageId), Inline: true},
+					{Name: "Guardian", Value: wrapCode(guardian), Inline: true},
+					{Name: "Digests", Value: digestsText.String(), Inline: false},
+				},
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *DiscordNotifier) GuardianSetMismatch(chain string, referenceChain string, reason string) error {
+	for _, cn := range d.chans {
+		if _, err := d.c.SendMessage(cn.ID, "🚨️ **GUARDIAN SET MISMATCH** - the core contracts are on different guardian sets @here",
+			discord.Embed{
+				Title: "Guardian set mismatch",
+				Fields: []discord.EmbedField{
+					{Name: "Chain", Value: strings.Title(chain), Inline: true},
+					{Name: "Reference Chain", Value: strings.Title(referenceChain), Inline: true},
+					{Name: "Mismatch", Value: reason, Inline: false},
+				},
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *DiscordNotifier) TokenBridgeRegistrationMismatch(chain string, emitterChain string, status string, expected string, registered string) error {
+	if registered == "" {
+		registered = "none"
+	}
+	for _, cn := range d.chans {
+		if _, err := d.c.SendMessage(cn.ID, "🚨️ **TOKEN BRIDGE REGISTRATION MISMATCH** - transfers between the chains will fail @here",
+			discord.Embed{
+				Title: "Token bridge registration " + status,
+				Fields: []discord.EmbedField{
+					{Name: "Chain", Value: strings.Title(chain), Inline: true},
+					{Name: "Registered Chain", Value: strings.Title(emitterChain), Inline: true},
+					{Name: "Expected Emitter", Value: wrapCode(expected), Inline: false},
+					{Name: "Registered Emitter", Value: wrapCode(registered), Inline: false},
+				},
+			},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
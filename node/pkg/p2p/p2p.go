@@ -213,6 +213,7 @@ func Run(obsvC chan *gossipv1.SignedObservation, obsvReqC chan *gossipv1.Observa
 						Version:       version.Version(),
 						GuardianAddr:  DefaultRegistry.guardianAddress,
 						BootTimestamp: bootTime.UnixNano(),
+						SigningPauses: DefaultRegistry.signingPauses,
 					}
 
 					ourAddr := ethcrypto.PubkeyToAddress(guardianSigner.PublicKey())
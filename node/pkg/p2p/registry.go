@@ -20,6 +20,9 @@ type registry struct {
 
 	// Value of Heartbeat.guardian_addr.
 	guardianAddress string
+
+	// Value of Heartbeat.signing_pauses.
+	signingPauses []*gossipv1.SigningPause
 }
 
 func NewRegistry() *registry {
@@ -41,6 +44,13 @@ func (r *registry) SetGuardianAddress(addr string) {
 	r.mu.Unlock()
 }
 
+// SetSigningPauses sets the signing pauses to broadcast in Heartbeat messages.
+func (r *registry) SetSigningPauses(pauses []*gossipv1.SigningPause) {
+	r.mu.Lock()
+	r.signingPauses = pauses
+	r.mu.Unlock()
+}
+
 // SetNetworkStats sets the current network status to be broadcast in Heartbeat messages.
 // The "Id" field is automatically set to the specified chain ID.
 func (r *registry) SetNetworkStats(chain vaa.ChainID, data *gossipv1.Heartbeat_Network) {
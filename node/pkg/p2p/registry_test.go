@@ -23,6 +23,13 @@ func TestSetGuardianAddress(t *testing.T) {
 	assert.Equal(t, "foo", registry.guardianAddress)
 }
 
+func TestSetSigningPauses(t *testing.T) {
+	registry := NewRegistry()
+	pauses := []*gossipv1.SigningPause{{Scope: gossipv1.SigningPause_SCOPE_EMITTER_CHAIN, ChainId: uint32(vaa.ChainIDEthereum)}}
+	registry.SetSigningPauses(pauses)
+	assert.Equal(t, pauses, registry.signingPauses)
+}
+
 func TestSetNetworkStats(t *testing.T) {
 	registry := NewRegistry()
 
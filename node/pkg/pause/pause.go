@@ -0,0 +1,206 @@
+// Package pause allows operators to stop a guardian from signing the messages of a compromised chain or emitter
+// without shutting the node down.
+//
+// A pause covers an emitter chain, a target chain or a single emitter. Pauses are persisted in the local database,
+// broadcast in heartbeats so that operators can coordinate a network-wide halt, and stay in effect until they
+// are resumed. Messages observed while paused are dropped and have to be re-observed once signing is resumed.
+package pause
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/p2p"
+	gossipv1 "github.com/alephium/wormhole-fork/node/pkg/proto/gossip/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+var (
+	signingPaused = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_signing_paused",
+			Help: "Set to 1 for each emitter chain, target chain or emitter whose messages are not signed",
+		}, []string{"scope", "chain", "emitter_address"})
+
+	messagesPausedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_signing_paused_messages_total",
+			Help: "Total number of messages which were not signed because signing is paused",
+		}, []string{"emitter_chain"})
+)
+
+var ErrNotPaused = errors.New("signing is not paused")
+
+type Controller struct {
+	db     *db.Database
+	logger *zap.Logger
+
+	mutex  sync.Mutex
+	pauses map[string]*db.SigningPause
+}
+
+// NewController creates a controller with the pauses stored in the database.
+func NewController(logger *zap.Logger, database *db.Database) (*Controller, error) {
+	stored, err := database.GetSigningPauses()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load signing pauses: %w", err)
+	}
+
+	c := &Controller{
+		db:     database,
+		logger: logger,
+		pauses: make(map[string]*db.SigningPause),
+	}
+	for _, p := range stored {
+		c.pauses[p.ID()] = p
+		c.logger.Warn("signing is paused", zap.String("pause", p.ID()), zap.String("reason", p.Reason), zap.Time("pausedAt", p.PausedAt))
+	}
+	c.update()
+	return c, nil
+}
+
+// FromProto validates a pause received through the admin RPC.
+func FromProto(p *gossipv1.SigningPause) (*db.SigningPause, error) {
+	if p == nil {
+		return nil, errors.New("missing pause")
+	}
+	if p.ChainId == 0 || p.ChainId > 0xffff {
+		return nil, fmt.Errorf("invalid chain id %d", p.ChainId)
+	}
+
+	pause := &db.SigningPause{
+		ChainID: uint16(p.ChainId),
+		Reason:  p.Reason,
+	}
+	switch p.Scope {
+	case gossipv1.SigningPause_SCOPE_EMITTER_CHAIN:
+		pause.Scope = db.SigningPauseEmitterChain
+	case gossipv1.SigningPause_SCOPE_TARGET_CHAIN:
+		pause.Scope = db.SigningPauseTargetChain
+	case gossipv1.SigningPause_SCOPE_EMITTER:
+		pause.Scope = db.SigningPauseEmitter
+		addr, err := vaa.StringToAddress(p.EmitterAddress)
+		if err != nil {
+			return nil, fmt.Errorf("invalid emitter address: %w", err)
+		}
+		pause.EmitterAddress = addr.String()
+	default:
+		return nil, fmt.Errorf("invalid scope %v", p.Scope)
+	}
+	return pause, nil
+}
+
+// ToProto converts a pause to its representation in the admin RPC and in heartbeats.
+func ToProto(p *db.SigningPause) *gossipv1.SigningPause {
+	pause := &gossipv1.SigningPause{
+		ChainId:        uint32(p.ChainID),
+		EmitterAddress: p.EmitterAddress,
+		Reason:         p.Reason,
+		PausedAt:       p.PausedAt.Unix(),
+	}
+	switch p.Scope {
+	case db.SigningPauseEmitterChain:
+		pause.Scope = gossipv1.SigningPause_SCOPE_EMITTER_CHAIN
+	case db.SigningPauseTargetChain:
+		pause.Scope = gossipv1.SigningPause_SCOPE_TARGET_CHAIN
+	case db.SigningPauseEmitter:
+		pause.Scope = gossipv1.SigningPause_SCOPE_EMITTER
+	}
+	return pause
+}
+
+// Pause stops signing the messages covered by p. An existing pause with the same scope is replaced.
+func (c *Controller) Pause(p *db.SigningPause) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	p.PausedAt = time.Now()
+	if err := c.db.StoreSigningPause(p); err != nil {
+		return err
+	}
+	c.pauses[p.ID()] = p
+	c.update()
+	c.logger.Warn("paused signing", zap.String("pause", p.ID()), zap.String("reason", p.Reason))
+	return nil
+}
+
+// Resume removes the pause with the same scope as p. It returns ErrNotPaused if there is no such pause.
+func (c *Controller) Resume(p *db.SigningPause) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	id := p.ID()
+	if _, ok := c.pauses[id]; !ok {
+		return ErrNotPaused
+	}
+	if err := c.db.DeleteSigningPause(id); err != nil {
+		return err
+	}
+	delete(c.pauses, id)
+	signingPaused.DeleteLabelValues(p.Scope, strconv.Itoa(int(p.ChainID)), p.EmitterAddress)
+	c.update()
+	c.logger.Warn("resumed signing", zap.String("pause", id))
+	return nil
+}
+
+// List returns the pauses in effect, ordered by ID.
+func (c *Controller) List() []*db.SigningPause {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return c.list()
+}
+
+func (c *Controller) list() []*db.SigningPause {
+	pauses := make([]*db.SigningPause, 0, len(c.pauses))
+	for _, p := range c.pauses {
+		pauses = append(pauses, p)
+	}
+	sort.Slice(pauses, func(i, j int) bool {
+		return pauses[i].ID() < pauses[j].ID()
+	})
+	return pauses
+}
+
+// update publishes the pauses in effect in the metrics and heartbeats.
+func (c *Controller) update() {
+	pauses := c.list()
+	heartbeat := make([]*gossipv1.SigningPause, 0, len(pauses))
+	for _, p := range pauses {
+		signingPaused.WithLabelValues(p.Scope, strconv.Itoa(int(p.ChainID)), p.EmitterAddress).Set(1)
+		heartbeat = append(heartbeat, ToProto(p))
+	}
+	p2p.DefaultRegistry.SetSigningPauses(heartbeat)
+}
+
+// Check returns the pause covering msg, or nil if the message can be signed.
+func (c *Controller) Check(msg *common.MessagePublication) *db.SigningPause {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if len(c.pauses) == 0 {
+		return nil
+	}
+	ids := []string{
+		(&db.SigningPause{Scope: db.SigningPauseEmitterChain, ChainID: uint16(msg.EmitterChain)}).ID(),
+		(&db.SigningPause{Scope: db.SigningPauseTargetChain, ChainID: uint16(msg.TargetChain)}).ID(),
+		(&db.SigningPause{Scope: db.SigningPauseEmitter, ChainID: uint16(msg.EmitterChain), EmitterAddress: msg.EmitterAddress.String()}).ID(),
+	}
+	for _, id := range ids {
+		if p, ok := c.pauses[id]; ok {
+			messagesPausedTotal.WithLabelValues(msg.EmitterChain.String()).Inc()
+			return p
+		}
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package pause
+
+import (
+	"testing"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	gossipv1 "github.com/alephium/wormhole-fork/node/pkg/proto/gossip/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+var emitterAddress = vaa.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x67, 0xb5, 0x65, 0x6d, 0x60, 0xa8, 0x09, 0x91, 0x53, 0x23, 0xbf, 0x2c, 0x40, 0xa8, 0xbe, 0xf1, 0x5a, 0x15, 0x2e, 0x3e}
+
+func testMsg(emitterChain vaa.ChainID, targetChain vaa.ChainID, emitter vaa.Address) *common.MessagePublication {
+	return &common.MessagePublication{
+		EmitterChain:   emitterChain,
+		TargetChain:    targetChain,
+		EmitterAddress: emitter,
+	}
+}
+
+func TestFromProto(t *testing.T) {
+	p, err := FromProto(&gossipv1.SigningPause{Scope: gossipv1.SigningPause_SCOPE_EMITTER, ChainId: 2, EmitterAddress: "0x" + emitterAddress.String(), Reason: "exploit"})
+	assert.Nil(t, err)
+	assert.Equal(t, &db.SigningPause{Scope: db.SigningPauseEmitter, ChainID: 2, EmitterAddress: emitterAddress.String(), Reason: "exploit"}, p)
+	assert.Equal(t, gossipv1.SigningPause_SCOPE_EMITTER, ToProto(p).Scope)
+
+	_, err = FromProto(&gossipv1.SigningPause{Scope: gossipv1.SigningPause_SCOPE_UNSPECIFIED, ChainId: 2})
+	assert.NotNil(t, err)
+	_, err = FromProto(&gossipv1.SigningPause{Scope: gossipv1.SigningPause_SCOPE_TARGET_CHAIN})
+	assert.NotNil(t, err)
+	_, err = FromProto(&gossipv1.SigningPause{Scope: gossipv1.SigningPause_SCOPE_EMITTER, ChainId: 2, EmitterAddress: "zz"})
+	assert.NotNil(t, err)
+	_, err = FromProto(nil)
+	assert.NotNil(t, err)
+}
+
+func TestPauseAndResume(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+	c, err := NewController(zap.NewNop(), database)
+	assert.Nil(t, err)
+
+	msg := testMsg(vaa.ChainIDEthereum, vaa.ChainIDAlephium, emitterAddress)
+	assert.Nil(t, c.Check(msg))
+
+	emitterChain := &db.SigningPause{Scope: db.SigningPauseEmitterChain, ChainID: uint16(vaa.ChainIDEthereum)}
+	assert.Nil(t, c.Pause(emitterChain))
+	assert.Equal(t, emitterChain, c.Check(msg))
+	assert.Nil(t, c.Check(testMsg(vaa.ChainIDBSC, vaa.ChainIDAlephium, emitterAddress)))
+
+	targetChain := &db.SigningPause{Scope: db.SigningPauseTargetChain, ChainID: uint16(vaa.ChainIDAlephium)}
+	assert.Nil(t, c.Pause(targetChain))
+	assert.Equal(t, targetChain, c.Check(testMsg(vaa.ChainIDBSC, vaa.ChainIDAlephium, emitterAddress)))
+
+	emitter := &db.SigningPause{Scope: db.SigningPauseEmitter, ChainID: uint16(vaa.ChainIDBSC), EmitterAddress: emitterAddress.String()}
+	assert.Nil(t, c.Pause(emitter))
+	assert.Equal(t, emitter, c.Check(testMsg(vaa.ChainIDBSC, vaa.ChainIDEthereum, emitterAddress)))
+	assert.Nil(t, c.Check(testMsg(vaa.ChainIDBSC, vaa.ChainIDEthereum, vaa.Address{})))
+
+	assert.Equal(t, 3, len(c.List()))
+
+	// pauses are reloaded from the database
+	c, err = NewController(zap.NewNop(), database)
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(c.List()))
+
+	assert.Nil(t, c.Resume(&db.SigningPause{Scope: db.SigningPauseEmitterChain, ChainID: uint16(vaa.ChainIDEthereum)}))
+	assert.Equal(t, ErrNotPaused, c.Resume(emitterChain))
+	assert.Nil(t, c.Resume(targetChain))
+	assert.Nil(t, c.Check(msg))
+	pauses := c.List()
+	assert.Equal(t, 1, len(pauses))
+	assert.Equal(t, emitter.ID(), pauses[0].ID())
+}
@@ -0,0 +1,157 @@
+// Package policy restricts the messages signed by a guardian to known emitters and registered target chains.
+//
+// By default, only the token bridge emitters of the chains in the bridge config are known, and only these chains are
+// registered as target chains, in addition to the broadcast target chain 0. The policy can be extended with a config
+// file listing additional emitters and target chains. Rejected messages are counted in metrics, and the most recent
+// ones are kept in memory for the admin RPC.
+package policy
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+const (
+	ReasonUnknownEmitter          = "unknown_emitter"
+	ReasonUnregisteredTargetChain = "unregistered_target_chain"
+
+	// maxRejections is the number of recently rejected messages kept for the admin RPC.
+	maxRejections = 100
+)
+
+var messagesRejectedTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "wormhole_signing_policy_rejected_messages_total",
+		Help: "Total number of messages which were not signed because of the signing policy",
+	}, []string{"reason", "emitter_chain"})
+
+// EmitterConfig is an emitter allowed in addition to the token bridge emitters. Address is the hex encoded
+// 32 bytes emitter address.
+type EmitterConfig struct {
+	Chain   uint16 `json:"chain"`
+	Address string `json:"address"`
+}
+
+// Config extends the policy derived from the bridge config.
+type Config struct {
+	Emitters     []EmitterConfig `json:"emitters"`
+	TargetChains []uint16        `json:"targetChains"`
+}
+
+func ReadConfig(path string) (*Config, error) {
+	bytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var config Config
+	if err := json.Unmarshal(bytes, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse signing policy config %s: %w", path, err)
+	}
+	return &config, nil
+}
+
+// Rejection is a message which was not signed because of the policy.
+type Rejection struct {
+	MessageID  string
+	TxHash     string
+	Reason     string
+	RejectedAt time.Time
+}
+
+type Policy struct {
+	logger *zap.Logger
+
+	emitters     map[vaa.ChainID]map[vaa.Address]bool
+	targetChains map[vaa.ChainID]bool
+
+	mutex sync.Mutex
+	// rejections holds the most recent rejections, oldest first.
+	rejections []*Rejection
+}
+
+// NewPolicy creates a policy allowing the token bridge emitters of the bridge config, which are also the registered
+// target chains, and the emitters and target chains of config, which may be nil.
+func NewPolicy(logger *zap.Logger, tokenBridgeEmitters map[vaa.ChainID]vaa.Address, config *Config) (*Policy, error) {
+	p := &Policy{
+		logger:       logger,
+		emitters:     make(map[vaa.ChainID]map[vaa.Address]bool),
+		targetChains: map[vaa.ChainID]bool{vaa.ChainIDUnset: true},
+	}
+	allow := func(chainId vaa.ChainID, emitter vaa.Address) {
+		if p.emitters[chainId] == nil {
+			p.emitters[chainId] = make(map[vaa.Address]bool)
+		}
+		p.emitters[chainId][emitter] = true
+	}
+
+	for chainId, emitter := range tokenBridgeEmitters {
+		allow(chainId, emitter)
+		p.targetChains[chainId] = true
+	}
+	if config != nil {
+		for _, e := range config.Emitters {
+			if e.Chain == 0 {
+				return nil, fmt.Errorf("invalid chain id 0 of emitter %s", e.Address)
+			}
+			emitter, err := vaa.StringToAddress(e.Address)
+			if err != nil {
+				return nil, fmt.Errorf("invalid emitter address %s: %w", e.Address, err)
+			}
+			allow(vaa.ChainID(e.Chain), emitter)
+		}
+		for _, chainId := range config.TargetChains {
+			p.targetChains[vaa.ChainID(chainId)] = true
+		}
+	}
+	return p, nil
+}
+
+// Check returns the rejection of msg, or nil if the message can be signed.
+func (p *Policy) Check(msg *common.MessagePublication) *Rejection {
+	var reason string
+	if !p.emitters[msg.EmitterChain][msg.EmitterAddress] {
+		reason = ReasonUnknownEmitter
+	} else if !p.targetChains[msg.TargetChain] {
+		reason = ReasonUnregisteredTargetChain
+	} else {
+		return nil
+	}
+
+	messagesRejectedTotal.WithLabelValues(reason, msg.EmitterChain.String()).Inc()
+	r := &Rejection{
+		MessageID:  msg.MessageIDString(),
+		TxHash:     msg.TxHash.String(),
+		Reason:     reason,
+		RejectedAt: time.Now(),
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if len(p.rejections) == maxRejections {
+		p.rejections = p.rejections[1:]
+	}
+	p.rejections = append(p.rejections, r)
+	return r
+}
+
+// Rejections returns the most recently rejected messages, newest first.
+func (p *Policy) Rejections() []*Rejection {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	rejections := make([]*Rejection, len(p.rejections))
+	for i, r := range p.rejections {
+		rejections[len(rejections)-1-i] = r
+	}
+	return rejections
+}
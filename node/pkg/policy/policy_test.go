@@ -0,0 +1,88 @@
+package policy
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var (
+	ethTokenBridge  = vaa.Address{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x02, 0x90, 0xfb, 0x16, 0x72, 0x08, 0xaf, 0x45, 0x5b, 0xb1, 0x37, 0x78, 0x01, 0x63, 0xb7, 0xb7, 0xa9, 0xa1, 0x0c, 0x16}
+	alphTokenBridge = vaa.Address{0x01}
+	otherEmitter    = vaa.Address{0x02}
+)
+
+func testMsg(emitterChain vaa.ChainID, targetChain vaa.ChainID, emitter vaa.Address, sequence uint64) *common.MessagePublication {
+	return &common.MessagePublication{
+		EmitterChain:   emitterChain,
+		TargetChain:    targetChain,
+		EmitterAddress: emitter,
+		Sequence:       sequence,
+	}
+}
+
+func testPolicy(t *testing.T, config *Config) *Policy {
+	p, err := NewPolicy(zap.NewNop(), map[vaa.ChainID]vaa.Address{
+		vaa.ChainIDEthereum: ethTokenBridge,
+		vaa.ChainIDAlephium: alphTokenBridge,
+	}, config)
+	require.NoError(t, err)
+	return p
+}
+
+func TestDefaultPolicy(t *testing.T) {
+	p := testPolicy(t, nil)
+
+	assert.Nil(t, p.Check(testMsg(vaa.ChainIDEthereum, vaa.ChainIDAlephium, ethTokenBridge, 1)))
+	assert.Nil(t, p.Check(testMsg(vaa.ChainIDAlephium, vaa.ChainIDEthereum, alphTokenBridge, 1)))
+	// Attestations are broadcast to all chains
+	assert.Nil(t, p.Check(testMsg(vaa.ChainIDEthereum, vaa.ChainIDUnset, ethTokenBridge, 2)))
+
+	r := p.Check(testMsg(vaa.ChainIDEthereum, vaa.ChainIDAlephium, otherEmitter, 3))
+	require.NotNil(t, r)
+	assert.Equal(t, ReasonUnknownEmitter, r.Reason)
+	// The token bridge emitter of a chain isn't allowed on another chain
+	r = p.Check(testMsg(vaa.ChainIDBSC, vaa.ChainIDAlephium, ethTokenBridge, 4))
+	require.NotNil(t, r)
+	assert.Equal(t, ReasonUnknownEmitter, r.Reason)
+
+	r = p.Check(testMsg(vaa.ChainIDEthereum, vaa.ChainIDSolana, ethTokenBridge, 5))
+	require.NotNil(t, r)
+	assert.Equal(t, ReasonUnregisteredTargetChain, r.Reason)
+	assert.Equal(t, fmt.Sprintf("%d/%s/%d/5", vaa.ChainIDEthereum, ethTokenBridge, vaa.ChainIDSolana), r.MessageID)
+}
+
+func TestPolicyConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "policy.json")
+	require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf(`{"emitters": [{"chain": 4, "address": "%s"}], "targetChains": [1]}`, otherEmitter)), 0600))
+	config, err := ReadConfig(path)
+	require.NoError(t, err)
+	p := testPolicy(t, config)
+
+	assert.Nil(t, p.Check(testMsg(vaa.ChainIDBSC, vaa.ChainIDAlephium, otherEmitter, 1)))
+	assert.Nil(t, p.Check(testMsg(vaa.ChainIDEthereum, vaa.ChainIDSolana, ethTokenBridge, 1)))
+	assert.NotNil(t, p.Check(testMsg(vaa.ChainIDEthereum, vaa.ChainIDAlephium, otherEmitter, 1)))
+
+	_, err = NewPolicy(zap.NewNop(), nil, &Config{Emitters: []EmitterConfig{{Chain: 2, Address: "zz"}}})
+	assert.Error(t, err)
+	_, err = NewPolicy(zap.NewNop(), nil, &Config{Emitters: []EmitterConfig{{Chain: 0, Address: otherEmitter.String()}}})
+	assert.Error(t, err)
+}
+
+func TestRejections(t *testing.T) {
+	p := testPolicy(t, nil)
+	for i := 0; i < maxRejections+10; i++ {
+		require.NotNil(t, p.Check(testMsg(vaa.ChainIDEthereum, vaa.ChainIDAlephium, otherEmitter, uint64(i))))
+	}
+	rejections := p.Rejections()
+	require.Len(t, rejections, maxRejections)
+	assert.Equal(t, fmt.Sprintf("%d/%s/%d/%d", vaa.ChainIDEthereum, otherEmitter, vaa.ChainIDAlephium, maxRejections+9), rejections[0].MessageID)
+	assert.Equal(t, fmt.Sprintf("%d/%s/%d/10", vaa.ChainIDEthereum, otherEmitter, vaa.ChainIDAlephium), rejections[maxRejections-1].MessageID)
+}
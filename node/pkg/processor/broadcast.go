@@ -59,6 +59,7 @@ func (p *Processor) broadcastSignature(v *vaa.VAA, signature []byte, txhash []by
 	p.state.vaaSignatures[hash].txHash = txhash
 	p.state.vaaSignatures[hash].source = v.EmitterChain.String()
 	p.state.vaaSignatures[hash].gs = p.gs // guaranteed to match ourVAA - there's no concurrent access to p.gs
+	p.journalState(hash)
 
 	// Fast path for our own signature
 	go func() { p.obsvC <- &obsv }()
@@ -62,6 +62,8 @@ const (
 func (p *Processor) handleCleanup(ctx context.Context) {
 	p.logger.Info("aggregation state summary", zap.Int("cached", len(p.state.vaaSignatures)))
 	aggregationStateEntries.Set(float64(len(p.state.vaaSignatures)))
+	p.expireObservedMessages()
+	p.expireTransition()
 
 	for hash, s := range p.state.vaaSignatures {
 		delta := time.Since(s.firstObserved)
@@ -78,7 +80,7 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 				// have a quorum VAA.
 				p.logger.Info("Expiring late VAA", zap.String("digest", hash), zap.Duration("delta", delta))
 				aggregationStateLate.Inc()
-				delete(p.state.vaaSignatures, hash)
+				p.deleteState(hash)
 				continue
 			} else if err != db.ErrVAANotFound {
 				p.logger.Error("failed to look up VAA in database",
@@ -88,6 +90,11 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 			}
 		}
 
+		if !s.submitted && s.ourVAA == nil && !s.selfReobserved && delta > selfReobservationGracePeriod {
+			// Other guardians signed a message we haven't observed, our watcher may have missed it.
+			p.selfReobserve(hash, s)
+		}
+
 		switch {
 		case !s.settled && delta > settlementTime:
 			// After 30 seconds, the VAA is considered settled - it's unlikely that more observations will
@@ -160,18 +167,19 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 					aggregationStateFulfillment.WithLabelValues(k.Hex(), s.source, "missing").Inc()
 				}
 			}
+			p.journalState(hash)
 		case s.submitted && delta.Hours() >= 1:
 			// We could delete submitted VAAs right away, but then we'd lose context about additional (late)
 			// observation that come in. Therefore, keep it for a reasonable amount of time.
 			// If a very late observation arrives after cleanup, a nil aggregation state will be created
 			// and then expired after a while (as noted in observation.go, this can be abused by a byzantine guardian).
 			p.logger.Info("expiring submitted VAA", zap.String("digest", hash), zap.Duration("delta", delta))
-			delete(p.state.vaaSignatures, hash)
+			p.deleteState(hash)
 			aggregationStateExpiration.Inc()
 		case !s.submitted && ((s.ourMsg != nil && s.retryCount >= 14400 /* 120 hours */) || (s.ourMsg == nil && s.retryCount >= 10 /* 5 minutes */)):
 			// Clearly, this horse is dead and continued beatings won't bring it closer to quorum.
 			p.logger.Info("expiring unsubmitted VAA after exhausting retries", zap.String("digest", hash), zap.Duration("delta", delta))
-			delete(p.state.vaaSignatures, hash)
+			p.deleteState(hash)
 			aggregationStateTimeout.Inc()
 		case !s.submitted && delta.Minutes() >= 5 && time.Since(s.lastRetry) >= retryTime:
 			// Poor VAA has been unsubmitted for five minutes - clearly, something went wrong.
@@ -196,6 +204,7 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 				s.retryCount += 1
 				s.lastRetry = time.Now()
 				aggregationStateRetries.Inc()
+				p.journalState(hash)
 			} else {
 				// For nil state entries, we log the quorum to determine whether the
 				// network reached consensus without us. We don't know the correct guardian
@@ -210,7 +219,7 @@ func (p *Processor) handleCleanup(ctx context.Context) {
 					zap.Int("required_sigs", wantSigs),
 					zap.Bool("quorum", hasSigs >= wantSigs),
 				)
-				delete(p.state.vaaSignatures, hash)
+				p.deleteState(hash)
 				aggregationStateUnobserved.Inc()
 			}
 		}
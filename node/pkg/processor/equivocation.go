@@ -0,0 +1,199 @@
+package processor
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	gossipv1 "github.com/alephium/wormhole-fork/node/pkg/proto/gossip/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	equivocationsDetectedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_equivocations_detected_total",
+			Help: "Total number of conflicting VAA bodies signed by the same guardian for the same message",
+		}, []string{"addr"})
+)
+
+const (
+	// observedMessageRetention is how long the observations of a message are kept to detect conflicts. Guardians
+	// retransmit their observations until the aggregation state expires, so it matches the expiration of submitted VAAs.
+	observedMessageRetention = time.Hour
+	// maxObservedBodies is the maximum number of distinct bodies kept per message.
+	maxObservedBodies = 4
+)
+
+// observedMessage holds the verified observations of a message ID, and the bodies of the message known to the node.
+type observedMessage struct {
+	firstObserved time.Time
+	// First observation of each body by digest and guardian.
+	observations map[string]map[ethcommon.Address]*gossipv1.SignedObservation
+	// Bodies by digest, for the ones we observed ourselves and the ones of VAAs with quorum.
+	bodies map[string]*vaa.VAA
+	// Guardians already reported as equivocating.
+	reported map[ethcommon.Address]bool
+}
+
+// recordObservation records an observation, and detects a guardian signing different bodies with the same message ID
+// (emitter chain, emitter address, target chain and sequence). This can be a byzantine guardian, or a watcher producing
+// different messages after a reorg. The signature must have been verified, body is nil if the node doesn't know it.
+//
+// The message ID isn't covered by the signature of an observation, so it is taken from the body when known. For bodies
+// unknown to the node, anyone relaying the observation could have changed it: the conflict is still reported and the
+// observations are stored as evidence, but it is only proven once both bodies are known.
+func (p *Processor) recordObservation(m *gossipv1.SignedObservation, body *vaa.VAA) {
+	id := m.MessageId
+	if body != nil {
+		id = body.MessageID()
+	}
+	if id == "" {
+		return
+	}
+	addr := ethcommon.BytesToAddress(m.Addr)
+	digest := hex.EncodeToString(m.Hash)
+
+	om, ok := p.messages[id]
+	if !ok {
+		om = &observedMessage{
+			firstObserved: time.Now(),
+			observations:  map[string]map[ethcommon.Address]*gossipv1.SignedObservation{},
+			bodies:        map[string]*vaa.VAA{},
+			reported:      map[ethcommon.Address]bool{},
+		}
+		p.messages[id] = om
+	}
+	if om.observations[digest] == nil {
+		if len(om.observations) >= maxObservedBodies {
+			return
+		}
+		om.observations[digest] = map[ethcommon.Address]*gossipv1.SignedObservation{}
+	}
+	if body != nil && om.bodies[digest] == nil {
+		om.bodies[digest] = body
+	}
+	if om.observations[digest][addr] != nil {
+		return
+	}
+	om.observations[digest][addr] = m
+
+	if om.reported[addr] {
+		return
+	}
+	for other, observations := range om.observations {
+		if other == digest || observations[addr] == nil {
+			continue
+		}
+		om.reported[addr] = true
+		p.reportEquivocation(id, addr, om, observations[addr], m)
+		return
+	}
+}
+
+// recordQuorumSignatures records the signatures of a VAA with quorum, verified against gs.
+func (p *Processor) recordQuorumSignatures(v *vaa.VAA, gs *common.GuardianSet) {
+	digest := v.SigningMsg()
+	for _, sig := range v.Signatures {
+		if int(sig.Index) >= len(gs.Keys) {
+			continue
+		}
+		p.recordObservation(&gossipv1.SignedObservation{
+			Addr:      gs.Keys[sig.Index].Bytes(),
+			Hash:      digest.Bytes(),
+			Signature: sig.Signature[:],
+			MessageId: v.MessageID(),
+		}, v)
+	}
+}
+
+// signedBody returns a copy of body which only holds the signature of addr, nil if addr isn't a known guardian.
+func (p *Processor) signedBody(body *vaa.VAA, addr ethcommon.Address, signature []byte) *vaa.VAA {
+	if len(signature) != len(vaa.SignatureData{}) {
+		return nil
+	}
+	for _, gs := range p.guardianSets() {
+		keyIndex, ok := gs.KeyIndex(addr)
+		if !ok {
+			continue
+		}
+		signed := *body
+		signed.GuardianSetIndex = gs.Index
+		signed.Signatures = []*vaa.Signature{{Index: uint8(keyIndex)}}
+		copy(signed.Signatures[0].Signature[:], signature)
+		return &signed
+	}
+	return nil
+}
+
+func (p *Processor) reportEquivocation(id string, addr ethcommon.Address, om *observedMessage, prev *gossipv1.SignedObservation, m *gossipv1.SignedObservation) {
+	evidence := &db.Evidence{
+		MessageID:    id,
+		Guardian:     addr.Hex(),
+		DetectedAt:   time.Now(),
+		Observations: make([][]byte, 0, 2),
+		VAAs:         make([][]byte, 0, 2),
+	}
+	digests := make([]string, 0, 2)
+	for _, o := range []*gossipv1.SignedObservation{prev, m} {
+		b, err := proto.Marshal(o)
+		if err != nil {
+			panic(err)
+		}
+		evidence.Observations = append(evidence.Observations, b)
+
+		digest := hex.EncodeToString(o.Hash)
+		digests = append(digests, digest)
+		if body := om.bodies[digest]; body != nil {
+			if signed := p.signedBody(body, addr, o.Signature); signed != nil {
+				b, err := signed.Marshal()
+				if err != nil {
+					panic(err)
+				}
+				evidence.VAAs = append(evidence.VAAs, b)
+			}
+		}
+	}
+	proven := len(evidence.VAAs) == 2
+
+	fields := []zap.Field{
+		zap.String("message_id", id),
+		zap.String("addr", addr.Hex()),
+		zap.Bool("ours", addr == p.ourAddr),
+		zap.Strings("digests", digests),
+	}
+	if proven {
+		p.logger.Error("SECURITY CRITICAL: guardian signed conflicting VAA bodies for the same message", fields...)
+	} else {
+		p.logger.Warn("guardian signed different digests for the same message ID, the bodies are unknown so the message ID isn't proven", fields...)
+	}
+	equivocationsDetectedTotal.WithLabelValues(addr.Hex()).Inc()
+
+	if err := p.db.StoreEvidence(evidence); err != nil {
+		p.logger.Error("failed to store evidence", zap.String("message_id", id), zap.Error(err))
+	}
+
+	// Only proven conflicts are notified, others can be forged by relaying an observation with another message ID.
+	if p.notifier != nil && proven {
+		go func(messageId string, guardian string, digests []string) {
+			if err := p.notifier.ConflictingObservations(messageId, guardian, digests); err != nil {
+				p.logger.Error("failed to send notification", zap.Error(err))
+			}
+		}(id, addr.Hex(), digests)
+	}
+}
+
+// expireObservedMessages removes the observations of message IDs older than observedMessageRetention.
+func (p *Processor) expireObservedMessages() {
+	for id, om := range p.messages {
+		if time.Since(om.firstObserved) > observedMessageRetention {
+			delete(p.messages, id)
+		}
+	}
+}
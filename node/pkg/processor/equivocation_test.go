@@ -0,0 +1,131 @@
+package processor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/devnet"
+	gossipv1 "github.com/alephium/wormhole-fork/node/pkg/proto/gossip/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestRecordObservation(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	p := newTransitionProcessor(database)
+	gs, keys := devnet.InsecureDeterministicGuardianSet(1, 2)
+	p.gs = gs
+
+	ours := getVAA()
+	conflicting := getVAA()
+	conflicting.Payload = []byte{98}
+
+	// Observations of the body we observed ourselves, retransmissions are not conflicts
+	p.recordObservation(signedObservation(t, &ours, keys[0]), &ours)
+	p.recordObservation(signedObservation(t, &ours, keys[0]), &ours)
+	evidence, err := database.GetEvidence()
+	require.NoError(t, err)
+	assert.Empty(t, evidence)
+
+	// Other guardians signing a different body are not conflicts either
+	p.recordObservation(signedObservation(t, &conflicting, keys[1]), nil)
+	evidence, err = database.GetEvidence()
+	require.NoError(t, err)
+	assert.Empty(t, evidence)
+
+	// Conflicts are detected for bodies unknown to the node, and only reported once
+	observation := signedObservation(t, &conflicting, keys[0])
+	p.recordObservation(observation, nil)
+	p.recordObservation(observation, nil)
+	evidence, err = database.GetEvidence()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(evidence))
+	assert.Equal(t, ours.MessageID(), evidence[0].MessageID)
+	assert.Equal(t, gs.Keys[0].Hex(), evidence[0].Guardian)
+
+	// Both raw observations are stored
+	require.Equal(t, 2, len(evidence[0].Observations))
+	for i, expected := range []vaa.VAA{ours, conflicting} {
+		var stored gossipv1.SignedObservation
+		require.NoError(t, proto.Unmarshal(evidence[0].Observations[i], &stored))
+		assert.Equal(t, expected.SigningMsg().Bytes(), stored.Hash)
+		assert.Equal(t, gs.Keys[0].Bytes(), stored.Addr)
+	}
+
+	// Only the known body is stored, with the signature of the guardian
+	require.Equal(t, 1, len(evidence[0].VAAs))
+	signed, err := vaa.Unmarshal(evidence[0].VAAs[0])
+	require.NoError(t, err)
+	assert.Equal(t, ours.SigningMsg(), signed.SigningMsg())
+	assert.Equal(t, 1, len(signed.Signatures))
+	assert.True(t, signed.VerifySignatures(gs.Keys))
+}
+
+func TestRecordQuorumSignatures(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	p := newTransitionProcessor(database)
+	gs, keys := devnet.InsecureDeterministicGuardianSet(1, 2)
+	p.gs = gs
+
+	ours := getVAA()
+	p.recordObservation(signedObservation(t, &ours, keys[0]), &ours)
+
+	quorum := getVAA()
+	quorum.Payload = []byte{98}
+	quorum.AddSignature(keys[0], 0)
+	quorum.AddSignature(keys[1], 1)
+	p.recordQuorumSignatures(&quorum, gs)
+
+	// Both bodies are known, the conflict is proven by the evidence
+	evidence, err := database.GetEvidence()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(evidence))
+	assert.Equal(t, gs.Keys[0].Hex(), evidence[0].Guardian)
+	assert.Equal(t, 2, len(evidence[0].Observations))
+	require.Equal(t, 2, len(evidence[0].VAAs))
+	for i, expected := range []vaa.VAA{ours, quorum} {
+		signed, err := vaa.Unmarshal(evidence[0].VAAs[i])
+		require.NoError(t, err)
+		assert.Equal(t, expected.SigningMsg(), signed.SigningMsg())
+		assert.Equal(t, 1, len(signed.Signatures))
+		assert.True(t, signed.VerifySignatures(gs.Keys))
+	}
+}
+
+func TestRecordObservationLimit(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	p := newTransitionProcessor(database)
+	gs, keys := devnet.InsecureDeterministicGuardianSet(1, 1)
+	id := getVAA()
+	for i := 0; i < 2*maxObservedBodies; i++ {
+		v := getVAA()
+		v.Payload = []byte{byte(i)}
+		v.AddSignature(keys[0], 0)
+		p.recordQuorumSignatures(&v, gs)
+	}
+	assert.Equal(t, maxObservedBodies, len(p.messages[id.MessageID()].observations))
+}
+
+func TestExpireObservedMessages(t *testing.T) {
+	p := &Processor{
+		messages: map[string]*observedMessage{
+			"expired": {firstObserved: time.Now().Add(-2 * observedMessageRetention)},
+			"recent":  {firstObserved: time.Now()},
+		},
+	}
+	p.expireObservedMessages()
+	assert.Equal(t, 1, len(p.messages))
+	assert.NotNil(t, p.messages["recent"])
+}
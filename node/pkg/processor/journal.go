@@ -0,0 +1,162 @@
+package processor
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"go.uber.org/zap"
+)
+
+// The aggregation state journal persists vaaState entries in the local database, so that partially collected
+// signatures and our own observations survive restarts. Entries are written whenever they change, and are
+// removed together with the in-memory state by handleCleanup.
+
+func toAggregationState(hash string, s *vaaState) (*db.AggregationState, error) {
+	state := &db.AggregationState{
+		Digest:        hash,
+		FirstObserved: s.firstObserved,
+		LastRetry:     s.lastRetry,
+		Submitted:     s.submitted,
+		Settled:       s.settled,
+		Source:        s.source,
+		RetryCount:    s.retryCount,
+		OurMsg:        s.ourMsg,
+		TxHash:        s.txHash,
+		Signatures:    make(map[string][]byte, len(s.signatures)),
+
+		SelfReobserved: s.selfReobserved,
+	}
+	if s.remoteObservations != nil {
+		state.RemoteObservations = make(map[string][]db.RemoteObservation, len(s.remoteObservations))
+		for addr, observations := range s.remoteObservations {
+			for _, o := range observations {
+				state.RemoteObservations[addr.Hex()] = append(state.RemoteObservations[addr.Hex()], db.RemoteObservation{Chain: uint16(o.chain), TxHash: o.txHash})
+			}
+		}
+	}
+	if s.ourVAA != nil {
+		b, err := s.ourVAA.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		state.OurVAA = b
+	}
+	for addr, sig := range s.signatures {
+		state.Signatures[addr.Hex()] = sig
+	}
+	if s.gs != nil {
+		state.GuardianSetIndex = s.gs.Index
+		state.GuardianSetKeys = s.gs.KeysAsHexStrings()
+	}
+	return state, nil
+}
+
+func fromAggregationState(state *db.AggregationState) (*vaaState, error) {
+	s := &vaaState{
+		firstObserved: state.FirstObserved,
+		lastRetry:     state.LastRetry,
+		submitted:     state.Submitted,
+		settled:       state.Settled,
+		source:        state.Source,
+		retryCount:    state.RetryCount,
+		ourMsg:        state.OurMsg,
+		txHash:        state.TxHash,
+		signatures:    make(map[ethcommon.Address][]byte, len(state.Signatures)),
+
+		selfReobserved: state.SelfReobserved,
+	}
+	if state.RemoteObservations != nil {
+		s.remoteObservations = make(map[ethcommon.Address][]remoteObservation, len(state.RemoteObservations))
+		for addr, observations := range state.RemoteObservations {
+			if !ethcommon.IsHexAddress(addr) {
+				return nil, fmt.Errorf("invalid guardian address %s", addr)
+			}
+			for _, o := range observations {
+				s.remoteObservations[ethcommon.HexToAddress(addr)] = append(s.remoteObservations[ethcommon.HexToAddress(addr)], remoteObservation{chain: vaa.ChainID(o.Chain), txHash: o.TxHash})
+			}
+		}
+	}
+	if state.OurVAA != nil {
+		v, err := vaa.Unmarshal(state.OurVAA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal VAA: %w", err)
+		}
+		s.ourVAA = v
+	}
+	for addr, sig := range state.Signatures {
+		if !ethcommon.IsHexAddress(addr) {
+			return nil, fmt.Errorf("invalid guardian address %s", addr)
+		}
+		s.signatures[ethcommon.HexToAddress(addr)] = sig
+	}
+	if state.GuardianSetKeys != nil {
+		keys := make([]ethcommon.Address, len(state.GuardianSetKeys))
+		for i, key := range state.GuardianSetKeys {
+			if !ethcommon.IsHexAddress(key) {
+				return nil, fmt.Errorf("invalid guardian key %s", key)
+			}
+			keys[i] = ethcommon.HexToAddress(key)
+		}
+		s.gs = &common.GuardianSet{Keys: keys, Index: state.GuardianSetIndex}
+	}
+	return s, nil
+}
+
+// journalState writes the current aggregation state of the given digest to the database.
+func (p *Processor) journalState(hash string) {
+	if !p.persistState {
+		return
+	}
+	s, ok := p.state.vaaSignatures[hash]
+	if !ok {
+		return
+	}
+	state, err := toAggregationState(hash, s)
+	if err != nil {
+		p.logger.Error("failed to convert aggregation state", zap.String("digest", hash), zap.Error(err))
+		return
+	}
+	if err := p.db.StoreAggregationState(state); err != nil {
+		p.logger.Error("failed to store aggregation state", zap.String("digest", hash), zap.Error(err))
+	}
+}
+
+// deleteState removes the aggregation state of the given digest, from memory and from the journal.
+func (p *Processor) deleteState(hash string) {
+	delete(p.state.vaaSignatures, hash)
+	if !p.persistState {
+		return
+	}
+	if err := p.db.DeleteAggregationState(hash); err != nil {
+		p.logger.Error("failed to delete aggregation state", zap.String("digest", hash), zap.Error(err))
+	}
+}
+
+// loadState restores the journaled aggregation state. Expired entries are removed by the next handleCleanup.
+func (p *Processor) loadState() error {
+	states, err := p.db.GetAggregationStates()
+	if err != nil {
+		return fmt.Errorf("failed to load aggregation state: %w", err)
+	}
+	for _, state := range states {
+		if _, err := hex.DecodeString(state.Digest); err != nil {
+			p.logger.Error("invalid aggregation state digest, dropping it", zap.String("digest", state.Digest))
+			p.deleteState(state.Digest)
+			continue
+		}
+		s, err := fromAggregationState(state)
+		if err != nil {
+			p.logger.Error("invalid aggregation state, dropping it", zap.String("digest", state.Digest), zap.Error(err))
+			p.deleteState(state.Digest)
+			continue
+		}
+		p.state.vaaSignatures[state.Digest] = s
+	}
+	p.logger.Info("restored aggregation state", zap.Int("entries", len(states)))
+	aggregationStateEntries.Set(float64(len(p.state.vaaSignatures)))
+	return nil
+}
@@ -0,0 +1,83 @@
+package processor
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func newJournalProcessor(database *db.Database) *Processor {
+	return &Processor{
+		logger:       zap.NewNop(),
+		db:           database,
+		state:        &aggregationState{vaaMap{}},
+		persistState: true,
+	}
+}
+
+func TestJournalAggregationState(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	assert.Nil(t, err)
+	defer database.Close()
+
+	v := getVAA()
+	hash := hex.EncodeToString(v.SigningMsg().Bytes())
+	guardian0 := ethcommon.HexToAddress("0xbeFA429d57cD18b7F8A4d91A2da9AB4AF05d0FBe")
+	guardian1 := ethcommon.HexToAddress("0x88D7D8B32a9105d228100E72dFFe2Fae0705D31c")
+
+	p := newJournalProcessor(database)
+	p.state.vaaSignatures[hash] = &vaaState{
+		firstObserved: time.Unix(1654516425, 0),
+		ourVAA:        &v,
+		signatures:    map[ethcommon.Address][]byte{guardian0: {1, 2, 3}},
+		source:        v.EmitterChain.String(),
+		retryCount:    2,
+		ourMsg:        []byte{4, 5, 6},
+		txHash:        []byte{7, 8, 9},
+		gs:            &common.GuardianSet{Keys: []ethcommon.Address{guardian0, guardian1}, Index: 1},
+	}
+	p.state.vaaSignatures["00"] = &vaaState{
+		firstObserved: time.Unix(1654516425, 0),
+		signatures:    map[ethcommon.Address][]byte{guardian1: {1}},
+		source:        "unknown",
+
+		remoteObservations: map[ethcommon.Address][]remoteObservation{guardian1: {{v.EmitterChain, []byte{10, 11}}}},
+		selfReobserved:     true,
+	}
+	p.journalState(hash)
+	p.journalState("00")
+
+	restored := newJournalProcessor(database)
+	assert.Nil(t, restored.loadState())
+	assert.Equal(t, 2, len(restored.state.vaaSignatures))
+
+	s := restored.state.vaaSignatures[hash]
+	expected := p.state.vaaSignatures[hash]
+	assert.True(t, expected.firstObserved.Equal(s.firstObserved))
+	assert.Equal(t, expected.ourVAA.SigningMsg(), s.ourVAA.SigningMsg())
+	assert.Equal(t, expected.signatures, s.signatures)
+	assert.Equal(t, expected.source, s.source)
+	assert.Equal(t, expected.retryCount, s.retryCount)
+	assert.Equal(t, expected.ourMsg, s.ourMsg)
+	assert.Equal(t, expected.txHash, s.txHash)
+	assert.Equal(t, expected.gs, s.gs)
+
+	unknown := restored.state.vaaSignatures["00"]
+	assert.Nil(t, unknown.ourVAA)
+	assert.Nil(t, unknown.gs)
+	assert.Equal(t, []byte{1}, unknown.signatures[guardian1])
+	assert.Equal(t, p.state.vaaSignatures["00"].remoteObservations, unknown.remoteObservations)
+	assert.True(t, unknown.selfReobserved)
+
+	restored.deleteState(hash)
+	restored = newJournalProcessor(database)
+	assert.Nil(t, restored.loadState())
+	assert.Equal(t, 1, len(restored.state.vaaSignatures))
+	assert.NotNil(t, restored.state.vaaSignatures["00"])
+}
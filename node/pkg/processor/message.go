@@ -37,6 +37,48 @@ var (
 		[]string{"emitter_chain"})
 )
 
+// signingAllowed returns whether the message may be signed according to the signing pauses and the signing policy. It
+// must be checked before the message is accounted for by the accountant and the governor, so that dropped messages
+// don't use up their limits.
+func (p *Processor) signingAllowed(k *common.MessagePublication) bool {
+	// A governance message should never be emitted on-chain, whatever the pauses and the policy
+	if k.EmitterAddress == p.governanceEmitterAddress && k.EmitterChain == p.governanceChainId {
+		p.logger.Error(
+			"EMERGENCY: PLEASE REPORT THIS IMMEDIATELY! A Solana message was emitted from the governance emitter. This should never be possible.",
+			zap.Stringer("emitter_chain", k.EmitterChain),
+			zap.Stringer("emitter_address", k.EmitterAddress),
+			zap.Uint32("nonce", k.Nonce),
+			zap.Stringer("txhash", k.TxHash),
+			zap.Time("timestamp", k.Timestamp))
+		return false
+	}
+
+	if p.pauses != nil {
+		if pause := p.pauses.Check(k); pause != nil {
+			p.logger.Warn("dropping observation since signing is paused",
+				zap.String("pause", pause.ID()),
+				zap.String("reason", pause.Reason),
+				zap.String("message_id", k.MessageIDString()),
+				zap.Stringer("txhash", k.TxHash),
+			)
+			return false
+		}
+	}
+
+	if p.policy != nil {
+		if rejection := p.policy.Check(k); rejection != nil {
+			p.logger.Warn("dropping observation rejected by the signing policy",
+				zap.String("reason", rejection.Reason),
+				zap.String("message_id", rejection.MessageID),
+				zap.Stringer("txhash", k.TxHash),
+			)
+			return false
+		}
+	}
+
+	return true
+}
+
 // handleMessage processes a message received from a chain and instantiates our deterministic copy of the VAA. An
 // event may be received multiple times and must be handled in an idempotent fashion.
 func (p *Processor) handleMessage(ctx context.Context, k *common.MessagePublication) {
@@ -82,18 +124,6 @@ func (p *Processor) handleMessage(ctx context.Context, k *common.MessagePublicat
 		ConsistencyLevel: k.ConsistencyLevel,
 	}
 
-	// A governance message should never be emitted on-chain
-	if v.EmitterAddress == p.governanceEmitterAddress && v.EmitterChain == p.governanceChainId {
-		supervisor.Logger(ctx).Error(
-			"EMERGENCY: PLEASE REPORT THIS IMMEDIATELY! A Solana message was emitted from the governance emitter. This should never be possible.",
-			zap.Stringer("emitter_chain", k.EmitterChain),
-			zap.Stringer("emitter_address", k.EmitterAddress),
-			zap.Uint32("nonce", k.Nonce),
-			zap.Stringer("txhash", k.TxHash),
-			zap.Time("timestamp", k.Timestamp))
-		return
-	}
-
 	// Ignore incoming observations when our database already has a quorum VAA for it.
 	// This can occur when we're receiving late observations due to node catchup, and
 	// processing those won't do us any good.
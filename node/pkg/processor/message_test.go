@@ -0,0 +1,39 @@
+package processor
+
+import (
+	"testing"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/policy"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestSigningAllowedGovernanceEmitter(t *testing.T) {
+	observedZapCore, observedLogs := observer.New(zap.InfoLevel)
+	signingPolicy, err := policy.NewPolicy(zap.NewNop(), map[vaa.ChainID]vaa.Address{vaa.ChainIDEthereum: {31: 2}}, nil)
+	require.NoError(t, err)
+
+	governance := getVAA()
+	p := &Processor{
+		logger:                   zap.New(observedZapCore),
+		policy:                   signingPolicy,
+		governanceChainId:        governance.EmitterChain,
+		governanceEmitterAddress: governance.EmitterAddress,
+	}
+
+	// Messages of the governance emitter are reported as an emergency, not as a policy rejection
+	assert.False(t, p.signingAllowed(&common.MessagePublication{EmitterChain: governance.EmitterChain, EmitterAddress: governance.EmitterAddress}))
+	require.Equal(t, 1, observedLogs.Len())
+	assert.Equal(t, zapcore.ErrorLevel, observedLogs.All()[0].Level)
+	assert.Contains(t, observedLogs.All()[0].Message, "EMERGENCY")
+
+	assert.True(t, p.signingAllowed(&common.MessagePublication{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: vaa.Address{31: 2}}))
+	assert.False(t, p.signingAllowed(&common.MessagePublication{EmitterChain: vaa.ChainIDEthereum, EmitterAddress: vaa.Address{31: 3}}))
+	require.Equal(t, 2, observedLogs.Len())
+	assert.Equal(t, zapcore.WarnLevel, observedLogs.All()[1].Level)
+}
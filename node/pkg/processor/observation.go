@@ -91,32 +91,20 @@ func (p *Processor) handleObservation(ctx context.Context, m *gossipv1.SignedObs
 		return
 	}
 
-	// Determine which guardian set to use. The following cases are possible:
+	// Determine which guardian sets to use. Outside of guardian set transitions, this is the current guardian set.
 	//
-	//  - We have already seen the message and generated ourVAA. In this case, use the guardian set valid at the time,
-	//    even if the guardian set was updated. Old guardian sets remain valid for longer than aggregation state,
-	//    and the guardians in the old set stay online and observe and sign messages for the transition period.
+	// During a transition, the previous guardian set remains valid on-chain until it expires, and its guardians stay
+	// online and keep observing and signing messages. We therefore accept signatures of both the current and the
+	// previous guardian set, aggregate them separately, and submit the VAA under whichever set reaches quorum first.
+	// Since the guardian set index isn't part of the signed digest, a single observation counts towards both sets.
 	//
-	//  - We have not yet seen the message. In this case, we assume the latest guardian set because that's what
-	//    we will store once we do see the message.
+	// During a transition, vaaState.signatures can contain signatures from *both* guardian sets.
 	//
-	// This ensures that during a guardian set update, a node which observed a given message with either the old
-	// or the new guardian set can achieve consensus, since both the old and the new set would achieve consensus,
-	// assuming that 2/3+ of the old and the new guardian set have seen the message and will periodically attempt
-	// to retransmit their observations such that nodes who initially dropped the signature will get a 2nd chance.
-	//
-	// During an update, vaaState.signatures can contain signatures from *both* guardian sets.
-	//
-	var gs *node_common.GuardianSet
-	if p.state.vaaSignatures[hash] != nil && p.state.vaaSignatures[hash].gs != nil {
-		gs = p.state.vaaSignatures[hash].gs
-	} else {
-		gs = p.gs
-	}
+	sets := p.guardianSets()
 
 	// We haven't yet observed the trusted guardian set on Ethereum, and therefore, it's impossible to verify it.
 	// May as well not have received it/been offline - drop it and wait for the guardian set.
-	if gs == nil {
+	if len(sets) == 0 {
 		p.logger.Warn("dropping observations since we haven't initialized our guardian set yet",
 			zap.String("digest", hash),
 			zap.String("their_addr", their_addr.Hex()),
@@ -125,15 +113,20 @@ func (p *Processor) handleObservation(ctx context.Context, m *gossipv1.SignedObs
 		return
 	}
 
-	// Verify that m.Addr is included in the guardian set. If it's not, drop the message. In case it's us
+	// Verify that m.Addr is included in one of the guardian sets. If it's not, drop the message. In case it's us
 	// who have the outdated guardian set, we'll just wait for the message to be retransmitted eventually.
-	_, ok := gs.KeyIndex(their_addr)
-	if !ok {
+	known := false
+	for _, gs := range sets {
+		if _, ok := gs.KeyIndex(their_addr); ok {
+			known = true
+		}
+	}
+	if !known {
 		p.logger.Debug("received observation by unknown guardian - is our guardian set outdated?",
 			zap.String("digest", hash),
 			zap.String("their_addr", their_addr.Hex()),
-			zap.Uint32("index", gs.Index),
-			zap.Any("keys", gs.KeysAsHexStrings()),
+			zap.Uint32("index", p.gs.Index),
+			zap.Any("keys", p.gs.KeysAsHexStrings()),
 		)
 		observationsFailedTotal.WithLabelValues("unknown_guardian").Inc()
 		return
@@ -166,8 +159,35 @@ func (p *Processor) handleObservation(ctx context.Context, m *gossipv1.SignedObs
 	}
 
 	p.state.vaaSignatures[hash].signatures[their_addr] = m.Signature
+	recordRemoteObservation(p.state.vaaSignatures[hash], their_addr, m)
+	p.recordObservation(m, p.state.vaaSignatures[hash].ourVAA)
 
-	// Aggregate all valid signatures into a list of vaa.Signature and construct signed VAA.
+	if p.state.vaaSignatures[hash].ourVAA != nil {
+		// We have seen it on chain! Try to reach quorum with each of the guardian sets.
+		for i, gs := range sets {
+			if p.state.vaaSignatures[hash].submitted {
+				break
+			}
+			p.submitWithQuorum(hash, gs, i > 0)
+		}
+		if !p.state.vaaSignatures[hash].submitted {
+			p.logger.Info("quorum not met or already submitted, doing nothing",
+				zap.String("digest", hash))
+		}
+	} else {
+		_, agg := p.aggregateSignatures(hash, p.gs)
+		p.logger.Info("we have not yet seen this VAA - temporarily storing signature",
+			zap.String("digest", hash),
+			zap.Bools("aggregation", agg))
+
+	}
+
+	p.journalState(hash)
+}
+
+// aggregateSignatures returns the signatures of the guardians of gs for the VAA with the given digest, and whether
+// each guardian of gs signed it.
+func (p *Processor) aggregateSignatures(hash string, gs *node_common.GuardianSet) ([]*vaa.Signature, []bool) {
 	agg := make([]bool, len(gs.Keys))
 	var sigs []*vaa.Signature
 	for i, a := range gs.Keys {
@@ -187,67 +207,74 @@ func (p *Processor) handleObservation(ctx context.Context, m *gossipv1.SignedObs
 
 		agg[i] = ok
 	}
+	return sigs, agg
+}
 
-	if p.state.vaaSignatures[hash].ourVAA != nil {
-		// We have seen it on chain!
-		// Deep copy the VAA and add signatures
-		v := p.state.vaaSignatures[hash].ourVAA
-		signed := &vaa.VAA{
-			Version:          v.Version,
-			GuardianSetIndex: v.GuardianSetIndex,
-			Signatures:       sigs,
-			Timestamp:        v.Timestamp,
-			Nonce:            v.Nonce,
-			Sequence:         v.Sequence,
-			EmitterChain:     v.EmitterChain,
-			TargetChain:      v.TargetChain,
-			EmitterAddress:   v.EmitterAddress,
-			Payload:          v.Payload,
-			ConsistencyLevel: v.ConsistencyLevel,
-		}
+// submitWithQuorum assembles our VAA signed by the guardians of gs, and submits it if gs reached quorum. previous
+// is set if gs is the previous guardian set of a guardian set transition.
+func (p *Processor) submitWithQuorum(hash string, gs *node_common.GuardianSet, previous bool) {
+	sigs, agg := p.aggregateSignatures(hash, gs)
+
+	// Deep copy the VAA and add signatures
+	v := p.state.vaaSignatures[hash].ourVAA
+	signed := &vaa.VAA{
+		Version:          v.Version,
+		GuardianSetIndex: gs.Index,
+		Signatures:       sigs,
+		Timestamp:        v.Timestamp,
+		Nonce:            v.Nonce,
+		Sequence:         v.Sequence,
+		EmitterChain:     v.EmitterChain,
+		TargetChain:      v.TargetChain,
+		EmitterAddress:   v.EmitterAddress,
+		Payload:          v.Payload,
+		ConsistencyLevel: v.ConsistencyLevel,
+	}
 
-		// 2/3+ majority required for VAA to be valid - wait until we have quorum to submit VAA.
-		quorum := CalculateQuorum(len(gs.Keys))
+	// 2/3+ majority required for VAA to be valid - wait until we have quorum to submit VAA.
+	quorum := CalculateQuorum(len(gs.Keys))
 
-		p.logger.Info("aggregation state for VAA",
-			zap.String("digest", hash),
-			zap.Any("set", gs.KeysAsHexStrings()),
-			zap.Uint32("index", gs.Index),
-			zap.Bools("aggregation", agg),
-			zap.Int("required_sigs", quorum),
-			zap.Int("have_sigs", len(sigs)),
-			zap.Bool("quorum", len(sigs) >= quorum),
-		)
+	p.logger.Info("aggregation state for VAA",
+		zap.String("digest", hash),
+		zap.Any("set", gs.KeysAsHexStrings()),
+		zap.Uint32("index", gs.Index),
+		zap.Bool("previous_set", previous),
+		zap.Bools("aggregation", agg),
+		zap.Int("required_sigs", quorum),
+		zap.Int("have_sigs", len(sigs)),
+		zap.Bool("quorum", len(sigs) >= quorum),
+	)
 
-		if len(sigs) >= quorum && !p.state.vaaSignatures[hash].submitted {
-			vaaBytes, err := signed.Marshal()
-			if err != nil {
-				panic(err)
-			}
+	if len(sigs) < quorum {
+		return
+	}
 
-			// Store signed VAA in database.
-			p.logger.Info("signed VAA with quorum",
-				zap.String("digest", hash),
-				zap.Any("vaa", signed),
-				zap.String("bytes", hex.EncodeToString(vaaBytes)),
-				zap.String("message_id", signed.MessageID()))
+	vaaBytes, err := signed.Marshal()
+	if err != nil {
+		panic(err)
+	}
 
-			if err := p.db.StoreSignedVAA(signed); err != nil {
-				p.logger.Error("failed to store signed VAA", zap.Error(err))
-			}
+	// Store signed VAA in database.
+	p.logger.Info("signed VAA with quorum",
+		zap.String("digest", hash),
+		zap.Any("vaa", signed),
+		zap.String("bytes", hex.EncodeToString(vaaBytes)),
+		zap.String("message_id", signed.MessageID()))
 
-			p.broadcastSignedVAA(signed)
-			p.attestationEvents.ReportVAAQuorum(signed)
-			p.state.vaaSignatures[hash].submitted = true
-		} else {
-			p.logger.Info("quorum not met or already submitted, doing nothing",
-				zap.String("digest", hash))
-		}
-	} else {
-		p.logger.Info("we have not yet seen this VAA - temporarily storing signature",
-			zap.String("digest", hash),
-			zap.Bools("aggregation", agg))
+	if err := p.db.StoreSignedVAA(signed); err != nil {
+		p.logger.Error("failed to store signed VAA", zap.Error(err))
+	}
+	if p.accountant != nil {
+		p.accountant.ApplyVAA(signed)
+	}
 
+	p.broadcastSignedVAA(signed)
+	p.attestationEvents.ReportVAAQuorum(signed)
+	p.state.vaaSignatures[hash].submitted = true
+	if previous {
+		vaaQuorumByGuardianSetTotal.WithLabelValues("previous").Inc()
+	} else {
+		vaaQuorumByGuardianSetTotal.WithLabelValues("current").Inc()
 	}
 }
 
@@ -271,8 +298,20 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(ctx context.Context, m *gos
 		return
 	}
 
+	// Verify the VAA against the guardian set it claims to be signed by. During a guardian set transition, this may
+	// be the previous guardian set.
+	gs := p.guardianSetByIndex(v.GuardianSetIndex)
+	if gs == nil {
+		p.logger.Warn("dropping SignedVAAWithQuorum message signed by an unknown or expired guardian set",
+			zap.String("digest", hash),
+			zap.Uint32("index", v.GuardianSetIndex),
+			zap.Uint32("current_index", p.gs.Index),
+		)
+		return
+	}
+
 	// Check if guardianSet doesn't have any keys
-	if len(p.gs.Keys) == 0 {
+	if len(gs.Keys) == 0 {
 		p.logger.Warn("dropping SignedVAAWithQuorum message since we have a guardian set without keys",
 			zap.String("digest", hash),
 			zap.Any("message", m),
@@ -291,7 +330,7 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(ctx context.Context, m *gos
 	}
 
 	// Verify VAA has enough signatures for quorum
-	quorum := CalculateQuorum(len(p.gs.Keys))
+	quorum := CalculateQuorum(len(gs.Keys))
 	if len(v.Signatures) < quorum {
 		p.logger.Warn("received SignedVAAWithQuorum message without quorum",
 			zap.String("digest", hash),
@@ -304,7 +343,7 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(ctx context.Context, m *gos
 	}
 
 	// Verify VAA signatures to prevent a DoS attack on our local store.
-	if !v.VerifySignatures(p.gs.Keys) {
+	if !v.VerifySignatures(gs.Keys) {
 		p.logger.Warn("received SignedVAAWithQuorum message with invalid VAA signatures",
 			zap.String("digest", hash),
 			zap.Any("message", m),
@@ -315,9 +354,11 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(ctx context.Context, m *gos
 
 	// We now established that:
 	//  - all signatures on the VAA are valid
-	//  - the signature's addresses match the node's current guardian set
+	//  - the signature's addresses match the node's current or, during a transition, previous guardian set
 	//  - enough signatures are present for the VAA to reach quorum
 
+	p.recordQuorumSignatures(v, gs)
+
 	// Check if we already store this VAA
 	_, err = p.db.GetSignedVAABytes(*db.VaaIDFromVAA(v))
 	if err == nil {
@@ -344,5 +385,8 @@ func (p *Processor) handleInboundSignedVAAWithQuorum(ctx context.Context, m *gos
 		p.logger.Error("failed to store signed VAA", zap.Error(err))
 		return
 	}
+	if p.accountant != nil {
+		p.accountant.ApplyVAA(v)
+	}
 	p.attestationEvents.ReportVAAQuorum(v)
 }
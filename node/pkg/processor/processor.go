@@ -8,11 +8,15 @@ import (
 
 	"github.com/alephium/wormhole-fork/node/pkg/db"
 	"github.com/alephium/wormhole-fork/node/pkg/ecdsasigner"
+	"github.com/alephium/wormhole-fork/node/pkg/governor"
+	"github.com/alephium/wormhole-fork/node/pkg/pause"
+	"github.com/alephium/wormhole-fork/node/pkg/policy"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"go.uber.org/zap"
 
+	"github.com/alephium/wormhole-fork/node/pkg/accountant"
 	"github.com/alephium/wormhole-fork/node/pkg/common"
 	gossipv1 "github.com/alephium/wormhole-fork/node/pkg/proto/gossip/v1"
 	"github.com/alephium/wormhole-fork/node/pkg/reporter"
@@ -46,6 +50,10 @@ type (
 		txHash []byte
 		// Copy of the guardian set valid at observation/injection time.
 		gs *common.GuardianSet
+		// Emitter chains and transaction hashes reported by each guardian for a message we haven't observed.
+		remoteObservations map[ethcommon.Address][]remoteObservation
+		// Flag set once we asked our watcher to re-observe a message other guardians signed without us.
+		selfReobserved bool
 	}
 
 	vaaMap map[string]*vaaState
@@ -70,6 +78,9 @@ type Processor struct {
 	// obsvReqSendC is a send-only channel of outbound re-observation requests to broadcast on p2p
 	obsvReqSendC chan<- *gossipv1.ObservationRequest
 
+	// obsvReqC is a send-only channel of local re-observation requests, routed to the watchers without broadcasting
+	obsvReqC chan<- *gossipv1.ObservationRequest
+
 	// signedInC is a channel of inbound signed VAA observations from p2p
 	signedInC chan *gossipv1.SignedVAAWithQuorum
 
@@ -89,12 +100,18 @@ type Processor struct {
 
 	// gs is the currently valid guardian set
 	gs *common.GuardianSet
+	// prevGs is the guardian set replaced by gs, which can still sign VAAs until prevGsExpiry, as read from the core
+	// contracts. Nil outside of guardian set transitions.
+	prevGs       *common.GuardianSet
+	prevGsExpiry time.Time
 	// gst is managed by the processor and allows concurrent access to the
 	// guardian set by other components.
 	gst *common.GuardianSetState
 
 	// state is the current runtime VAA view
 	state *aggregationState
+	// messages holds the observations of each message ID, to detect conflicting bodies
+	messages map[string]*observedMessage
 	// gk pk as eth address
 	ourAddr ethcommon.Address
 	// cleanup triggers periodic state cleanup
@@ -104,6 +121,18 @@ type Processor struct {
 
 	governanceChainId        vaa.ChainID
 	governanceEmitterAddress vaa.Address
+
+	// governor holds token bridge transfers which exceed the configured limits, nil if disabled.
+	governor *governor.ChainGovernor
+	// accountant checks token bridge transfers against the tokens locked on each chain, nil if disabled.
+	accountant *accountant.Accountant
+	// pauses holds the emitter chains, target chains and emitters whose messages are not signed, nil if disabled.
+	pauses *pause.Controller
+	// policy restricts the emitters and target chains of signed messages, nil if disabled.
+	policy *policy.Policy
+
+	// persistState enables journaling of the aggregation state in the database.
+	persistState bool
 }
 
 func NewProcessor(
@@ -114,6 +143,7 @@ func NewProcessor(
 	sendC chan []byte,
 	obsvC chan *gossipv1.SignedObservation,
 	obsvReqSendC chan<- *gossipv1.ObservationRequest,
+	obsvReqC chan<- *gossipv1.ObservationRequest,
 	injectC chan *vaa.VAA,
 	signedInC chan *gossipv1.SignedVAAWithQuorum,
 	guardianSigner ecdsasigner.ECDSASigner,
@@ -122,6 +152,11 @@ func NewProcessor(
 	notifier *discord.DiscordNotifier,
 	governanceChainId vaa.ChainID,
 	governanceEmitterAddress vaa.Address,
+	governor *governor.ChainGovernor,
+	accountant *accountant.Accountant,
+	pauses *pause.Controller,
+	policy *policy.Policy,
+	persistState bool,
 ) *Processor {
 
 	return &Processor{
@@ -130,6 +165,7 @@ func NewProcessor(
 		sendC:          sendC,
 		obsvC:          obsvC,
 		obsvReqSendC:   obsvReqSendC,
+		obsvReqC:       obsvReqC,
 		signedInC:      signedInC,
 		injectC:        injectC,
 		guardianSigner: guardianSigner,
@@ -140,28 +176,52 @@ func NewProcessor(
 
 		notifier: notifier,
 
-		logger:  supervisor.Logger(ctx),
-		state:   &aggregationState{vaaMap{}},
-		ourAddr: crypto.PubkeyToAddress(guardianSigner.PublicKey()),
+		logger:   supervisor.Logger(ctx),
+		state:    &aggregationState{vaaMap{}},
+		messages: map[string]*observedMessage{},
+		ourAddr:  crypto.PubkeyToAddress(guardianSigner.PublicKey()),
 
 		governanceChainId:        governanceChainId,
 		governanceEmitterAddress: governanceEmitterAddress,
+
+		governor:   governor,
+		accountant: accountant,
+		pauses:     pauses,
+		policy:     policy,
+
+		persistState: persistState,
 	}
 }
 
 func (p *Processor) Run(ctx context.Context) error {
+	if p.persistState {
+		if err := p.loadState(); err != nil {
+			return err
+		}
+	}
+
 	p.cleanup = time.NewTicker(30 * time.Second)
 
+	// govTimer triggers periodic checks of the transfers held by the governor
+	govTimer := time.NewTicker(time.Minute)
+	defer govTimer.Stop()
+
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
-		case p.gs = <-p.setC:
-			p.logger.Info("guardian set updated",
-				zap.Strings("set", p.gs.KeysAsHexStrings()),
-				zap.Uint32("index", p.gs.Index))
-			p.gst.Set(p.gs)
+		case gs := <-p.setC:
+			p.updateGuardianSet(gs)
 		case k := <-p.lockC:
+			if !p.signingAllowed(k) {
+				continue
+			}
+			if p.accountant != nil && !p.accountant.ProcessMsg(k) {
+				continue
+			}
+			if p.governor != nil && !p.governor.ProcessMsg(k) {
+				continue
+			}
 			p.handleMessage(ctx, k)
 		case v := <-p.injectC:
 			p.handleInjection(ctx, v)
@@ -171,6 +231,20 @@ func (p *Processor) Run(ctx context.Context) error {
 			p.handleInboundSignedVAAWithQuorum(ctx, m)
 		case <-p.cleanup.C:
 			p.handleCleanup(ctx)
+		case <-govTimer.C:
+			if p.governor != nil {
+				for _, k := range p.governor.CheckPending() {
+					// Signing may have been paused while the transfer was held.
+					if !p.signingAllowed(k) {
+						continue
+					}
+					// The ledger may have changed while the transfer was held, so it is checked again on release.
+					if p.accountant != nil && !p.accountant.ProcessMsg(k) {
+						continue
+					}
+					p.handleMessage(ctx, k)
+				}
+			}
 		}
 	}
 }
@@ -0,0 +1,140 @@
+package processor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	gossipv1 "github.com/alephium/wormhole-fork/node/pkg/proto/gossip/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+)
+
+var (
+	selfReobservationsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_aggregation_state_self_reobservations_total",
+			Help: "Total number of local re-observations of messages signed by other guardians but not by us",
+		}, []string{"emitter_chain"})
+)
+
+// selfReobservationGracePeriod is the time we wait for our own observation of a message signed by other
+// guardians, before asking our watcher to re-observe it.
+const selfReobservationGracePeriod = time.Minute
+
+// selfReobservationThreshold returns the minimum number of guardians that need to report the same transaction for a
+// message we haven't observed before we re-observe it. It's more than a third of the guardian set, so that byzantine
+// guardians can't make us look up arbitrary transactions on their own.
+func selfReobservationThreshold(numGuardians int) int {
+	return numGuardians/3 + 1
+}
+
+// maxRemoteObservations is the maximum number of distinct transactions recorded per guardian for a message.
+const maxRemoteObservations = 4
+
+// remoteObservation is the emitter chain and transaction of a message, as reported by another guardian.
+type remoteObservation struct {
+	chain  vaa.ChainID
+	txHash []byte
+}
+
+// recordRemoteObservation remembers the transaction reported by a guardian for a message we haven't observed.
+//
+// The transaction hash and message ID are not covered by the signature, so any peer can replay a guardian's
+// observation with another transaction. Every transaction reported for a guardian is kept, up to
+// maxRemoteObservations, so that replays can add transactions but not hide the one the guardian reported. The
+// watcher verifies re-observed transactions on chain.
+func recordRemoteObservation(s *vaaState, addr ethcommon.Address, m *gossipv1.SignedObservation) {
+	if s.ourVAA != nil || len(m.TxHash) == 0 {
+		return
+	}
+	id, err := vaa.VaaIDFromString(m.MessageId)
+	if err != nil {
+		return
+	}
+	observation := remoteObservation{chain: id.EmitterChain, txHash: m.TxHash}
+	reported := s.remoteObservations[addr]
+	if len(reported) >= maxRemoteObservations {
+		return
+	}
+	for _, o := range reported {
+		if o.chain == observation.chain && bytes.Equal(o.txHash, observation.txHash) {
+			return
+		}
+	}
+	if s.remoteObservations == nil {
+		s.remoteObservations = make(map[ethcommon.Address][]remoteObservation)
+	}
+	s.remoteObservations[addr] = append(reported, observation)
+}
+
+// agreedRemoteObservations returns the transactions reported by at least threshold guardians, ordered by emitter chain
+// and transaction hash.
+func agreedRemoteObservations(s *vaaState, threshold int) []remoteObservation {
+	type candidate struct {
+		remoteObservation
+		guardians int
+	}
+	candidates := make(map[string]*candidate)
+	for _, observations := range s.remoteObservations {
+		for _, o := range observations {
+			key := fmt.Sprintf("%d/%x", o.chain, o.txHash)
+			if candidates[key] == nil {
+				candidates[key] = &candidate{remoteObservation: o}
+			}
+			candidates[key].guardians++
+		}
+	}
+	agreed := make([]remoteObservation, 0)
+	for _, c := range candidates {
+		if c.guardians >= threshold {
+			agreed = append(agreed, c.remoteObservation)
+		}
+	}
+	sort.Slice(agreed, func(i, j int) bool {
+		if agreed[i].chain != agreed[j].chain {
+			return agreed[i].chain < agreed[j].chain
+		}
+		return bytes.Compare(agreed[i].txHash, agreed[j].txHash) < 0
+	})
+	return agreed
+}
+
+// selfReobserve routes re-observation requests to the watcher of a message that other guardians signed without us,
+// for each transaction reported by more than a third of the guardians. The requests are not broadcast, since the other
+// guardians already observed the message.
+func (p *Processor) selfReobserve(hash string, s *vaaState) {
+	if p.obsvReqC == nil || p.gs == nil {
+		return
+	}
+	agreed := agreedRemoteObservations(s, selfReobservationThreshold(len(p.gs.Keys)))
+	if len(agreed) == 0 {
+		return
+	}
+
+	for _, o := range agreed {
+		req := &gossipv1.ObservationRequest{
+			ChainId: uint32(o.chain),
+			TxHash:  o.txHash,
+		}
+		if err := common.PostObservationRequest(p.obsvReqC, req); err != nil {
+			p.logger.Warn("failed to send local re-observation request", zap.String("digest", hash), zap.Error(err))
+			return
+		}
+
+		p.logger.Info("re-observing message signed by other guardians",
+			zap.String("digest", hash),
+			zap.Stringer("emitter_chain", o.chain),
+			zap.String("tx_hash", hex.EncodeToString(o.txHash)),
+			zap.Int("have_sigs", len(s.signatures)))
+		selfReobservationsTotal.WithLabelValues(o.chain.String()).Inc()
+	}
+	s.selfReobserved = true
+	p.journalState(hash)
+}
@@ -0,0 +1,112 @@
+package processor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	gossipv1 "github.com/alephium/wormhole-fork/node/pkg/proto/gossip/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestSelfReobservationThreshold(t *testing.T) {
+	assert.Equal(t, 1, selfReobservationThreshold(1))
+	assert.Equal(t, 2, selfReobservationThreshold(3))
+	assert.Equal(t, 7, selfReobservationThreshold(19))
+}
+
+func TestRecordRemoteObservation(t *testing.T) {
+	v := getVAA()
+	s := &vaaState{}
+	guardian := ethcommon.Address{1}
+
+	// Observations without a valid message ID are ignored
+	recordRemoteObservation(s, guardian, &gossipv1.SignedObservation{TxHash: []byte{1}, MessageId: "invalid"})
+	assert.Nil(t, s.remoteObservations)
+
+	recordRemoteObservation(s, guardian, &gossipv1.SignedObservation{TxHash: []byte{1}, MessageId: v.MessageID()})
+	recordRemoteObservation(s, guardian, &gossipv1.SignedObservation{TxHash: []byte{1}, MessageId: v.MessageID()})
+	assert.Equal(t, []remoteObservation{{vaa.ChainIDSolana, []byte{1}}}, s.remoteObservations[guardian])
+
+	// Replays with other transactions are recorded next to the first one, up to maxRemoteObservations
+	for i := byte(2); i < 10; i++ {
+		recordRemoteObservation(s, guardian, &gossipv1.SignedObservation{TxHash: []byte{i}, MessageId: v.MessageID()})
+	}
+	assert.Equal(t, maxRemoteObservations, len(s.remoteObservations[guardian]))
+	assert.Equal(t, []byte{1}, s.remoteObservations[guardian][0].txHash)
+
+	// Messages we observed ourselves are not recorded
+	s = &vaaState{ourVAA: &v}
+	recordRemoteObservation(s, guardian, &gossipv1.SignedObservation{TxHash: []byte{1}, MessageId: v.MessageID()})
+	assert.Nil(t, s.remoteObservations)
+}
+
+func TestAgreedRemoteObservations(t *testing.T) {
+	s := &vaaState{remoteObservations: map[ethcommon.Address][]remoteObservation{
+		{1}: {{vaa.ChainIDEthereum, []byte{9}}},
+		{2}: {{vaa.ChainIDEthereum, []byte{1}}},
+		{3}: {{vaa.ChainIDEthereum, []byte{1}}, {vaa.ChainIDEthereum, []byte{9}}},
+		{4}: {{vaa.ChainIDBSC, []byte{1}}},
+	}}
+
+	// A single guardian can't choose the transaction we re-observe
+	assert.Equal(t, []remoteObservation{{vaa.ChainIDEthereum, []byte{1}}, {vaa.ChainIDEthereum, []byte{9}}}, agreedRemoteObservations(s, 2))
+	assert.Equal(t, 0, len(agreedRemoteObservations(s, 3)))
+}
+
+func TestSelfReobservation(t *testing.T) {
+	obsvReqC := make(chan *gossipv1.ObservationRequest, 1)
+	guardians := []ethcommon.Address{{1}, {2}, {3}, {4}}
+	p := &Processor{
+		logger:   zap.NewNop(),
+		obsvReqC: obsvReqC,
+		gs:       &common.GuardianSet{Keys: guardians},
+		state:    &aggregationState{vaaMap{}},
+	}
+
+	s := &vaaState{
+		firstObserved:      time.Now().Add(-2 * selfReobservationGracePeriod),
+		signatures:         map[ethcommon.Address][]byte{guardians[0]: {1}, guardians[1]: {2}},
+		remoteObservations: map[ethcommon.Address][]remoteObservation{guardians[0]: {{vaa.ChainIDEthereum, []byte{1, 2}}}},
+	}
+	p.state.vaaSignatures["00"] = s
+
+	// Not enough guardians reported the transaction yet
+	p.handleCleanup(context.Background())
+	assert.Equal(t, 0, len(obsvReqC))
+	assert.False(t, s.selfReobserved)
+
+	s.remoteObservations[guardians[1]] = []remoteObservation{{vaa.ChainIDEthereum, []byte{1, 2}}}
+	p.handleCleanup(context.Background())
+	assert.True(t, s.selfReobserved)
+	req := <-obsvReqC
+	assert.Equal(t, uint32(vaa.ChainIDEthereum), req.ChainId)
+	assert.Equal(t, []byte{1, 2}, req.TxHash)
+
+	// The message is only re-observed once
+	p.handleCleanup(context.Background())
+	assert.Equal(t, 0, len(obsvReqC))
+}
+
+func TestSelfReobservationGracePeriod(t *testing.T) {
+	obsvReqC := make(chan *gossipv1.ObservationRequest, 1)
+	guardians := []ethcommon.Address{{1}}
+	p := &Processor{
+		logger:   zap.NewNop(),
+		obsvReqC: obsvReqC,
+		gs:       &common.GuardianSet{Keys: guardians},
+		state:    &aggregationState{vaaMap{}},
+	}
+	p.state.vaaSignatures["00"] = &vaaState{
+		firstObserved:      time.Now(),
+		signatures:         map[ethcommon.Address][]byte{guardians[0]: {1}},
+		remoteObservations: map[ethcommon.Address][]remoteObservation{guardians[0]: {{vaa.ChainIDEthereum, []byte{1, 2}}}},
+	}
+
+	p.handleCleanup(context.Background())
+	assert.Equal(t, 0, len(obsvReqC))
+}
@@ -0,0 +1,132 @@
+package processor
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+)
+
+var (
+	guardianSetTransitionActive = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_guardian_set_transition_active",
+			Help: "Whether signatures are aggregated for both the current and the previous guardian set (1) or not (0)",
+		})
+	guardianSetTransitionExpiry = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "wormhole_guardian_set_transition_expiry_seconds",
+			Help: "Unix timestamp at which the previous guardian set expires, 0 if there is no transition",
+		})
+	vaaQuorumByGuardianSetTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_vaa_quorum_by_guardian_set_total",
+			Help: "Total number of VAAs which reached quorum, grouped by the guardian set (current or previous) which reached it first",
+		}, []string{"set"})
+)
+
+// updateGuardianSet replaces the current guardian set, or sets the previous guardian set if gs is a replaced guardian
+// set, which has an expiration time. The current guardian set only moves forward: chains which haven't processed the
+// upgrade yet keep reporting the older one.
+func (p *Processor) updateGuardianSet(gs *common.GuardianSet) {
+	if !gs.ExpirationTime.IsZero() {
+		p.updatePreviousGuardianSet(gs)
+		return
+	}
+	if p.gs != nil && gs.Index <= p.gs.Index {
+		if gs.Index < p.gs.Index {
+			p.logger.Debug("ignoring outdated guardian set",
+				zap.Uint32("index", gs.Index),
+				zap.Uint32("current_index", p.gs.Index))
+		}
+		return
+	}
+	// The transition of the new guardian set starts when the chains report its previous guardian set.
+	if p.prevGs != nil && p.prevGs.Index+1 != gs.Index {
+		p.prevGs = nil
+	}
+
+	p.gs = gs
+	p.logger.Info("guardian set updated",
+		zap.Strings("set", p.gs.KeysAsHexStrings()),
+		zap.Uint32("index", p.gs.Index))
+	p.gst.Set(p.gs)
+	p.publishTransition()
+}
+
+// updatePreviousGuardianSet starts or updates the transition window with the guardian set replaced by the current
+// one, as read from the core contract of a chain. The chains may expire it at different times, the earliest expiry
+// is used so that VAAs of the previous guardian set are accepted by all of them.
+func (p *Processor) updatePreviousGuardianSet(gs *common.GuardianSet) {
+	if p.gs == nil || gs.Index+1 != p.gs.Index || !time.Now().Before(gs.ExpirationTime) {
+		return
+	}
+	if p.prevGs != nil && p.prevGs.Index == gs.Index && !gs.ExpirationTime.Before(p.prevGsExpiry) {
+		return
+	}
+
+	p.prevGs = gs
+	p.prevGsExpiry = gs.ExpirationTime
+	p.logger.Info("guardian set transition",
+		zap.Uint32("previous_index", p.prevGs.Index),
+		zap.Uint32("index", p.gs.Index),
+		zap.Time("previous_expiry", p.prevGsExpiry))
+	p.publishTransition()
+}
+
+// expireTransition ends the transition window once the previous guardian set has expired.
+func (p *Processor) expireTransition() {
+	if p.prevGs == nil || time.Now().Before(p.prevGsExpiry) {
+		return
+	}
+	p.logger.Info("guardian set transition finished, previous guardian set expired",
+		zap.Uint32("previous_index", p.prevGs.Index),
+		zap.Uint32("index", p.gs.Index))
+	p.prevGs = nil
+	p.publishTransition()
+}
+
+func (p *Processor) publishTransition() {
+	if p.prevGs == nil {
+		p.gst.SetPrevious(nil, time.Time{})
+		guardianSetTransitionActive.Set(0)
+		guardianSetTransitionExpiry.Set(0)
+		return
+	}
+	p.gst.SetPrevious(p.prevGs, p.prevGsExpiry)
+	guardianSetTransitionActive.Set(1)
+	guardianSetTransitionExpiry.Set(float64(p.prevGsExpiry.Unix()))
+}
+
+// previousGuardianSet returns the previous guardian set while the transition window is open, nil otherwise.
+func (p *Processor) previousGuardianSet() *common.GuardianSet {
+	if p.prevGs == nil || !time.Now().Before(p.prevGsExpiry) {
+		return nil
+	}
+	return p.prevGs
+}
+
+// guardianSets returns the guardian sets which can sign VAAs, the current one first. During a guardian set
+// transition, this includes the previous guardian set.
+func (p *Processor) guardianSets() []*common.GuardianSet {
+	if p.gs == nil {
+		return nil
+	}
+	if prev := p.previousGuardianSet(); prev != nil {
+		return []*common.GuardianSet{p.gs, prev}
+	}
+	return []*common.GuardianSet{p.gs}
+}
+
+// guardianSetByIndex returns the current or, during a transition, the previous guardian set with the given index.
+func (p *Processor) guardianSetByIndex(index uint32) *common.GuardianSet {
+	for _, gs := range p.guardianSets() {
+		if gs.Index == index {
+			return gs
+		}
+	}
+	return nil
+}
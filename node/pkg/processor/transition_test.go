@@ -0,0 +1,199 @@
+package processor
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/alephium/wormhole-fork/node/pkg/common"
+	"github.com/alephium/wormhole-fork/node/pkg/db"
+	"github.com/alephium/wormhole-fork/node/pkg/devnet"
+	gossipv1 "github.com/alephium/wormhole-fork/node/pkg/proto/gossip/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/reporter"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTransitionProcessor(database *db.Database) *Processor {
+	return &Processor{
+		logger:            zap.NewNop(),
+		db:                database,
+		gst:               common.NewGuardianSetState(nil),
+		state:             &aggregationState{vaaMap{}},
+		messages:          map[string]*observedMessage{},
+		sendC:             make(chan []byte, 10),
+		attestationEvents: reporter.EventListener(zap.NewNop()),
+	}
+}
+
+// upgradeGuardianSet upgrades the guardian set of p from previous to current, the way the watchers report it.
+func upgradeGuardianSet(p *Processor, previous *common.GuardianSet, current *common.GuardianSet, expiry time.Time) {
+	p.updateGuardianSet(previous)
+	p.updateGuardianSet(current)
+	expiring := *previous
+	expiring.ExpirationTime = expiry
+	p.updateGuardianSet(&expiring)
+}
+
+func signedObservation(t *testing.T, v *vaa.VAA, key *ecdsa.PrivateKey) *gossipv1.SignedObservation {
+	digest := v.SigningMsg()
+	signature, err := crypto.Sign(digest.Bytes(), key)
+	require.NoError(t, err)
+	return &gossipv1.SignedObservation{
+		Addr:      crypto.PubkeyToAddress(key.PublicKey).Bytes(),
+		Hash:      digest.Bytes(),
+		Signature: signature,
+		MessageId: v.MessageID(),
+	}
+}
+
+func TestGuardianSetTransition(t *testing.T) {
+	p := newTransitionProcessor(nil)
+	previous, _ := devnet.InsecureDeterministicGuardianSet(1, 4)
+	current, _ := devnet.InsecureDeterministicGuardianSet(2, 4)
+
+	// The initial guardian set doesn't start a transition, neither does the upgrade until the expiry is known
+	p.updateGuardianSet(previous)
+	assert.Equal(t, []*common.GuardianSet{previous}, p.guardianSets())
+	p.updateGuardianSet(current)
+	assert.Equal(t, []*common.GuardianSet{current}, p.guardianSets())
+	gs, _ := p.gst.GetPrevious()
+	assert.Nil(t, gs)
+
+	expiry := time.Now().Add(time.Hour)
+	expiring := *previous
+	expiring.ExpirationTime = expiry
+	p.updateGuardianSet(&expiring)
+	assert.Equal(t, []*common.GuardianSet{current, &expiring}, p.guardianSets())
+	assert.Equal(t, &expiring, p.guardianSetByIndex(1))
+	assert.Equal(t, current, p.guardianSetByIndex(2))
+	assert.Nil(t, p.guardianSetByIndex(3))
+	gs, prevExpiry := p.gst.GetPrevious()
+	assert.Equal(t, &expiring, gs)
+	assert.Equal(t, expiry, prevExpiry)
+
+	// The earliest expiry reported by the chains is used
+	earlier := expiring
+	earlier.ExpirationTime = expiry.Add(-time.Minute)
+	p.updateGuardianSet(&earlier)
+	later := expiring
+	later.ExpirationTime = expiry.Add(time.Minute)
+	p.updateGuardianSet(&later)
+	assert.Equal(t, earlier.ExpirationTime, p.prevGsExpiry)
+
+	// Guardian sets reported again don't end the transition
+	p.updateGuardianSet(current)
+	p.expireTransition()
+	assert.Equal(t, &earlier, p.guardianSetByIndex(1))
+
+	// Neither does a chain which hasn't processed the upgrade yet
+	p.updateGuardianSet(previous)
+	assert.Equal(t, []*common.GuardianSet{current, &earlier}, p.guardianSets())
+	assert.Equal(t, current, p.gst.Get())
+
+	p.prevGsExpiry = time.Now().Add(-time.Second)
+	assert.Nil(t, p.guardianSetByIndex(1))
+	p.expireTransition()
+	assert.Nil(t, p.prevGs)
+	gs, _ = p.gst.GetPrevious()
+	assert.Nil(t, gs)
+}
+
+func TestPreviousGuardianSetIgnored(t *testing.T) {
+	p := newTransitionProcessor(nil)
+	previous, _ := devnet.InsecureDeterministicGuardianSet(1, 4)
+	current, _ := devnet.InsecureDeterministicGuardianSet(2, 4)
+	p.updateGuardianSet(current)
+
+	// Expired
+	expiring := *previous
+	expiring.ExpirationTime = time.Now().Add(-time.Second)
+	p.updateGuardianSet(&expiring)
+	assert.Nil(t, p.prevGs)
+
+	// Not replaced by the current guardian set
+	expiring = *current
+	expiring.ExpirationTime = time.Now().Add(time.Hour)
+	p.updateGuardianSet(&expiring)
+	assert.Nil(t, p.prevGs)
+	assert.Equal(t, current, p.gs)
+}
+
+func TestQuorumWithPreviousGuardianSet(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	p := newTransitionProcessor(database)
+	previous, previousKeys := devnet.InsecureDeterministicGuardianSet(1, 4)
+	current, currentKeys := devnet.InsecureDeterministicGuardianSet(2, 4)
+	upgradeGuardianSet(p, previous, current, time.Now().Add(time.Hour))
+
+	v := getVAA()
+	v.GuardianSetIndex = current.Index
+	hash := hex.EncodeToString(v.SigningMsg().Bytes())
+	p.state.vaaSignatures[hash] = &vaaState{
+		firstObserved: time.Now(),
+		ourVAA:        &v,
+		signatures:    map[ethcommon.Address][]byte{},
+		gs:            current,
+	}
+
+	// Observations of both guardian sets are aggregated, the previous set reaches quorum first
+	p.handleObservation(context.Background(), signedObservation(t, &v, currentKeys[0]))
+	for _, key := range previousKeys[:3] {
+		p.handleObservation(context.Background(), signedObservation(t, &v, key))
+	}
+	assert.Equal(t, 4, len(p.state.vaaSignatures[hash].signatures))
+	assert.True(t, p.state.vaaSignatures[hash].submitted)
+
+	b, err := database.GetSignedVAABytes(*db.VaaIDFromVAA(&v))
+	require.NoError(t, err)
+	signed, err := vaa.Unmarshal(b)
+	require.NoError(t, err)
+	assert.Equal(t, previous.Index, signed.GuardianSetIndex)
+	assert.Equal(t, 3, len(signed.Signatures))
+	assert.True(t, signed.VerifySignatures(previous.Keys))
+}
+
+func TestInboundSignedVAAWithPreviousGuardianSet(t *testing.T) {
+	database, err := db.Open(t.TempDir())
+	require.NoError(t, err)
+	defer database.Close()
+
+	p := newTransitionProcessor(database)
+	previous, previousKeys := devnet.InsecureDeterministicGuardianSet(1, 4)
+	current, _ := devnet.InsecureDeterministicGuardianSet(2, 4)
+	upgradeGuardianSet(p, previous, current, time.Now().Add(time.Hour))
+
+	signedVAA := func(sequence uint64) (*vaa.VAA, *gossipv1.SignedVAAWithQuorum) {
+		v := getVAA()
+		v.Sequence = sequence
+		v.GuardianSetIndex = previous.Index
+		for i, key := range previousKeys[:3] {
+			v.AddSignature(key, uint8(i))
+		}
+		b, err := v.Marshal()
+		require.NoError(t, err)
+		return &v, &gossipv1.SignedVAAWithQuorum{Vaa: b}
+	}
+
+	// Accepted while the previous guardian set is valid
+	v, m := signedVAA(1)
+	p.handleInboundSignedVAAWithQuorum(context.Background(), m)
+	_, err = database.GetSignedVAABytes(*db.VaaIDFromVAA(v))
+	assert.NoError(t, err)
+
+	// Dropped once it expired
+	p.prevGsExpiry = time.Now().Add(-time.Second)
+	v, m = signedVAA(2)
+	p.handleInboundSignedVAAWithQuorum(context.Background(), m)
+	_, err = database.GetSignedVAABytes(*db.VaaIDFromVAA(v))
+	assert.ErrorIs(t, err, db.ErrVAANotFound)
+}
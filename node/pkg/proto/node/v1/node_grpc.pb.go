@@ -34,6 +34,46 @@ type NodePrivilegedServiceClient interface {
 	// using the node's guardian key. The network rate limits these requests to one per second.
 	// Requests at higher rates will fail silently.
 	SendObservationRequest(ctx context.Context, in *SendObservationRequestRequest, opts ...grpc.CallOption) (*SendObservationRequestResponse, error)
+	// GovernorGetStatus returns the limits and the current usage of the chains governed by the chain governor,
+	// as well as the list of messages currently held by the governor.
+	GovernorGetStatus(ctx context.Context, in *GovernorGetStatusRequest, opts ...grpc.CallOption) (*GovernorGetStatusResponse, error)
+	// GovernorReleasePendingTransfer releases a message held by the chain governor, regardless of the limits.
+	// The message is signed on the next periodic check of the governor.
+	GovernorReleasePendingTransfer(ctx context.Context, in *GovernorReleasePendingTransferRequest, opts ...grpc.CallOption) (*GovernorReleasePendingTransferResponse, error)
+	// GovernorDropPendingTransfer removes a message held by the chain governor. The message will not be signed
+	// unless it is observed again.
+	GovernorDropPendingTransfer(ctx context.Context, in *GovernorDropPendingTransferRequest, opts ...grpc.CallOption) (*GovernorDropPendingTransferResponse, error)
+	// GetEquivocationEvidence returns the conflicting observations signed by the same guardian for the same
+	// message ID detected by this node.
+	GetEquivocationEvidence(ctx context.Context, in *GetEquivocationEvidenceRequest, opts ...grpc.CallOption) (*GetEquivocationEvidenceResponse, error)
+	// AccountantGetBalances returns the amount of each origin token locked on its origin chain and released
+	// on the other chains, according to the ledger of the token accountant.
+	AccountantGetBalances(ctx context.Context, in *AccountantGetBalancesRequest, opts ...grpc.CallOption) (*AccountantGetBalancesResponse, error)
+	// AccountantRebuild discards the ledger of the token accountant and builds it again from the VAAs
+	// stored in the local database.
+	AccountantRebuild(ctx context.Context, in *AccountantRebuildRequest, opts ...grpc.CallOption) (*AccountantRebuildResponse, error)
+	// PauseSigning stops the node from signing the messages of an emitter chain, a target chain or an emitter.
+	// Pauses are persisted, messages observed while paused are dropped and need to be re-observed once resumed.
+	PauseSigning(ctx context.Context, in *PauseSigningRequest, opts ...grpc.CallOption) (*PauseSigningResponse, error)
+	// ResumeSigning removes a pause created by PauseSigning.
+	ResumeSigning(ctx context.Context, in *ResumeSigningRequest, opts ...grpc.CallOption) (*ResumeSigningResponse, error)
+	// ListSigningPauses returns the signing pauses currently in effect.
+	ListSigningPauses(ctx context.Context, in *ListSigningPausesRequest, opts ...grpc.CallOption) (*ListSigningPausesResponse, error)
+	// ListSigningPolicyRejections returns the messages most recently rejected by the signing policy, which restricts
+	// signed messages to known emitters and registered target chains.
+	ListSigningPolicyRejections(ctx context.Context, in *ListSigningPolicyRejectionsRequest, opts ...grpc.CallOption) (*ListSigningPolicyRejectionsResponse, error)
+	// GetGuardianSetTransition returns the current guardian set and, while a guardian set transition is in progress,
+	// the previous guardian set which can still sign VAAs until it expires.
+	GetGuardianSetTransition(ctx context.Context, in *GetGuardianSetTransitionRequest, opts ...grpc.CallOption) (*GetGuardianSetTransitionResponse, error)
+	// GetGuardianSetConsistency returns the guardian set of the core contract of each chain, as last read by the
+	// guardian set monitor, and whether it matches the one of the reference chain.
+	GetGuardianSetConsistency(ctx context.Context, in *GetGuardianSetConsistencyRequest, opts ...grpc.CallOption) (*GetGuardianSetConsistencyResponse, error)
+	// GetTokenBridgeRegistrations returns the token bridge emitter registered for every other chain on the token bridge
+	// of each chain, as last read by the registration checker, compared with the configured emitters.
+	GetTokenBridgeRegistrations(ctx context.Context, in *GetTokenBridgeRegistrationsRequest, opts ...grpc.CallOption) (*GetTokenBridgeRegistrationsResponse, error)
+	// GetGovernanceStatus returns the governance state of the contracts of each chain and whether the governance VAAs
+	// stored by the node were executed on their target chains, as last checked by the governance tracker.
+	GetGovernanceStatus(ctx context.Context, in *GetGovernanceStatusRequest, opts ...grpc.CallOption) (*GetGovernanceStatusResponse, error)
 }
 
 type nodePrivilegedServiceClient struct {
@@ -71,6 +111,132 @@ func (c *nodePrivilegedServiceClient) SendObservationRequest(ctx context.Context
 	return out, nil
 }
 
+func (c *nodePrivilegedServiceClient) GovernorGetStatus(ctx context.Context, in *GovernorGetStatusRequest, opts ...grpc.CallOption) (*GovernorGetStatusResponse, error) {
+	out := new(GovernorGetStatusResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GovernorGetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GovernorReleasePendingTransfer(ctx context.Context, in *GovernorReleasePendingTransferRequest, opts ...grpc.CallOption) (*GovernorReleasePendingTransferResponse, error) {
+	out := new(GovernorReleasePendingTransferResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GovernorReleasePendingTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GovernorDropPendingTransfer(ctx context.Context, in *GovernorDropPendingTransferRequest, opts ...grpc.CallOption) (*GovernorDropPendingTransferResponse, error) {
+	out := new(GovernorDropPendingTransferResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GovernorDropPendingTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GetEquivocationEvidence(ctx context.Context, in *GetEquivocationEvidenceRequest, opts ...grpc.CallOption) (*GetEquivocationEvidenceResponse, error) {
+	out := new(GetEquivocationEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GetEquivocationEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) AccountantGetBalances(ctx context.Context, in *AccountantGetBalancesRequest, opts ...grpc.CallOption) (*AccountantGetBalancesResponse, error) {
+	out := new(AccountantGetBalancesResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/AccountantGetBalances", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) AccountantRebuild(ctx context.Context, in *AccountantRebuildRequest, opts ...grpc.CallOption) (*AccountantRebuildResponse, error) {
+	out := new(AccountantRebuildResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/AccountantRebuild", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) PauseSigning(ctx context.Context, in *PauseSigningRequest, opts ...grpc.CallOption) (*PauseSigningResponse, error) {
+	out := new(PauseSigningResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/PauseSigning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) ResumeSigning(ctx context.Context, in *ResumeSigningRequest, opts ...grpc.CallOption) (*ResumeSigningResponse, error) {
+	out := new(ResumeSigningResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/ResumeSigning", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) ListSigningPauses(ctx context.Context, in *ListSigningPausesRequest, opts ...grpc.CallOption) (*ListSigningPausesResponse, error) {
+	out := new(ListSigningPausesResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/ListSigningPauses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) ListSigningPolicyRejections(ctx context.Context, in *ListSigningPolicyRejectionsRequest, opts ...grpc.CallOption) (*ListSigningPolicyRejectionsResponse, error) {
+	out := new(ListSigningPolicyRejectionsResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/ListSigningPolicyRejections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GetGuardianSetTransition(ctx context.Context, in *GetGuardianSetTransitionRequest, opts ...grpc.CallOption) (*GetGuardianSetTransitionResponse, error) {
+	out := new(GetGuardianSetTransitionResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GetGuardianSetTransition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GetGuardianSetConsistency(ctx context.Context, in *GetGuardianSetConsistencyRequest, opts ...grpc.CallOption) (*GetGuardianSetConsistencyResponse, error) {
+	out := new(GetGuardianSetConsistencyResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GetGuardianSetConsistency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GetTokenBridgeRegistrations(ctx context.Context, in *GetTokenBridgeRegistrationsRequest, opts ...grpc.CallOption) (*GetTokenBridgeRegistrationsResponse, error) {
+	out := new(GetTokenBridgeRegistrationsResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GetTokenBridgeRegistrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nodePrivilegedServiceClient) GetGovernanceStatus(ctx context.Context, in *GetGovernanceStatusRequest, opts ...grpc.CallOption) (*GetGovernanceStatusResponse, error) {
+	out := new(GetGovernanceStatusResponse)
+	err := c.cc.Invoke(ctx, "/node.v1.NodePrivilegedService/GetGovernanceStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NodePrivilegedServiceServer is the server API for NodePrivilegedService service.
 // All implementations must embed UnimplementedNodePrivilegedServiceServer
 // for forward compatibility
@@ -91,6 +257,46 @@ type NodePrivilegedServiceServer interface {
 	// using the node's guardian key. The network rate limits these requests to one per second.
 	// Requests at higher rates will fail silently.
 	SendObservationRequest(context.Context, *SendObservationRequestRequest) (*SendObservationRequestResponse, error)
+	// GovernorGetStatus returns the limits and the current usage of the chains governed by the chain governor,
+	// as well as the list of messages currently held by the governor.
+	GovernorGetStatus(context.Context, *GovernorGetStatusRequest) (*GovernorGetStatusResponse, error)
+	// GovernorReleasePendingTransfer releases a message held by the chain governor, regardless of the limits.
+	// The message is signed on the next periodic check of the governor.
+	GovernorReleasePendingTransfer(context.Context, *GovernorReleasePendingTransferRequest) (*GovernorReleasePendingTransferResponse, error)
+	// GovernorDropPendingTransfer removes a message held by the chain governor. The message will not be signed
+	// unless it is observed again.
+	GovernorDropPendingTransfer(context.Context, *GovernorDropPendingTransferRequest) (*GovernorDropPendingTransferResponse, error)
+	// GetEquivocationEvidence returns the conflicting observations signed by the same guardian for the same
+	// message ID detected by this node.
+	GetEquivocationEvidence(context.Context, *GetEquivocationEvidenceRequest) (*GetEquivocationEvidenceResponse, error)
+	// AccountantGetBalances returns the amount of each origin token locked on its origin chain and released
+	// on the other chains, according to the ledger of the token accountant.
+	AccountantGetBalances(context.Context, *AccountantGetBalancesRequest) (*AccountantGetBalancesResponse, error)
+	// AccountantRebuild discards the ledger of the token accountant and builds it again from the VAAs
+	// stored in the local database.
+	AccountantRebuild(context.Context, *AccountantRebuildRequest) (*AccountantRebuildResponse, error)
+	// PauseSigning stops the node from signing the messages of an emitter chain, a target chain or an emitter.
+	// Pauses are persisted, messages observed while paused are dropped and need to be re-observed once resumed.
+	PauseSigning(context.Context, *PauseSigningRequest) (*PauseSigningResponse, error)
+	// ResumeSigning removes a pause created by PauseSigning.
+	ResumeSigning(context.Context, *ResumeSigningRequest) (*ResumeSigningResponse, error)
+	// ListSigningPauses returns the signing pauses currently in effect.
+	ListSigningPauses(context.Context, *ListSigningPausesRequest) (*ListSigningPausesResponse, error)
+	// ListSigningPolicyRejections returns the messages most recently rejected by the signing policy, which restricts
+	// signed messages to known emitters and registered target chains.
+	ListSigningPolicyRejections(context.Context, *ListSigningPolicyRejectionsRequest) (*ListSigningPolicyRejectionsResponse, error)
+	// GetGuardianSetTransition returns the current guardian set and, while a guardian set transition is in progress,
+	// the previous guardian set which can still sign VAAs until it expires.
+	GetGuardianSetTransition(context.Context, *GetGuardianSetTransitionRequest) (*GetGuardianSetTransitionResponse, error)
+	// GetGuardianSetConsistency returns the guardian set of the core contract of each chain, as last read by the
+	// guardian set monitor, and whether it matches the one of the reference chain.
+	GetGuardianSetConsistency(context.Context, *GetGuardianSetConsistencyRequest) (*GetGuardianSetConsistencyResponse, error)
+	// GetTokenBridgeRegistrations returns the token bridge emitter registered for every other chain on the token bridge
+	// of each chain, as last read by the registration checker, compared with the configured emitters.
+	GetTokenBridgeRegistrations(context.Context, *GetTokenBridgeRegistrationsRequest) (*GetTokenBridgeRegistrationsResponse, error)
+	// GetGovernanceStatus returns the governance state of the contracts of each chain and whether the governance VAAs
+	// stored by the node were executed on their target chains, as last checked by the governance tracker.
+	GetGovernanceStatus(context.Context, *GetGovernanceStatusRequest) (*GetGovernanceStatusResponse, error)
 	mustEmbedUnimplementedNodePrivilegedServiceServer()
 }
 
@@ -107,6 +313,48 @@ func (UnimplementedNodePrivilegedServiceServer) FindMissingMessages(context.Cont
 func (UnimplementedNodePrivilegedServiceServer) SendObservationRequest(context.Context, *SendObservationRequestRequest) (*SendObservationRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendObservationRequest not implemented")
 }
+func (UnimplementedNodePrivilegedServiceServer) GovernorGetStatus(context.Context, *GovernorGetStatusRequest) (*GovernorGetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernorGetStatus not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GovernorReleasePendingTransfer(context.Context, *GovernorReleasePendingTransferRequest) (*GovernorReleasePendingTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernorReleasePendingTransfer not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GovernorDropPendingTransfer(context.Context, *GovernorDropPendingTransferRequest) (*GovernorDropPendingTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovernorDropPendingTransfer not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GetEquivocationEvidence(context.Context, *GetEquivocationEvidenceRequest) (*GetEquivocationEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEquivocationEvidence not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) AccountantGetBalances(context.Context, *AccountantGetBalancesRequest) (*AccountantGetBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantGetBalances not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) AccountantRebuild(context.Context, *AccountantRebuildRequest) (*AccountantRebuildResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountantRebuild not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) PauseSigning(context.Context, *PauseSigningRequest) (*PauseSigningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSigning not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) ResumeSigning(context.Context, *ResumeSigningRequest) (*ResumeSigningResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSigning not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) ListSigningPauses(context.Context, *ListSigningPausesRequest) (*ListSigningPausesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSigningPauses not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) ListSigningPolicyRejections(context.Context, *ListSigningPolicyRejectionsRequest) (*ListSigningPolicyRejectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSigningPolicyRejections not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GetGuardianSetTransition(context.Context, *GetGuardianSetTransitionRequest) (*GetGuardianSetTransitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuardianSetTransition not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GetGuardianSetConsistency(context.Context, *GetGuardianSetConsistencyRequest) (*GetGuardianSetConsistencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuardianSetConsistency not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GetTokenBridgeRegistrations(context.Context, *GetTokenBridgeRegistrationsRequest) (*GetTokenBridgeRegistrationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTokenBridgeRegistrations not implemented")
+}
+func (UnimplementedNodePrivilegedServiceServer) GetGovernanceStatus(context.Context, *GetGovernanceStatusRequest) (*GetGovernanceStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGovernanceStatus not implemented")
+}
 func (UnimplementedNodePrivilegedServiceServer) mustEmbedUnimplementedNodePrivilegedServiceServer() {}
 
 // UnsafeNodePrivilegedServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -174,6 +422,258 @@ func _NodePrivilegedService_SendObservationRequest_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NodePrivilegedService_GovernorGetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GovernorGetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GovernorGetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GovernorGetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GovernorGetStatus(ctx, req.(*GovernorGetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GovernorReleasePendingTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GovernorReleasePendingTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GovernorReleasePendingTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GovernorReleasePendingTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GovernorReleasePendingTransfer(ctx, req.(*GovernorReleasePendingTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GovernorDropPendingTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GovernorDropPendingTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GovernorDropPendingTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GovernorDropPendingTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GovernorDropPendingTransfer(ctx, req.(*GovernorDropPendingTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GetEquivocationEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEquivocationEvidenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GetEquivocationEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GetEquivocationEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GetEquivocationEvidence(ctx, req.(*GetEquivocationEvidenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_AccountantGetBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountantGetBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).AccountantGetBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/AccountantGetBalances",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).AccountantGetBalances(ctx, req.(*AccountantGetBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_AccountantRebuild_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountantRebuildRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).AccountantRebuild(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/AccountantRebuild",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).AccountantRebuild(ctx, req.(*AccountantRebuildRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_PauseSigning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSigningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).PauseSigning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/PauseSigning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).PauseSigning(ctx, req.(*PauseSigningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_ResumeSigning_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSigningRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).ResumeSigning(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/ResumeSigning",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).ResumeSigning(ctx, req.(*ResumeSigningRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_ListSigningPauses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSigningPausesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).ListSigningPauses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/ListSigningPauses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).ListSigningPauses(ctx, req.(*ListSigningPausesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_ListSigningPolicyRejections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSigningPolicyRejectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).ListSigningPolicyRejections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/ListSigningPolicyRejections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).ListSigningPolicyRejections(ctx, req.(*ListSigningPolicyRejectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GetGuardianSetTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuardianSetTransitionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GetGuardianSetTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GetGuardianSetTransition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GetGuardianSetTransition(ctx, req.(*GetGuardianSetTransitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GetGuardianSetConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGuardianSetConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GetGuardianSetConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GetGuardianSetConsistency",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GetGuardianSetConsistency(ctx, req.(*GetGuardianSetConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GetTokenBridgeRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenBridgeRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GetTokenBridgeRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GetTokenBridgeRegistrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GetTokenBridgeRegistrations(ctx, req.(*GetTokenBridgeRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NodePrivilegedService_GetGovernanceStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGovernanceStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodePrivilegedServiceServer).GetGovernanceStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/node.v1.NodePrivilegedService/GetGovernanceStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodePrivilegedServiceServer).GetGovernanceStatus(ctx, req.(*GetGovernanceStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NodePrivilegedService_ServiceDesc is the grpc.ServiceDesc for NodePrivilegedService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -193,6 +693,62 @@ var NodePrivilegedService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendObservationRequest",
 			Handler:    _NodePrivilegedService_SendObservationRequest_Handler,
 		},
+		{
+			MethodName: "GovernorGetStatus",
+			Handler:    _NodePrivilegedService_GovernorGetStatus_Handler,
+		},
+		{
+			MethodName: "GovernorReleasePendingTransfer",
+			Handler:    _NodePrivilegedService_GovernorReleasePendingTransfer_Handler,
+		},
+		{
+			MethodName: "GovernorDropPendingTransfer",
+			Handler:    _NodePrivilegedService_GovernorDropPendingTransfer_Handler,
+		},
+		{
+			MethodName: "GetEquivocationEvidence",
+			Handler:    _NodePrivilegedService_GetEquivocationEvidence_Handler,
+		},
+		{
+			MethodName: "AccountantGetBalances",
+			Handler:    _NodePrivilegedService_AccountantGetBalances_Handler,
+		},
+		{
+			MethodName: "AccountantRebuild",
+			Handler:    _NodePrivilegedService_AccountantRebuild_Handler,
+		},
+		{
+			MethodName: "PauseSigning",
+			Handler:    _NodePrivilegedService_PauseSigning_Handler,
+		},
+		{
+			MethodName: "ResumeSigning",
+			Handler:    _NodePrivilegedService_ResumeSigning_Handler,
+		},
+		{
+			MethodName: "ListSigningPauses",
+			Handler:    _NodePrivilegedService_ListSigningPauses_Handler,
+		},
+		{
+			MethodName: "ListSigningPolicyRejections",
+			Handler:    _NodePrivilegedService_ListSigningPolicyRejections_Handler,
+		},
+		{
+			MethodName: "GetGuardianSetTransition",
+			Handler:    _NodePrivilegedService_GetGuardianSetTransition_Handler,
+		},
+		{
+			MethodName: "GetGuardianSetConsistency",
+			Handler:    _NodePrivilegedService_GetGuardianSetConsistency_Handler,
+		},
+		{
+			MethodName: "GetTokenBridgeRegistrations",
+			Handler:    _NodePrivilegedService_GetTokenBridgeRegistrations_Handler,
+		},
+		{
+			MethodName: "GetGovernanceStatus",
+			Handler:    _NodePrivilegedService_GetGovernanceStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "node/v1/node.proto",
@@ -511,6 +511,167 @@ func (x *GetGovernanceVAABatchResponse) GetEntries() []*GetGovernanceVAABatchRes
 	return nil
 }
 
+type EmitterFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Emitter chain ID.
+	ChainId ChainID `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3,enum=publicrpc.v1.ChainID" json:"chain_id,omitempty"`
+	// Hex-encoded (without leading 0x) emitter address.
+	EmitterAddress string `protobuf:"bytes,2,opt,name=emitter_address,json=emitterAddress,proto3" json:"emitter_address,omitempty"`
+}
+
+func (x *EmitterFilter) Reset() {
+	*x = EmitterFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmitterFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmitterFilter) ProtoMessage() {}
+
+func (x *EmitterFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmitterFilter.ProtoReflect.Descriptor instead.
+func (*EmitterFilter) Descriptor() ([]byte, []int) {
+	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EmitterFilter) GetChainId() ChainID {
+	if x != nil {
+		return x.ChainId
+	}
+	return ChainID_CHAIN_ID_UNSPECIFIED
+}
+
+func (x *EmitterFilter) GetEmitterAddress() string {
+	if x != nil {
+		return x.EmitterAddress
+	}
+	return ""
+}
+
+type StreamSignedVAAsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// VAAs from any emitter are streamed if empty.
+	EmitterFilters []*EmitterFilter `protobuf:"bytes,1,rep,name=emitter_filters,json=emitterFilters,proto3" json:"emitter_filters,omitempty"`
+	// VAAs to any target chain are streamed if empty.
+	TargetChains []ChainID `protobuf:"varint,2,rep,packed,name=target_chains,json=targetChains,proto3,enum=publicrpc.v1.ChainID" json:"target_chains,omitempty"`
+}
+
+func (x *StreamSignedVAAsRequest) Reset() {
+	*x = StreamSignedVAAsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamSignedVAAsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSignedVAAsRequest) ProtoMessage() {}
+
+func (x *StreamSignedVAAsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSignedVAAsRequest.ProtoReflect.Descriptor instead.
+func (*StreamSignedVAAsRequest) Descriptor() ([]byte, []int) {
+	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *StreamSignedVAAsRequest) GetEmitterFilters() []*EmitterFilter {
+	if x != nil {
+		return x.EmitterFilters
+	}
+	return nil
+}
+
+func (x *StreamSignedVAAsRequest) GetTargetChains() []ChainID {
+	if x != nil {
+		return x.TargetChains
+	}
+	return nil
+}
+
+type StreamSignedVAAsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VaaBytes []byte `protobuf:"bytes,1,opt,name=vaa_bytes,json=vaaBytes,proto3" json:"vaa_bytes,omitempty"`
+}
+
+func (x *StreamSignedVAAsResponse) Reset() {
+	*x = StreamSignedVAAsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamSignedVAAsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSignedVAAsResponse) ProtoMessage() {}
+
+func (x *StreamSignedVAAsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSignedVAAsResponse.ProtoReflect.Descriptor instead.
+func (*StreamSignedVAAsResponse) Descriptor() ([]byte, []int) {
+	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *StreamSignedVAAsResponse) GetVaaBytes() []byte {
+	if x != nil {
+		return x.VaaBytes
+	}
+	return nil
+}
+
 type GetLastHeartbeatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -520,7 +681,7 @@ type GetLastHeartbeatsRequest struct {
 func (x *GetLastHeartbeatsRequest) Reset() {
 	*x = GetLastHeartbeatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[7]
+		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -533,7 +694,7 @@ func (x *GetLastHeartbeatsRequest) String() string {
 func (*GetLastHeartbeatsRequest) ProtoMessage() {}
 
 func (x *GetLastHeartbeatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[7]
+	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,7 +707,7 @@ func (x *GetLastHeartbeatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastHeartbeatsRequest.ProtoReflect.Descriptor instead.
 func (*GetLastHeartbeatsRequest) Descriptor() ([]byte, []int) {
-	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{7}
+	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{10}
 }
 
 type GetLastHeartbeatsResponse struct {
@@ -560,7 +721,7 @@ type GetLastHeartbeatsResponse struct {
 func (x *GetLastHeartbeatsResponse) Reset() {
 	*x = GetLastHeartbeatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[8]
+		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -573,7 +734,7 @@ func (x *GetLastHeartbeatsResponse) String() string {
 func (*GetLastHeartbeatsResponse) ProtoMessage() {}
 
 func (x *GetLastHeartbeatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[8]
+	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -586,7 +747,7 @@ func (x *GetLastHeartbeatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastHeartbeatsResponse.ProtoReflect.Descriptor instead.
 func (*GetLastHeartbeatsResponse) Descriptor() ([]byte, []int) {
-	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{8}
+	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetLastHeartbeatsResponse) GetEntries() []*GetLastHeartbeatsResponse_Entry {
@@ -605,7 +766,7 @@ type GetCurrentGuardianSetRequest struct {
 func (x *GetCurrentGuardianSetRequest) Reset() {
 	*x = GetCurrentGuardianSetRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[9]
+		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -618,7 +779,7 @@ func (x *GetCurrentGuardianSetRequest) String() string {
 func (*GetCurrentGuardianSetRequest) ProtoMessage() {}
 
 func (x *GetCurrentGuardianSetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[9]
+	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -631,7 +792,7 @@ func (x *GetCurrentGuardianSetRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCurrentGuardianSetRequest.ProtoReflect.Descriptor instead.
 func (*GetCurrentGuardianSetRequest) Descriptor() ([]byte, []int) {
-	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{9}
+	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{12}
 }
 
 type GetCurrentGuardianSetResponse struct {
@@ -645,7 +806,7 @@ type GetCurrentGuardianSetResponse struct {
 func (x *GetCurrentGuardianSetResponse) Reset() {
 	*x = GetCurrentGuardianSetResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[10]
+		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -658,7 +819,7 @@ func (x *GetCurrentGuardianSetResponse) String() string {
 func (*GetCurrentGuardianSetResponse) ProtoMessage() {}
 
 func (x *GetCurrentGuardianSetResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[10]
+	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,7 +832,7 @@ func (x *GetCurrentGuardianSetResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetCurrentGuardianSetResponse.ProtoReflect.Descriptor instead.
 func (*GetCurrentGuardianSetResponse) Descriptor() ([]byte, []int) {
-	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{10}
+	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetCurrentGuardianSetResponse) GetGuardianSet() *GuardianSet {
@@ -695,7 +856,7 @@ type GuardianSet struct {
 func (x *GuardianSet) Reset() {
 	*x = GuardianSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[11]
+		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -708,7 +869,7 @@ func (x *GuardianSet) String() string {
 func (*GuardianSet) ProtoMessage() {}
 
 func (x *GuardianSet) ProtoReflect() protoreflect.Message {
-	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[11]
+	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -721,7 +882,7 @@ func (x *GuardianSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GuardianSet.ProtoReflect.Descriptor instead.
 func (*GuardianSet) Descriptor() ([]byte, []int) {
-	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{11}
+	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GuardianSet) GetIndex() uint32 {
@@ -750,7 +911,7 @@ type GetNonGovernanceVAABatchResponse_Entry struct {
 func (x *GetNonGovernanceVAABatchResponse_Entry) Reset() {
 	*x = GetNonGovernanceVAABatchResponse_Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[12]
+		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -763,7 +924,7 @@ func (x *GetNonGovernanceVAABatchResponse_Entry) String() string {
 func (*GetNonGovernanceVAABatchResponse_Entry) ProtoMessage() {}
 
 func (x *GetNonGovernanceVAABatchResponse_Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[12]
+	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -806,7 +967,7 @@ type GetGovernanceVAABatchResponse_Entry struct {
 func (x *GetGovernanceVAABatchResponse_Entry) Reset() {
 	*x = GetGovernanceVAABatchResponse_Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[13]
+		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -819,7 +980,7 @@ func (x *GetGovernanceVAABatchResponse_Entry) String() string {
 func (*GetGovernanceVAABatchResponse_Entry) ProtoMessage() {}
 
 func (x *GetGovernanceVAABatchResponse_Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[13]
+	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -876,7 +1037,7 @@ type GetLastHeartbeatsResponse_Entry struct {
 func (x *GetLastHeartbeatsResponse_Entry) Reset() {
 	*x = GetLastHeartbeatsResponse_Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[14]
+		mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -889,7 +1050,7 @@ func (x *GetLastHeartbeatsResponse_Entry) String() string {
 func (*GetLastHeartbeatsResponse_Entry) ProtoMessage() {}
 
 func (x *GetLastHeartbeatsResponse_Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[14]
+	mi := &file_publicrpc_v1_publicrpc_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,7 +1063,7 @@ func (x *GetLastHeartbeatsResponse_Entry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastHeartbeatsResponse_Entry.ProtoReflect.Descriptor instead.
 func (*GetLastHeartbeatsResponse_Entry) Descriptor() ([]byte, []int) {
-	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{8, 0}
+	return file_publicrpc_v1_publicrpc_proto_rawDescGZIP(), []int{11, 0}
 }
 
 func (x *GetLastHeartbeatsResponse_Entry) GetVerifiedGuardianAddr() string {
@@ -1000,117 +1161,144 @@ var file_publicrpc_v1_publicrpc_proto_rawDesc = []byte{
 	0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
 	0x6e, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x61, 0x61, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x76, 0x61, 0x61, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74,
-	0x62, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x83, 0x02, 0x0a,
-	0x19, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x07, 0x65, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61,
-	0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x1a, 0x9c, 0x01, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x34, 0x0a,
-	0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69,
-	0x61, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x76,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x32, 0x70, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x32, 0x70, 0x4e,
-	0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x0d, 0x72, 0x61, 0x77, 0x5f, 0x68,
-	0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74,
-	0x62, 0x65, 0x61, 0x74, 0x52, 0x0c, 0x72, 0x61, 0x77, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
-	0x61, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x5d, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x5f,
-	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61,
-	0x6e, 0x53, 0x65, 0x74, 0x52, 0x0b, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x65,
-	0x74, 0x22, 0x41, 0x0a, 0x0b, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x65, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x2a, 0xbe, 0x03, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44,
-	0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x55, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x48,
-	0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x53, 0x4f, 0x4c, 0x41, 0x4e, 0x41, 0x10, 0x01, 0x12,
-	0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x45, 0x54, 0x48, 0x45,
-	0x52, 0x45, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f,
-	0x49, 0x44, 0x5f, 0x54, 0x45, 0x52, 0x52, 0x41, 0x10, 0x03, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x48,
-	0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x42, 0x53, 0x43, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10,
-	0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x50, 0x4f, 0x4c, 0x59, 0x47, 0x4f, 0x4e,
-	0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x41,
-	0x56, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48,
-	0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x4f, 0x41, 0x53, 0x49, 0x53, 0x10, 0x07, 0x12, 0x15,
-	0x0a, 0x11, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x41, 0x4c, 0x47, 0x4f, 0x52,
-	0x41, 0x4e, 0x44, 0x10, 0x08, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49,
-	0x44, 0x5f, 0x41, 0x55, 0x52, 0x4f, 0x52, 0x41, 0x10, 0x09, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x48,
-	0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x46, 0x41, 0x4e, 0x54, 0x4f, 0x4d, 0x10, 0x0a, 0x12,
-	0x13, 0x0a, 0x0f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x4b, 0x41, 0x52, 0x55,
-	0x52, 0x41, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44,
-	0x5f, 0x41, 0x43, 0x41, 0x4c, 0x41, 0x10, 0x0c, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x48, 0x41, 0x49,
-	0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x4b, 0x4c, 0x41, 0x59, 0x54, 0x4e, 0x10, 0x0d, 0x12, 0x11, 0x0a,
-	0x0d, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x43, 0x45, 0x4c, 0x4f, 0x10, 0x0e,
-	0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x4e, 0x45, 0x41,
-	0x52, 0x10, 0x0f, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f,
-	0x4d, 0x4f, 0x4f, 0x4e, 0x42, 0x45, 0x41, 0x4d, 0x10, 0x10, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48,
-	0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x4e, 0x45, 0x4f, 0x4e, 0x10, 0x11, 0x12, 0x16, 0x0a,
-	0x11, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x41, 0x4c, 0x45, 0x50, 0x48, 0x49,
-	0x55, 0x4d, 0x10, 0xff, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49,
-	0x44, 0x5f, 0x45, 0x54, 0x48, 0x45, 0x52, 0x45, 0x55, 0x4d, 0x5f, 0x52, 0x4f, 0x50, 0x53, 0x54,
-	0x45, 0x4e, 0x10, 0x91, 0x4e, 0x32, 0xed, 0x05, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x52, 0x50, 0x43, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7c, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x4c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x12,
-	0x26, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47,
-	0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x48, 0x65,
-	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x68, 0x65,
-	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x12, 0xd5, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x74,
-	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x12, 0x21, 0x2e, 0x70, 0x75, 0x62, 0x6c,
-	0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e,
-	0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70,
+	0x22, 0x6a, 0x0a, 0x0d, 0x45, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x9b, 0x01, 0x0a,
+	0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0f, 0x65, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31,
+	0x2e, 0x45, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x0e,
+	0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3a,
+	0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x37, 0x0a, 0x18, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x61, 0x61, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x76, 0x61, 0x61, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x83, 0x02, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74,
+	0x62, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65,
+	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x9c, 0x01, 0x0a, 0x05, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x34, 0x0a, 0x16, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69,
+	0x61, 0x6e, 0x41, 0x64, 0x64, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x70, 0x32, 0x70, 0x5f, 0x6e, 0x6f,
+	0x64, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x32, 0x70, 0x4e, 0x6f, 0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x0d, 0x72, 0x61,
+	0x77, 0x5f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x52, 0x0c, 0x72, 0x61, 0x77, 0x48, 0x65, 0x61, 0x72,
+	0x74, 0x62, 0x65, 0x61, 0x74, 0x22, 0x1e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5d, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69,
+	0x61, 0x6e, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x75, 0x61, 0x72,
+	0x64, 0x69, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x0b, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61,
+	0x6e, 0x53, 0x65, 0x74, 0x22, 0x41, 0x0a, 0x0b, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e,
+	0x53, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x2a, 0xbe, 0x03, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x13, 0x0a,
+	0x0f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x53, 0x4f, 0x4c, 0x41, 0x4e, 0x41,
+	0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x45,
+	0x54, 0x48, 0x45, 0x52, 0x45, 0x55, 0x4d, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x41,
+	0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x54, 0x45, 0x52, 0x52, 0x41, 0x10, 0x03, 0x12, 0x10, 0x0a,
+	0x0c, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x42, 0x53, 0x43, 0x10, 0x04, 0x12,
+	0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x50, 0x4f, 0x4c, 0x59,
+	0x47, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49,
+	0x44, 0x5f, 0x41, 0x56, 0x41, 0x4c, 0x41, 0x4e, 0x43, 0x48, 0x45, 0x10, 0x06, 0x12, 0x12, 0x0a,
+	0x0e, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x4f, 0x41, 0x53, 0x49, 0x53, 0x10,
+	0x07, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x41, 0x4c,
+	0x47, 0x4f, 0x52, 0x41, 0x4e, 0x44, 0x10, 0x08, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x48, 0x41, 0x49,
+	0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x41, 0x55, 0x52, 0x4f, 0x52, 0x41, 0x10, 0x09, 0x12, 0x13, 0x0a,
+	0x0f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x46, 0x41, 0x4e, 0x54, 0x4f, 0x4d,
+	0x10, 0x0a, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x4b,
+	0x41, 0x52, 0x55, 0x52, 0x41, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x41, 0x49, 0x4e,
+	0x5f, 0x49, 0x44, 0x5f, 0x41, 0x43, 0x41, 0x4c, 0x41, 0x10, 0x0c, 0x12, 0x13, 0x0a, 0x0f, 0x43,
+	0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x4b, 0x4c, 0x41, 0x59, 0x54, 0x4e, 0x10, 0x0d,
+	0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x43, 0x45, 0x4c,
+	0x4f, 0x10, 0x0e, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f,
+	0x4e, 0x45, 0x41, 0x52, 0x10, 0x0f, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f,
+	0x49, 0x44, 0x5f, 0x4d, 0x4f, 0x4f, 0x4e, 0x42, 0x45, 0x41, 0x4d, 0x10, 0x10, 0x12, 0x11, 0x0a,
+	0x0d, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x4e, 0x45, 0x4f, 0x4e, 0x10, 0x11,
+	0x12, 0x16, 0x0a, 0x11, 0x43, 0x48, 0x41, 0x49, 0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x41, 0x4c, 0x45,
+	0x50, 0x48, 0x49, 0x55, 0x4d, 0x10, 0xff, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x43, 0x48, 0x41, 0x49,
+	0x4e, 0x5f, 0x49, 0x44, 0x5f, 0x45, 0x54, 0x48, 0x45, 0x52, 0x45, 0x55, 0x4d, 0x5f, 0x52, 0x4f,
+	0x50, 0x53, 0x54, 0x45, 0x4e, 0x10, 0x91, 0x4e, 0x32, 0xd4, 0x06, 0x0a, 0x10, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x52, 0x50, 0x43, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7c, 0x0a,
+	0x11, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61,
+	0x74, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
+	0x74, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x12, 0x0e, 0x2f, 0x76, 0x31,
+	0x2f, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x73, 0x12, 0xd5, 0x01, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x12, 0x21, 0x2e, 0x70,
 	0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x7e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x78, 0x12, 0x76, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x61, 0x2f, 0x7b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x5f, 0x69, 0x64, 0x2e, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x2e,
-	0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
-	0x2f, 0x7b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x2e, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x7d,
-	0x12, 0x7b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x6e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e,
-	0x61, 0x6e, 0x63, 0x65, 0x56, 0x41, 0x41, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2d, 0x2e, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e,
-	0x6f, 0x6e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x56, 0x41, 0x41, 0x42,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f,
-	0x6e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x56, 0x41, 0x41, 0x42, 0x61,
-	0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x72, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x56, 0x41,
-	0x41, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2a, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72,
-	0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61,
-	0x6e, 0x63, 0x65, 0x56, 0x41, 0x41, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76,
-	0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x56,
-	0x41, 0x41, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x91, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x2a, 0x2e, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x65, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12, 0x17, 0x2f, 0x76,
-	0x31, 0x2f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x65, 0x74, 0x2f, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x65, 0x70, 0x68, 0x69, 0x75, 0x6d, 0x2f, 0x77, 0x6f, 0x72,
-	0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x2d, 0x66, 0x6f, 0x72, 0x6b, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f,
-	0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x7e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x78, 0x12, 0x76, 0x2f, 0x76, 0x31,
+	0x2f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x61, 0x2f, 0x7b, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x2e, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x64, 0x2e, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x7d, 0x2f, 0x7b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x2e,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x7d, 0x2f, 0x7b, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x2e, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x7d, 0x12, 0x7b, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x6e, 0x47, 0x6f, 0x76,
+	0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x56, 0x41, 0x41, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x2d, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x6e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x56,
+	0x41, 0x41, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x6e, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x56, 0x41,
+	0x41, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x72, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e, 0x63,
+	0x65, 0x56, 0x41, 0x41, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x2a, 0x2e, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x76, 0x65,
+	0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x56, 0x41, 0x41, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70,
+	0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x6f, 0x76, 0x65, 0x72, 0x6e, 0x61, 0x6e,
+	0x63, 0x65, 0x56, 0x41, 0x41, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x91, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x12, 0x2a,
+	0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x53, 0x65, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x12,
+	0x17, 0x2f, 0x76, 0x31, 0x2f, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x73, 0x65, 0x74,
+	0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x65, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x73, 0x12, 0x25, 0x2e, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56,
+	0x41, 0x41, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42,
+	0x4b, 0x5a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c,
+	0x65, 0x70, 0x68, 0x69, 0x75, 0x6d, 0x2f, 0x77, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x2d,
+	0x66, 0x6f, 0x72, 0x6b, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31,
+	0x3b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1126,7 +1314,7 @@ func file_publicrpc_v1_publicrpc_proto_rawDescGZIP() []byte {
 }
 
 var file_publicrpc_v1_publicrpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_publicrpc_v1_publicrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_publicrpc_v1_publicrpc_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_publicrpc_v1_publicrpc_proto_goTypes = []interface{}{
 	(ChainID)(0),                                   // 0: publicrpc.v1.ChainID
 	(*MessageID)(nil),                              // 1: publicrpc.v1.MessageID
@@ -1136,15 +1324,18 @@ var file_publicrpc_v1_publicrpc_proto_goTypes = []interface{}{
 	(*GetNonGovernanceVAABatchResponse)(nil),       // 5: publicrpc.v1.GetNonGovernanceVAABatchResponse
 	(*GetGovernanceVAABatchRequest)(nil),           // 6: publicrpc.v1.GetGovernanceVAABatchRequest
 	(*GetGovernanceVAABatchResponse)(nil),          // 7: publicrpc.v1.GetGovernanceVAABatchResponse
-	(*GetLastHeartbeatsRequest)(nil),               // 8: publicrpc.v1.GetLastHeartbeatsRequest
-	(*GetLastHeartbeatsResponse)(nil),              // 9: publicrpc.v1.GetLastHeartbeatsResponse
-	(*GetCurrentGuardianSetRequest)(nil),           // 10: publicrpc.v1.GetCurrentGuardianSetRequest
-	(*GetCurrentGuardianSetResponse)(nil),          // 11: publicrpc.v1.GetCurrentGuardianSetResponse
-	(*GuardianSet)(nil),                            // 12: publicrpc.v1.GuardianSet
-	(*GetNonGovernanceVAABatchResponse_Entry)(nil), // 13: publicrpc.v1.GetNonGovernanceVAABatchResponse.Entry
-	(*GetGovernanceVAABatchResponse_Entry)(nil),    // 14: publicrpc.v1.GetGovernanceVAABatchResponse.Entry
-	(*GetLastHeartbeatsResponse_Entry)(nil),        // 15: publicrpc.v1.GetLastHeartbeatsResponse.Entry
-	(*v1.Heartbeat)(nil),                           // 16: gossip.v1.Heartbeat
+	(*EmitterFilter)(nil),                          // 8: publicrpc.v1.EmitterFilter
+	(*StreamSignedVAAsRequest)(nil),                // 9: publicrpc.v1.StreamSignedVAAsRequest
+	(*StreamSignedVAAsResponse)(nil),               // 10: publicrpc.v1.StreamSignedVAAsResponse
+	(*GetLastHeartbeatsRequest)(nil),               // 11: publicrpc.v1.GetLastHeartbeatsRequest
+	(*GetLastHeartbeatsResponse)(nil),              // 12: publicrpc.v1.GetLastHeartbeatsResponse
+	(*GetCurrentGuardianSetRequest)(nil),           // 13: publicrpc.v1.GetCurrentGuardianSetRequest
+	(*GetCurrentGuardianSetResponse)(nil),          // 14: publicrpc.v1.GetCurrentGuardianSetResponse
+	(*GuardianSet)(nil),                            // 15: publicrpc.v1.GuardianSet
+	(*GetNonGovernanceVAABatchResponse_Entry)(nil), // 16: publicrpc.v1.GetNonGovernanceVAABatchResponse.Entry
+	(*GetGovernanceVAABatchResponse_Entry)(nil),    // 17: publicrpc.v1.GetGovernanceVAABatchResponse.Entry
+	(*GetLastHeartbeatsResponse_Entry)(nil),        // 18: publicrpc.v1.GetLastHeartbeatsResponse.Entry
+	(*v1.Heartbeat)(nil),                           // 19: gossip.v1.Heartbeat
 }
 var file_publicrpc_v1_publicrpc_proto_depIdxs = []int32{
 	0,  // 0: publicrpc.v1.MessageID.emitter_chain:type_name -> publicrpc.v1.ChainID
@@ -1152,27 +1343,32 @@ var file_publicrpc_v1_publicrpc_proto_depIdxs = []int32{
 	1,  // 2: publicrpc.v1.GetSignedVAARequest.message_id:type_name -> publicrpc.v1.MessageID
 	0,  // 3: publicrpc.v1.GetNonGovernanceVAABatchRequest.emitter_chain:type_name -> publicrpc.v1.ChainID
 	0,  // 4: publicrpc.v1.GetNonGovernanceVAABatchRequest.target_chain:type_name -> publicrpc.v1.ChainID
-	13, // 5: publicrpc.v1.GetNonGovernanceVAABatchResponse.entries:type_name -> publicrpc.v1.GetNonGovernanceVAABatchResponse.Entry
-	14, // 6: publicrpc.v1.GetGovernanceVAABatchResponse.entries:type_name -> publicrpc.v1.GetGovernanceVAABatchResponse.Entry
-	15, // 7: publicrpc.v1.GetLastHeartbeatsResponse.entries:type_name -> publicrpc.v1.GetLastHeartbeatsResponse.Entry
-	12, // 8: publicrpc.v1.GetCurrentGuardianSetResponse.guardian_set:type_name -> publicrpc.v1.GuardianSet
-	0,  // 9: publicrpc.v1.GetGovernanceVAABatchResponse.Entry.target_chain:type_name -> publicrpc.v1.ChainID
-	16, // 10: publicrpc.v1.GetLastHeartbeatsResponse.Entry.raw_heartbeat:type_name -> gossip.v1.Heartbeat
-	8,  // 11: publicrpc.v1.PublicRPCService.GetLastHeartbeats:input_type -> publicrpc.v1.GetLastHeartbeatsRequest
-	2,  // 12: publicrpc.v1.PublicRPCService.GetSignedVAA:input_type -> publicrpc.v1.GetSignedVAARequest
-	4,  // 13: publicrpc.v1.PublicRPCService.GetNonGovernanceVAABatch:input_type -> publicrpc.v1.GetNonGovernanceVAABatchRequest
-	6,  // 14: publicrpc.v1.PublicRPCService.GetGovernanceVAABatch:input_type -> publicrpc.v1.GetGovernanceVAABatchRequest
-	10, // 15: publicrpc.v1.PublicRPCService.GetCurrentGuardianSet:input_type -> publicrpc.v1.GetCurrentGuardianSetRequest
-	9,  // 16: publicrpc.v1.PublicRPCService.GetLastHeartbeats:output_type -> publicrpc.v1.GetLastHeartbeatsResponse
-	3,  // 17: publicrpc.v1.PublicRPCService.GetSignedVAA:output_type -> publicrpc.v1.GetSignedVAAResponse
-	5,  // 18: publicrpc.v1.PublicRPCService.GetNonGovernanceVAABatch:output_type -> publicrpc.v1.GetNonGovernanceVAABatchResponse
-	7,  // 19: publicrpc.v1.PublicRPCService.GetGovernanceVAABatch:output_type -> publicrpc.v1.GetGovernanceVAABatchResponse
-	11, // 20: publicrpc.v1.PublicRPCService.GetCurrentGuardianSet:output_type -> publicrpc.v1.GetCurrentGuardianSetResponse
-	16, // [16:21] is the sub-list for method output_type
-	11, // [11:16] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	16, // 5: publicrpc.v1.GetNonGovernanceVAABatchResponse.entries:type_name -> publicrpc.v1.GetNonGovernanceVAABatchResponse.Entry
+	17, // 6: publicrpc.v1.GetGovernanceVAABatchResponse.entries:type_name -> publicrpc.v1.GetGovernanceVAABatchResponse.Entry
+	0,  // 7: publicrpc.v1.EmitterFilter.chain_id:type_name -> publicrpc.v1.ChainID
+	8,  // 8: publicrpc.v1.StreamSignedVAAsRequest.emitter_filters:type_name -> publicrpc.v1.EmitterFilter
+	0,  // 9: publicrpc.v1.StreamSignedVAAsRequest.target_chains:type_name -> publicrpc.v1.ChainID
+	18, // 10: publicrpc.v1.GetLastHeartbeatsResponse.entries:type_name -> publicrpc.v1.GetLastHeartbeatsResponse.Entry
+	15, // 11: publicrpc.v1.GetCurrentGuardianSetResponse.guardian_set:type_name -> publicrpc.v1.GuardianSet
+	0,  // 12: publicrpc.v1.GetGovernanceVAABatchResponse.Entry.target_chain:type_name -> publicrpc.v1.ChainID
+	19, // 13: publicrpc.v1.GetLastHeartbeatsResponse.Entry.raw_heartbeat:type_name -> gossip.v1.Heartbeat
+	11, // 14: publicrpc.v1.PublicRPCService.GetLastHeartbeats:input_type -> publicrpc.v1.GetLastHeartbeatsRequest
+	2,  // 15: publicrpc.v1.PublicRPCService.GetSignedVAA:input_type -> publicrpc.v1.GetSignedVAARequest
+	4,  // 16: publicrpc.v1.PublicRPCService.GetNonGovernanceVAABatch:input_type -> publicrpc.v1.GetNonGovernanceVAABatchRequest
+	6,  // 17: publicrpc.v1.PublicRPCService.GetGovernanceVAABatch:input_type -> publicrpc.v1.GetGovernanceVAABatchRequest
+	13, // 18: publicrpc.v1.PublicRPCService.GetCurrentGuardianSet:input_type -> publicrpc.v1.GetCurrentGuardianSetRequest
+	9,  // 19: publicrpc.v1.PublicRPCService.StreamSignedVAAs:input_type -> publicrpc.v1.StreamSignedVAAsRequest
+	12, // 20: publicrpc.v1.PublicRPCService.GetLastHeartbeats:output_type -> publicrpc.v1.GetLastHeartbeatsResponse
+	3,  // 21: publicrpc.v1.PublicRPCService.GetSignedVAA:output_type -> publicrpc.v1.GetSignedVAAResponse
+	5,  // 22: publicrpc.v1.PublicRPCService.GetNonGovernanceVAABatch:output_type -> publicrpc.v1.GetNonGovernanceVAABatchResponse
+	7,  // 23: publicrpc.v1.PublicRPCService.GetGovernanceVAABatch:output_type -> publicrpc.v1.GetGovernanceVAABatchResponse
+	14, // 24: publicrpc.v1.PublicRPCService.GetCurrentGuardianSet:output_type -> publicrpc.v1.GetCurrentGuardianSetResponse
+	10, // 25: publicrpc.v1.PublicRPCService.StreamSignedVAAs:output_type -> publicrpc.v1.StreamSignedVAAsResponse
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
 }
 
 func init() { file_publicrpc_v1_publicrpc_proto_init() }
@@ -1266,7 +1462,7 @@ func file_publicrpc_v1_publicrpc_proto_init() {
 			}
 		}
 		file_publicrpc_v1_publicrpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastHeartbeatsRequest); i {
+			switch v := v.(*EmitterFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1278,7 +1474,7 @@ func file_publicrpc_v1_publicrpc_proto_init() {
 			}
 		}
 		file_publicrpc_v1_publicrpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastHeartbeatsResponse); i {
+			switch v := v.(*StreamSignedVAAsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1290,7 +1486,7 @@ func file_publicrpc_v1_publicrpc_proto_init() {
 			}
 		}
 		file_publicrpc_v1_publicrpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCurrentGuardianSetRequest); i {
+			switch v := v.(*StreamSignedVAAsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1302,7 +1498,7 @@ func file_publicrpc_v1_publicrpc_proto_init() {
 			}
 		}
 		file_publicrpc_v1_publicrpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCurrentGuardianSetResponse); i {
+			switch v := v.(*GetLastHeartbeatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1314,7 +1510,7 @@ func file_publicrpc_v1_publicrpc_proto_init() {
 			}
 		}
 		file_publicrpc_v1_publicrpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GuardianSet); i {
+			switch v := v.(*GetLastHeartbeatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1326,7 +1522,7 @@ func file_publicrpc_v1_publicrpc_proto_init() {
 			}
 		}
 		file_publicrpc_v1_publicrpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNonGovernanceVAABatchResponse_Entry); i {
+			switch v := v.(*GetCurrentGuardianSetRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1338,7 +1534,7 @@ func file_publicrpc_v1_publicrpc_proto_init() {
 			}
 		}
 		file_publicrpc_v1_publicrpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetGovernanceVAABatchResponse_Entry); i {
+			switch v := v.(*GetCurrentGuardianSetResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1350,6 +1546,42 @@ func file_publicrpc_v1_publicrpc_proto_init() {
 			}
 		}
 		file_publicrpc_v1_publicrpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GuardianSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_publicrpc_v1_publicrpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNonGovernanceVAABatchResponse_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_publicrpc_v1_publicrpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGovernanceVAABatchResponse_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_publicrpc_v1_publicrpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetLastHeartbeatsResponse_Entry); i {
 			case 0:
 				return &v.state
@@ -1368,7 +1600,7 @@ func file_publicrpc_v1_publicrpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_publicrpc_v1_publicrpc_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   15,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -295,6 +295,31 @@ func local_request_PublicRPCService_GetCurrentGuardianSet_0(ctx context.Context,
 
 }
 
+func request_PublicRPCService_StreamSignedVAAs_0(ctx context.Context, marshaler runtime.Marshaler, client PublicRPCServiceClient, req *http.Request, pathParams map[string]string) (PublicRPCService_StreamSignedVAAsClient, runtime.ServerMetadata, error) {
+	var protoReq StreamSignedVAAsRequest
+	var metadata runtime.ServerMetadata
+
+	newReader, berr := utilities.IOReaderFactory(req.Body)
+	if berr != nil {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", berr)
+	}
+	if err := marshaler.NewDecoder(newReader()).Decode(&protoReq); err != nil && err != io.EOF {
+		return nil, metadata, status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+
+	stream, err := client.StreamSignedVAAs(ctx, &protoReq)
+	if err != nil {
+		return nil, metadata, err
+	}
+	header, err := stream.Header()
+	if err != nil {
+		return nil, metadata, err
+	}
+	metadata.HeaderMD = header
+	return stream, metadata, nil
+
+}
+
 // RegisterPublicRPCServiceHandlerServer registers the http handlers for service PublicRPCService to "mux".
 // UnaryRPC     :call PublicRPCServiceServer directly.
 // StreamingRPC :currently unsupported pending https://github.com/grpc/grpc-go/issues/906.
@@ -416,6 +441,13 @@ func RegisterPublicRPCServiceHandlerServer(ctx context.Context, mux *runtime.Ser
 
 	})
 
+	mux.Handle("POST", pattern_PublicRPCService_StreamSignedVAAs_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		err := status.Error(codes.Unimplemented, "streaming calls are not yet supported in the in-process transport")
+		_, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+		return
+	})
+
 	return nil
 }
 
@@ -557,6 +589,26 @@ func RegisterPublicRPCServiceHandlerClient(ctx context.Context, mux *runtime.Ser
 
 	})
 
+	mux.Handle("POST", pattern_PublicRPCService_StreamSignedVAAs_0, func(w http.ResponseWriter, req *http.Request, pathParams map[string]string) {
+		ctx, cancel := context.WithCancel(req.Context())
+		defer cancel()
+		inboundMarshaler, outboundMarshaler := runtime.MarshalerForRequest(mux, req)
+		rctx, err := runtime.AnnotateContext(ctx, mux, req, "/publicrpc.v1.PublicRPCService/StreamSignedVAAs", runtime.WithHTTPPathPattern("/publicrpc.v1.PublicRPCService/StreamSignedVAAs"))
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+		resp, md, err := request_PublicRPCService_StreamSignedVAAs_0(rctx, inboundMarshaler, client, req, pathParams)
+		ctx = runtime.NewServerMetadataContext(ctx, md)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, outboundMarshaler, w, req, err)
+			return
+		}
+
+		forward_PublicRPCService_StreamSignedVAAs_0(ctx, mux, outboundMarshaler, w, req, func() (proto.Message, error) { return resp.Recv() }, mux.GetForwardResponseOptions()...)
+
+	})
+
 	return nil
 }
 
@@ -570,6 +622,8 @@ var (
 	pattern_PublicRPCService_GetGovernanceVAABatch_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"publicrpc.v1.PublicRPCService", "GetGovernanceVAABatch"}, ""))
 
 	pattern_PublicRPCService_GetCurrentGuardianSet_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1, 2, 2}, []string{"v1", "guardianset", "current"}, ""))
+
+	pattern_PublicRPCService_StreamSignedVAAs_0 = runtime.MustPattern(runtime.NewPattern(1, []int{2, 0, 2, 1}, []string{"publicrpc.v1.PublicRPCService", "StreamSignedVAAs"}, ""))
 )
 
 var (
@@ -582,4 +636,6 @@ var (
 	forward_PublicRPCService_GetGovernanceVAABatch_0 = runtime.ForwardResponseMessage
 
 	forward_PublicRPCService_GetCurrentGuardianSet_0 = runtime.ForwardResponseMessage
+
+	forward_PublicRPCService_StreamSignedVAAs_0 = runtime.ForwardResponseStream
 )
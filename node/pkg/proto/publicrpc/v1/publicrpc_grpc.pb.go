@@ -26,6 +26,10 @@ type PublicRPCServiceClient interface {
 	GetNonGovernanceVAABatch(ctx context.Context, in *GetNonGovernanceVAABatchRequest, opts ...grpc.CallOption) (*GetNonGovernanceVAABatchResponse, error)
 	GetGovernanceVAABatch(ctx context.Context, in *GetGovernanceVAABatchRequest, opts ...grpc.CallOption) (*GetGovernanceVAABatchResponse, error)
 	GetCurrentGuardianSet(ctx context.Context, in *GetCurrentGuardianSetRequest, opts ...grpc.CallOption) (*GetCurrentGuardianSetResponse, error)
+	// StreamSignedVAAs streams the VAAs reaching quorum on this node from now on. Filters of the same type
+	// are combined with OR, and the resulting groups with AND. Slow clients are disconnected.
+	// Over REST, the stream is served as server-sent events on /v1/signed_vaa/stream.
+	StreamSignedVAAs(ctx context.Context, in *StreamSignedVAAsRequest, opts ...grpc.CallOption) (PublicRPCService_StreamSignedVAAsClient, error)
 }
 
 type publicRPCServiceClient struct {
@@ -81,6 +85,38 @@ func (c *publicRPCServiceClient) GetCurrentGuardianSet(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *publicRPCServiceClient) StreamSignedVAAs(ctx context.Context, in *StreamSignedVAAsRequest, opts ...grpc.CallOption) (PublicRPCService_StreamSignedVAAsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PublicRPCService_ServiceDesc.Streams[0], "/publicrpc.v1.PublicRPCService/StreamSignedVAAs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &publicRPCServiceStreamSignedVAAsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PublicRPCService_StreamSignedVAAsClient interface {
+	Recv() (*StreamSignedVAAsResponse, error)
+	grpc.ClientStream
+}
+
+type publicRPCServiceStreamSignedVAAsClient struct {
+	grpc.ClientStream
+}
+
+func (x *publicRPCServiceStreamSignedVAAsClient) Recv() (*StreamSignedVAAsResponse, error) {
+	m := new(StreamSignedVAAsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // PublicRPCServiceServer is the server API for PublicRPCService service.
 // All implementations must embed UnimplementedPublicRPCServiceServer
 // for forward compatibility
@@ -93,6 +129,10 @@ type PublicRPCServiceServer interface {
 	GetNonGovernanceVAABatch(context.Context, *GetNonGovernanceVAABatchRequest) (*GetNonGovernanceVAABatchResponse, error)
 	GetGovernanceVAABatch(context.Context, *GetGovernanceVAABatchRequest) (*GetGovernanceVAABatchResponse, error)
 	GetCurrentGuardianSet(context.Context, *GetCurrentGuardianSetRequest) (*GetCurrentGuardianSetResponse, error)
+	// StreamSignedVAAs streams the VAAs reaching quorum on this node from now on. Filters of the same type
+	// are combined with OR, and the resulting groups with AND. Slow clients are disconnected.
+	// Over REST, the stream is served as server-sent events on /v1/signed_vaa/stream.
+	StreamSignedVAAs(*StreamSignedVAAsRequest, PublicRPCService_StreamSignedVAAsServer) error
 	mustEmbedUnimplementedPublicRPCServiceServer()
 }
 
@@ -115,6 +155,9 @@ func (UnimplementedPublicRPCServiceServer) GetGovernanceVAABatch(context.Context
 func (UnimplementedPublicRPCServiceServer) GetCurrentGuardianSet(context.Context, *GetCurrentGuardianSetRequest) (*GetCurrentGuardianSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCurrentGuardianSet not implemented")
 }
+func (UnimplementedPublicRPCServiceServer) StreamSignedVAAs(*StreamSignedVAAsRequest, PublicRPCService_StreamSignedVAAsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamSignedVAAs not implemented")
+}
 func (UnimplementedPublicRPCServiceServer) mustEmbedUnimplementedPublicRPCServiceServer() {}
 
 // UnsafePublicRPCServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -218,6 +261,27 @@ func _PublicRPCService_GetCurrentGuardianSet_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PublicRPCService_StreamSignedVAAs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamSignedVAAsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PublicRPCServiceServer).StreamSignedVAAs(m, &publicRPCServiceStreamSignedVAAsServer{stream})
+}
+
+type PublicRPCService_StreamSignedVAAsServer interface {
+	Send(*StreamSignedVAAsResponse) error
+	grpc.ServerStream
+}
+
+type publicRPCServiceStreamSignedVAAsServer struct {
+	grpc.ServerStream
+}
+
+func (x *publicRPCServiceStreamSignedVAAsServer) Send(m *StreamSignedVAAsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // PublicRPCService_ServiceDesc is the grpc.ServiceDesc for PublicRPCService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -246,6 +310,12 @@ var PublicRPCService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _PublicRPCService_GetCurrentGuardianSet_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSignedVAAs",
+			Handler:       _PublicRPCService_StreamSignedVAAs_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "publicrpc/v1/publicrpc.proto",
 }
@@ -80,6 +80,161 @@ func (x *EmitterFilter) GetEmitterAddress() string {
 	return ""
 }
 
+// A TargetChainFilter matches VAAs destined to the given chain.
+type TargetChainFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId v1.ChainID `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3,enum=publicrpc.v1.ChainID" json:"chain_id,omitempty"`
+}
+
+func (x *TargetChainFilter) Reset() {
+	*x = TargetChainFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TargetChainFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetChainFilter) ProtoMessage() {}
+
+func (x *TargetChainFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetChainFilter.ProtoReflect.Descriptor instead.
+func (*TargetChainFilter) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TargetChainFilter) GetChainId() v1.ChainID {
+	if x != nil {
+		return x.ChainId
+	}
+	return v1.ChainID(0)
+}
+
+// A PayloadIdFilter matches VAAs whose payload starts with the given byte,
+// e.g. 1 for token transfers and 2 for token attestations.
+type PayloadIdFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PayloadId uint32 `protobuf:"varint,1,opt,name=payload_id,json=payloadId,proto3" json:"payload_id,omitempty"`
+}
+
+func (x *PayloadIdFilter) Reset() {
+	*x = PayloadIdFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PayloadIdFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PayloadIdFilter) ProtoMessage() {}
+
+func (x *PayloadIdFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PayloadIdFilter.ProtoReflect.Descriptor instead.
+func (*PayloadIdFilter) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PayloadIdFilter) GetPayloadId() uint32 {
+	if x != nil {
+		return x.PayloadId
+	}
+	return 0
+}
+
+// A SequenceRangeFilter matches VAAs whose sequence is in the given range.
+type SequenceRangeFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Inclusive lower bound.
+	FromSequence uint64 `protobuf:"varint,1,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	// Inclusive upper bound, 0 if there is no upper bound.
+	ToSequence uint64 `protobuf:"varint,2,opt,name=to_sequence,json=toSequence,proto3" json:"to_sequence,omitempty"`
+}
+
+func (x *SequenceRangeFilter) Reset() {
+	*x = SequenceRangeFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SequenceRangeFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SequenceRangeFilter) ProtoMessage() {}
+
+func (x *SequenceRangeFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SequenceRangeFilter.ProtoReflect.Descriptor instead.
+func (*SequenceRangeFilter) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SequenceRangeFilter) GetFromSequence() uint64 {
+	if x != nil {
+		return x.FromSequence
+	}
+	return 0
+}
+
+func (x *SequenceRangeFilter) GetToSequence() uint64 {
+	if x != nil {
+		return x.ToSequence
+	}
+	return 0
+}
+
 type FilterEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -88,13 +243,16 @@ type FilterEntry struct {
 	// Types that are assignable to Filter:
 	//
 	//	*FilterEntry_EmitterFilter
+	//	*FilterEntry_TargetChainFilter
+	//	*FilterEntry_PayloadIdFilter
+	//	*FilterEntry_SequenceRangeFilter
 	Filter isFilterEntry_Filter `protobuf_oneof:"filter"`
 }
 
 func (x *FilterEntry) Reset() {
 	*x = FilterEntry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[1]
+		mi := &file_spy_v1_spy_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -107,7 +265,7 @@ func (x *FilterEntry) String() string {
 func (*FilterEntry) ProtoMessage() {}
 
 func (x *FilterEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[1]
+	mi := &file_spy_v1_spy_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -120,7 +278,7 @@ func (x *FilterEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FilterEntry.ProtoReflect.Descriptor instead.
 func (*FilterEntry) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{1}
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{4}
 }
 
 func (m *FilterEntry) GetFilter() isFilterEntry_Filter {
@@ -137,6 +295,27 @@ func (x *FilterEntry) GetEmitterFilter() *EmitterFilter {
 	return nil
 }
 
+func (x *FilterEntry) GetTargetChainFilter() *TargetChainFilter {
+	if x, ok := x.GetFilter().(*FilterEntry_TargetChainFilter); ok {
+		return x.TargetChainFilter
+	}
+	return nil
+}
+
+func (x *FilterEntry) GetPayloadIdFilter() *PayloadIdFilter {
+	if x, ok := x.GetFilter().(*FilterEntry_PayloadIdFilter); ok {
+		return x.PayloadIdFilter
+	}
+	return nil
+}
+
+func (x *FilterEntry) GetSequenceRangeFilter() *SequenceRangeFilter {
+	if x, ok := x.GetFilter().(*FilterEntry_SequenceRangeFilter); ok {
+		return x.SequenceRangeFilter
+	}
+	return nil
+}
+
 type isFilterEntry_Filter interface {
 	isFilterEntry_Filter()
 }
@@ -145,22 +324,119 @@ type FilterEntry_EmitterFilter struct {
 	EmitterFilter *EmitterFilter `protobuf:"bytes,1,opt,name=emitter_filter,json=emitterFilter,proto3,oneof"`
 }
 
+type FilterEntry_TargetChainFilter struct {
+	TargetChainFilter *TargetChainFilter `protobuf:"bytes,2,opt,name=target_chain_filter,json=targetChainFilter,proto3,oneof"`
+}
+
+type FilterEntry_PayloadIdFilter struct {
+	PayloadIdFilter *PayloadIdFilter `protobuf:"bytes,3,opt,name=payload_id_filter,json=payloadIdFilter,proto3,oneof"`
+}
+
+type FilterEntry_SequenceRangeFilter struct {
+	SequenceRangeFilter *SequenceRangeFilter `protobuf:"bytes,4,opt,name=sequence_range_filter,json=sequenceRangeFilter,proto3,oneof"`
+}
+
 func (*FilterEntry_EmitterFilter) isFilterEntry_Filter() {}
 
+func (*FilterEntry_TargetChainFilter) isFilterEntry_Filter() {}
+
+func (*FilterEntry_PayloadIdFilter) isFilterEntry_Filter() {}
+
+func (*FilterEntry_SequenceRangeFilter) isFilterEntry_Filter() {}
+
+// A ReplayFrom requests the VAAs of an emitter to a target chain stored by the spy,
+// starting at the given sequence.
+type ReplayFrom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EmitterChain v1.ChainID `protobuf:"varint,1,opt,name=emitter_chain,json=emitterChain,proto3,enum=publicrpc.v1.ChainID" json:"emitter_chain,omitempty"`
+	// Hex-encoded (without leading 0x) emitter address.
+	EmitterAddress string     `protobuf:"bytes,2,opt,name=emitter_address,json=emitterAddress,proto3" json:"emitter_address,omitempty"`
+	TargetChain    v1.ChainID `protobuf:"varint,3,opt,name=target_chain,json=targetChain,proto3,enum=publicrpc.v1.ChainID" json:"target_chain,omitempty"`
+	Sequence       uint64     `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (x *ReplayFrom) Reset() {
+	*x = ReplayFrom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spy_v1_spy_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReplayFrom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayFrom) ProtoMessage() {}
+
+func (x *ReplayFrom) ProtoReflect() protoreflect.Message {
+	mi := &file_spy_v1_spy_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayFrom.ProtoReflect.Descriptor instead.
+func (*ReplayFrom) Descriptor() ([]byte, []int) {
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReplayFrom) GetEmitterChain() v1.ChainID {
+	if x != nil {
+		return x.EmitterChain
+	}
+	return v1.ChainID(0)
+}
+
+func (x *ReplayFrom) GetEmitterAddress() string {
+	if x != nil {
+		return x.EmitterAddress
+	}
+	return ""
+}
+
+func (x *ReplayFrom) GetTargetChain() v1.ChainID {
+	if x != nil {
+		return x.TargetChain
+	}
+	return v1.ChainID(0)
+}
+
+func (x *ReplayFrom) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
 type SubscribeSignedVAARequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// List of filters to apply to the stream (OR).
+	// List of filters to apply to the stream. Filters of the same type are combined
+	// with OR, and the resulting groups with AND. For instance, two emitter filters
+	// and a target chain filter match VAAs from either emitter to the target chain.
 	// If empty, all messages are streamed.
 	Filters []*FilterEntry `protobuf:"bytes,1,rep,name=filters,proto3" json:"filters,omitempty"`
+	// Stored VAAs to stream in sequence order before switching to live VAAs, the
+	// filters apply to them as well. Requires the spy to run with a VAA store.
+	Replay []*ReplayFrom `protobuf:"bytes,2,rep,name=replay,proto3" json:"replay,omitempty"`
 }
 
 func (x *SubscribeSignedVAARequest) Reset() {
 	*x = SubscribeSignedVAARequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[2]
+		mi := &file_spy_v1_spy_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -173,7 +449,7 @@ func (x *SubscribeSignedVAARequest) String() string {
 func (*SubscribeSignedVAARequest) ProtoMessage() {}
 
 func (x *SubscribeSignedVAARequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[2]
+	mi := &file_spy_v1_spy_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -186,7 +462,7 @@ func (x *SubscribeSignedVAARequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeSignedVAARequest.ProtoReflect.Descriptor instead.
 func (*SubscribeSignedVAARequest) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{2}
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SubscribeSignedVAARequest) GetFilters() []*FilterEntry {
@@ -196,6 +472,13 @@ func (x *SubscribeSignedVAARequest) GetFilters() []*FilterEntry {
 	return nil
 }
 
+func (x *SubscribeSignedVAARequest) GetReplay() []*ReplayFrom {
+	if x != nil {
+		return x.Replay
+	}
+	return nil
+}
+
 type SubscribeSignedVAAResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -208,7 +491,7 @@ type SubscribeSignedVAAResponse struct {
 func (x *SubscribeSignedVAAResponse) Reset() {
 	*x = SubscribeSignedVAAResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spy_v1_spy_proto_msgTypes[3]
+		mi := &file_spy_v1_spy_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -221,7 +504,7 @@ func (x *SubscribeSignedVAAResponse) String() string {
 func (*SubscribeSignedVAAResponse) ProtoMessage() {}
 
 func (x *SubscribeSignedVAAResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spy_v1_spy_proto_msgTypes[3]
+	mi := &file_spy_v1_spy_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,7 +517,7 @@ func (x *SubscribeSignedVAAResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeSignedVAAResponse.ProtoReflect.Descriptor instead.
 func (*SubscribeSignedVAAResponse) Descriptor() ([]byte, []int) {
-	return file_spy_v1_spy_proto_rawDescGZIP(), []int{3}
+	return file_spy_v1_spy_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SubscribeSignedVAAResponse) GetVaaBytes() []byte {
@@ -259,35 +542,78 @@ var file_spy_v1_spy_proto_rawDesc = []byte{
 	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6d, 0x69,
 	0x74, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0e, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x22, 0x57, 0x0a, 0x0b, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x3e, 0x0a, 0x0e, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x48, 0x00, 0x52, 0x0d, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x42, 0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x4a, 0x0a, 0x19, 0x53,
+	0x73, 0x73, 0x22, 0x45, 0x0a, 0x11, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x30, 0x0a, 0x0f, 0x50, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x13, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x53,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f,
+	0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22, 0xbe, 0x02, 0x0a, 0x0b, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x3e, 0x0a, 0x0e, 0x65, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0d, 0x65, 0x6d, 0x69, 0x74, 0x74,
+	0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x13, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x48, 0x00, 0x52, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x11, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x69, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x15,
+	0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x70,
+	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x13, 0x73, 0x65, 0x71, 0x75,
+	0x65, 0x6e, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x42,
+	0x08, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xc7, 0x01, 0x0a, 0x0a, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x3a, 0x0a, 0x0d, 0x65, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x15, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x0c, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x38, 0x0a,
+	0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x72, 0x70, 0x63, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x52, 0x0b, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x22, 0x76, 0x0a, 0x19, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2d, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x2a, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x46,
+	0x72, 0x6f, 0x6d, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x22, 0x39, 0x0a, 0x1a, 0x53,
 	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41,
-	0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x79, 0x2e,
-	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x39, 0x0a, 0x1a, 0x53, 0x75, 0x62, 0x73, 0x63,
-	0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x61, 0x61, 0x5f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x76, 0x61, 0x61, 0x42, 0x79, 0x74,
-	0x65, 0x73, 0x32, 0x94, 0x01, 0x0a, 0x0d, 0x53, 0x70, 0x79, 0x52, 0x50, 0x43, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x12, 0x21, 0x2e, 0x73, 0x70,
-	0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69,
-	0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
-	0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x3a,
-	0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
-	0x5f, 0x76, 0x61, 0x61, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x65, 0x70, 0x68, 0x69, 0x75, 0x6d,
-	0x2f, 0x77, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x2d, 0x66, 0x6f, 0x72, 0x6b, 0x2f, 0x6e,
-	0x6f, 0x64, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70,
-	0x79, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x70, 0x79, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x41, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x61, 0x61,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x76, 0x61,
+	0x61, 0x42, 0x79, 0x74, 0x65, 0x73, 0x32, 0x94, 0x01, 0x0a, 0x0d, 0x53, 0x70, 0x79, 0x52, 0x50,
+	0x43, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x12, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x12,
+	0x21, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70, 0x79, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x56, 0x41, 0x41, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18,
+	0x2f, 0x76, 0x31, 0x3a, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x5f, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x76, 0x61, 0x61, 0x3a, 0x01, 0x2a, 0x30, 0x01, 0x42, 0x3f, 0x5a,
+	0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x65, 0x70,
+	0x68, 0x69, 0x75, 0x6d, 0x2f, 0x77, 0x6f, 0x72, 0x6d, 0x68, 0x6f, 0x6c, 0x65, 0x2d, 0x66, 0x6f,
+	0x72, 0x6b, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x73, 0x70, 0x79, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x70, 0x79, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -302,25 +628,36 @@ func file_spy_v1_spy_proto_rawDescGZIP() []byte {
 	return file_spy_v1_spy_proto_rawDescData
 }
 
-var file_spy_v1_spy_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_spy_v1_spy_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_spy_v1_spy_proto_goTypes = []interface{}{
 	(*EmitterFilter)(nil),              // 0: spy.v1.EmitterFilter
-	(*FilterEntry)(nil),                // 1: spy.v1.FilterEntry
-	(*SubscribeSignedVAARequest)(nil),  // 2: spy.v1.SubscribeSignedVAARequest
-	(*SubscribeSignedVAAResponse)(nil), // 3: spy.v1.SubscribeSignedVAAResponse
-	(v1.ChainID)(0),                    // 4: publicrpc.v1.ChainID
+	(*TargetChainFilter)(nil),          // 1: spy.v1.TargetChainFilter
+	(*PayloadIdFilter)(nil),            // 2: spy.v1.PayloadIdFilter
+	(*SequenceRangeFilter)(nil),        // 3: spy.v1.SequenceRangeFilter
+	(*FilterEntry)(nil),                // 4: spy.v1.FilterEntry
+	(*ReplayFrom)(nil),                 // 5: spy.v1.ReplayFrom
+	(*SubscribeSignedVAARequest)(nil),  // 6: spy.v1.SubscribeSignedVAARequest
+	(*SubscribeSignedVAAResponse)(nil), // 7: spy.v1.SubscribeSignedVAAResponse
+	(v1.ChainID)(0),                    // 8: publicrpc.v1.ChainID
 }
 var file_spy_v1_spy_proto_depIdxs = []int32{
-	4, // 0: spy.v1.EmitterFilter.chain_id:type_name -> publicrpc.v1.ChainID
-	0, // 1: spy.v1.FilterEntry.emitter_filter:type_name -> spy.v1.EmitterFilter
-	1, // 2: spy.v1.SubscribeSignedVAARequest.filters:type_name -> spy.v1.FilterEntry
-	2, // 3: spy.v1.SpyRPCService.SubscribeSignedVAA:input_type -> spy.v1.SubscribeSignedVAARequest
-	3, // 4: spy.v1.SpyRPCService.SubscribeSignedVAA:output_type -> spy.v1.SubscribeSignedVAAResponse
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	8,  // 0: spy.v1.EmitterFilter.chain_id:type_name -> publicrpc.v1.ChainID
+	8,  // 1: spy.v1.TargetChainFilter.chain_id:type_name -> publicrpc.v1.ChainID
+	0,  // 2: spy.v1.FilterEntry.emitter_filter:type_name -> spy.v1.EmitterFilter
+	1,  // 3: spy.v1.FilterEntry.target_chain_filter:type_name -> spy.v1.TargetChainFilter
+	2,  // 4: spy.v1.FilterEntry.payload_id_filter:type_name -> spy.v1.PayloadIdFilter
+	3,  // 5: spy.v1.FilterEntry.sequence_range_filter:type_name -> spy.v1.SequenceRangeFilter
+	8,  // 6: spy.v1.ReplayFrom.emitter_chain:type_name -> publicrpc.v1.ChainID
+	8,  // 7: spy.v1.ReplayFrom.target_chain:type_name -> publicrpc.v1.ChainID
+	4,  // 8: spy.v1.SubscribeSignedVAARequest.filters:type_name -> spy.v1.FilterEntry
+	5,  // 9: spy.v1.SubscribeSignedVAARequest.replay:type_name -> spy.v1.ReplayFrom
+	6,  // 10: spy.v1.SpyRPCService.SubscribeSignedVAA:input_type -> spy.v1.SubscribeSignedVAARequest
+	7,  // 11: spy.v1.SpyRPCService.SubscribeSignedVAA:output_type -> spy.v1.SubscribeSignedVAAResponse
+	11, // [11:12] is the sub-list for method output_type
+	10, // [10:11] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_spy_v1_spy_proto_init() }
@@ -342,7 +679,7 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilterEntry); i {
+			switch v := v.(*TargetChainFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -354,7 +691,7 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeSignedVAARequest); i {
+			switch v := v.(*PayloadIdFilter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -366,6 +703,54 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 		file_spy_v1_spy_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SequenceRangeFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilterEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayFrom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeSignedVAARequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spy_v1_spy_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscribeSignedVAAResponse); i {
 			case 0:
 				return &v.state
@@ -378,8 +763,11 @@ func file_spy_v1_spy_proto_init() {
 			}
 		}
 	}
-	file_spy_v1_spy_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_spy_v1_spy_proto_msgTypes[4].OneofWrappers = []interface{}{
 		(*FilterEntry_EmitterFilter)(nil),
+		(*FilterEntry_TargetChainFilter)(nil),
+		(*FilterEntry_PayloadIdFilter)(nil),
+		(*FilterEntry_SequenceRangeFilter)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -387,7 +775,7 @@ func file_spy_v1_spy_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_spy_v1_spy_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
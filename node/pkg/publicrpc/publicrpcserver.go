@@ -8,6 +8,7 @@ import (
 	"github.com/alephium/wormhole-fork/node/pkg/common"
 	"github.com/alephium/wormhole-fork/node/pkg/db"
 	publicrpcv1 "github.com/alephium/wormhole-fork/node/pkg/proto/publicrpc/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/reporter"
 	"github.com/alephium/wormhole-fork/node/pkg/vaa"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
@@ -20,6 +21,10 @@ type PublicrpcServer struct {
 	logger *zap.Logger
 	db     *db.Database
 	gst    *common.GuardianSetState
+	// attestationEvents feeds StreamSignedVAAs, streaming is disabled if nil.
+	attestationEvents *reporter.AttestationEventReporter
+	// vaaStreams is the number of open VAA streams.
+	vaaStreams int32
 
 	governanceChainId vaa.ChainID
 	governanceEmitter vaa.Address
@@ -31,11 +36,13 @@ func NewPublicrpcServer(
 	gst *common.GuardianSetState,
 	governanceChainId vaa.ChainID,
 	governanceEmitterAddress vaa.Address,
+	attestationEvents *reporter.AttestationEventReporter,
 ) *PublicrpcServer {
 	return &PublicrpcServer{
-		logger: logger.Named("publicrpcserver"),
-		db:     db,
-		gst:    gst,
+		logger:            logger.Named("publicrpcserver"),
+		db:                db,
+		gst:               gst,
+		attestationEvents: attestationEvents,
 
 		governanceChainId: governanceChainId,
 		governanceEmitter: governanceEmitterAddress,
@@ -0,0 +1,121 @@
+package publicrpc
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync/atomic"
+
+	publicrpcv1 "github.com/alephium/wormhole-fork/node/pkg/proto/publicrpc/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxVAAStreams is the maximum number of concurrent VAA streams. The stream is public and every stream is fed by the
+// processor.
+const maxVAAStreams = 64
+
+type emitterFilter struct {
+	chainId     vaa.ChainID
+	emitterAddr vaa.Address
+}
+
+// vaaFilter is the set of filters of a VAA stream. Filters of the same type are combined with OR,
+// and the resulting groups with AND. An empty group matches all VAAs.
+type vaaFilter struct {
+	emitters     []emitterFilter
+	targetChains []vaa.ChainID
+}
+
+func newVAAFilter(req *publicrpcv1.StreamSignedVAAsRequest) (*vaaFilter, error) {
+	f := &vaaFilter{}
+	for _, entry := range req.EmitterFilters {
+		addr, err := hex.DecodeString(entry.EmitterAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("failed to decode address: %v", err))
+		}
+		if len(addr) != 32 {
+			return nil, status.Error(codes.InvalidArgument, "address must be 32 bytes")
+		}
+		filter := emitterFilter{chainId: vaa.ChainID(entry.ChainId)}
+		copy(filter.emitterAddr[:], addr)
+		f.emitters = append(f.emitters, filter)
+	}
+	for _, chainId := range req.TargetChains {
+		f.targetChains = append(f.targetChains, vaa.ChainID(chainId))
+	}
+	return f, nil
+}
+
+func (f *vaaFilter) matches(v *vaa.VAA) bool {
+	return f.matchesEmitter(v) && f.matchesTargetChain(v)
+}
+
+func (f *vaaFilter) matchesEmitter(v *vaa.VAA) bool {
+	if len(f.emitters) == 0 {
+		return true
+	}
+	for _, e := range f.emitters {
+		if e.chainId == v.EmitterChain && e.emitterAddr == v.EmitterAddress {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *vaaFilter) matchesTargetChain(v *vaa.VAA) bool {
+	if len(f.targetChains) == 0 {
+		return true
+	}
+	for _, chainId := range f.targetChains {
+		if chainId == v.TargetChain {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *PublicrpcServer) StreamSignedVAAs(req *publicrpcv1.StreamSignedVAAsRequest, resp publicrpcv1.PublicRPCService_StreamSignedVAAsServer) error {
+	if s.attestationEvents == nil {
+		return status.Error(codes.Unavailable, "VAA streaming is not enabled")
+	}
+
+	f, err := newVAAFilter(req)
+	if err != nil {
+		return err
+	}
+
+	if atomic.AddInt32(&s.vaaStreams, 1) > maxVAAStreams {
+		atomic.AddInt32(&s.vaaStreams, -1)
+		return status.Error(codes.ResourceExhausted, "too many VAA streams")
+	}
+	defer atomic.AddInt32(&s.vaaStreams, -1)
+
+	sub := s.attestationEvents.Subscribe()
+	defer s.attestationEvents.Unsubscribe(sub.ClientId)
+
+	for {
+		select {
+		case <-resp.Context().Done():
+			return resp.Context().Err()
+		case <-sub.Channels.MessagePublicationC:
+			// Drain message publications, which are reported on the same subscription
+		case <-sub.Channels.VAAQuorumOverrunC:
+			// The client can fetch the VAAs it missed with GetSignedVAA.
+			return status.Error(codes.ResourceExhausted, "VAAs were dropped since the client didn't keep up")
+		case v := <-sub.Channels.VAAQuorumC:
+			if !f.matches(v) {
+				continue
+			}
+			vaaBytes, err := v.Marshal()
+			if err != nil {
+				s.logger.Error("failed to marshal VAA", zap.String("message_id", v.MessageID()), zap.Error(err))
+				continue
+			}
+			if err := resp.Send(&publicrpcv1.StreamSignedVAAsResponse{VaaBytes: vaaBytes}); err != nil {
+				return err
+			}
+		}
+	}
+}
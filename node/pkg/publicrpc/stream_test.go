@@ -0,0 +1,146 @@
+package publicrpc
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	publicrpcv1 "github.com/alephium/wormhole-fork/node/pkg/proto/publicrpc/v1"
+	"github.com/alephium/wormhole-fork/node/pkg/reporter"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type mockVAAStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	ch  chan *publicrpcv1.StreamSignedVAAsResponse
+}
+
+func (m *mockVAAStream) Send(resp *publicrpcv1.StreamSignedVAAsResponse) error {
+	m.ch <- resp
+	return nil
+}
+
+func (m *mockVAAStream) Context() context.Context {
+	return m.ctx
+}
+
+// streamTestVAA returns a VAA with the fields matched by the stream filters, which can be marshaled.
+func streamTestVAA(emitterChain vaa.ChainID, emitter vaa.Address, targetChain vaa.ChainID) *vaa.VAA {
+	return &vaa.VAA{
+		Version:        vaa.SupportedVAAVersion,
+		EmitterChain:   emitterChain,
+		EmitterAddress: emitter,
+		TargetChain:    targetChain,
+		Payload:        []byte{1},
+	}
+}
+
+func TestVAAFilter(t *testing.T) {
+	emitter := vaa.Address{1}
+	f, err := newVAAFilter(&publicrpcv1.StreamSignedVAAsRequest{
+		EmitterFilters: []*publicrpcv1.EmitterFilter{{
+			ChainId:        publicrpcv1.ChainID_CHAIN_ID_ETHEREUM,
+			EmitterAddress: hex.EncodeToString(emitter[:]),
+		}},
+		TargetChains: []publicrpcv1.ChainID{publicrpcv1.ChainID_CHAIN_ID_ALEPHIUM, publicrpcv1.ChainID_CHAIN_ID_BSC},
+	})
+	assert.Nil(t, err)
+
+	assert.True(t, f.matches(streamTestVAA(vaa.ChainIDEthereum, emitter, vaa.ChainIDAlephium)))
+	assert.True(t, f.matches(streamTestVAA(vaa.ChainIDEthereum, emitter, vaa.ChainIDBSC)))
+	assert.False(t, f.matches(streamTestVAA(vaa.ChainIDEthereum, emitter, vaa.ChainIDPolygon)))
+	assert.False(t, f.matches(streamTestVAA(vaa.ChainIDBSC, emitter, vaa.ChainIDAlephium)))
+	assert.False(t, f.matches(streamTestVAA(vaa.ChainIDEthereum, vaa.Address{2}, vaa.ChainIDAlephium)))
+
+	f, err = newVAAFilter(&publicrpcv1.StreamSignedVAAsRequest{})
+	assert.Nil(t, err)
+	assert.True(t, f.matches(streamTestVAA(vaa.ChainIDEthereum, emitter, vaa.ChainIDPolygon)))
+
+	_, err = newVAAFilter(&publicrpcv1.StreamSignedVAAsRequest{
+		EmitterFilters: []*publicrpcv1.EmitterFilter{{EmitterAddress: "00"}},
+	})
+	assert.NotNil(t, err)
+}
+
+func TestStreamSignedVAAs(t *testing.T) {
+	events := reporter.EventListener(zap.NewNop())
+	server := &PublicrpcServer{logger: zap.NewNop(), attestationEvents: events}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &mockVAAStream{ctx: ctx, ch: make(chan *publicrpcv1.StreamSignedVAAsResponse, 10)}
+	req := &publicrpcv1.StreamSignedVAAsRequest{
+		TargetChains: []publicrpcv1.ChainID{publicrpcv1.ChainID_CHAIN_ID_ALEPHIUM},
+	}
+	errC := make(chan error, 1)
+	go func() {
+		errC <- server.StreamSignedVAAs(req, stream)
+	}()
+
+	// Wait for the stream to subscribe, VAAs reported before are not streamed
+	expected := streamTestVAA(vaa.ChainIDEthereum, vaa.Address{1}, vaa.ChainIDAlephium)
+	for len(stream.ch) == 0 {
+		events.ReportVAAQuorum(streamTestVAA(vaa.ChainIDEthereum, vaa.Address{1}, vaa.ChainIDBSC))
+		events.ReportVAAQuorum(expected)
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	resp := <-stream.ch
+	v, err := vaa.Unmarshal(resp.VaaBytes)
+	assert.Nil(t, err)
+	assert.Equal(t, expected.MessageID(), v.MessageID())
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-errC)
+}
+
+func TestStreamSignedVAAsDisabled(t *testing.T) {
+	server := &PublicrpcServer{logger: zap.NewNop()}
+	stream := &mockVAAStream{ctx: context.Background()}
+	assert.NotNil(t, server.StreamSignedVAAs(&publicrpcv1.StreamSignedVAAsRequest{}, stream))
+}
+
+func TestStreamSignedVAAsOverrun(t *testing.T) {
+	events := reporter.EventListener(zap.NewNop())
+	server := &PublicrpcServer{logger: zap.NewNop(), attestationEvents: events}
+
+	stream := &mockVAAStream{ctx: context.Background(), ch: make(chan *publicrpcv1.StreamSignedVAAsResponse, 1)}
+	errC := make(chan error, 1)
+	go func() {
+		errC <- server.StreamSignedVAAs(&publicrpcv1.StreamSignedVAAsRequest{}, stream)
+	}()
+
+	v := streamTestVAA(vaa.ChainIDEthereum, vaa.Address{1}, vaa.ChainIDAlephium)
+	for len(stream.ch) == 0 {
+		events.ReportVAAQuorum(v)
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// The client doesn't receive the VAAs while they are reported
+	for i := 0; i < 100; i++ {
+		events.ReportVAAQuorum(v)
+	}
+	for {
+		select {
+		case <-stream.ch:
+		case err := <-errC:
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+			return
+		}
+	}
+}
+
+func TestStreamSignedVAAsLimit(t *testing.T) {
+	server := &PublicrpcServer{logger: zap.NewNop(), attestationEvents: reporter.EventListener(zap.NewNop()), vaaStreams: maxVAAStreams}
+	stream := &mockVAAStream{ctx: context.Background()}
+	err := server.StreamSignedVAAs(&publicrpcv1.StreamSignedVAAsRequest{}, stream)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, int32(maxVAAStreams), server.vaaStreams)
+}
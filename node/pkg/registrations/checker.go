@@ -0,0 +1,229 @@
+// Package registrations checks the token bridge emitters registered on the token bridge of every chain against the
+// bridge config.
+//
+// The token bridge of a chain only accepts transfers from the chains registered by a RegisterChain governance VAA, so a
+// missing or wrong registration breaks the transfers in one direction only. Every chain is expected to have the token
+// bridge emitters of all other chains registered. Missing and wrong registrations are also sent to the notifier.
+package registrations
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+
+	"github.com/alephium/wormhole-fork/node/pkg/chainreader"
+	"github.com/alephium/wormhole-fork/node/pkg/notify/discord"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+type Status string
+
+const (
+	StatusOK      Status = "ok"
+	StatusMissing Status = "missing"
+	StatusWrong   Status = "wrong"
+	// StatusUnknown is the status of registrations which were never read successfully.
+	StatusUnknown Status = "unknown"
+)
+
+var (
+	tokenBridgeRegistrationMismatch = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "wormhole_token_bridge_registration_mismatch",
+			Help: "Whether the token bridge emitter of emitter_chain registered on chain is missing or wrong (1) or not (0)",
+		}, []string{"chain", "emitter_chain"})
+	tokenBridgeRegistrationQueryErrors = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wormhole_token_bridge_registration_query_errors_total",
+			Help: "Total number of errors reading the token bridge registrations of a chain",
+		}, []string{"chain"})
+)
+
+// Source reads the token bridge emitters registered on the token bridge of a chain.
+type Source interface {
+	ChainID() vaa.ChainID
+	// RegisteredEmitter returns the token bridge emitter registered for emitterChain, nil if the chain is not registered.
+	RegisteredEmitter(ctx context.Context, emitterChain vaa.ChainID) (*vaa.Address, error)
+}
+
+// Chain is a chain whose token bridge is checked.
+type Chain struct {
+	Source Source
+	// Emitter is the token bridge emitter of the chain according to the bridge config, which the other chains are
+	// expected to have registered.
+	Emitter vaa.Address
+}
+
+// Registration is the most recent view of the token bridge emitter of EmitterChainID registered on ChainID.
+type Registration struct {
+	ChainID        vaa.ChainID
+	EmitterChainID vaa.ChainID
+	Expected       vaa.Address
+	// Registered is the last emitter read from the chain, nil if the chain is not registered or was never read.
+	Registered *vaa.Address
+	Status     Status
+	// Error is the error of the last query, empty if it succeeded.
+	Error string
+}
+
+type Checker struct {
+	logger   *zap.Logger
+	chains   []Chain
+	notifier *discord.DiscordNotifier
+	interval time.Duration
+
+	// checkMutex serializes the periodic checks and those requested by the admin RPC.
+	checkMutex sync.Mutex
+	snapshot   *chainreader.Snapshot[snapshot]
+}
+
+// snapshot is the result of a check.
+type snapshot struct {
+	registrations []*Registration
+	checkedAt     time.Time
+}
+
+// NewChecker creates a checker of the token bridge registrations between chains. The notifier may be nil. With a zero
+// interval, the registrations are only checked by Check.
+func NewChecker(logger *zap.Logger, chains []Chain, notifier *discord.DiscordNotifier, interval time.Duration) *Checker {
+	var registrations []*Registration
+	for _, chain := range chains {
+		for _, emitterChain := range chains {
+			if emitterChain.Source.ChainID() == chain.Source.ChainID() {
+				continue
+			}
+			registrations = append(registrations, &Registration{
+				ChainID:        chain.Source.ChainID(),
+				EmitterChainID: emitterChain.Source.ChainID(),
+				Expected:       emitterChain.Emitter,
+				Status:         StatusUnknown,
+			})
+		}
+	}
+	return &Checker{
+		logger:   logger,
+		chains:   chains,
+		notifier: notifier,
+		interval: interval,
+		snapshot: chainreader.NewSnapshot(snapshot{registrations: registrations}),
+	}
+}
+
+// Run checks the registrations every interval until ctx is done.
+func (c *Checker) Run(ctx context.Context) error {
+	return chainreader.Run(ctx, c.interval, c.check)
+}
+
+// Check checks the registrations immediately and returns the result.
+func (c *Checker) Check(ctx context.Context) ([]*Registration, time.Time) {
+	c.check(ctx)
+	return c.Registrations()
+}
+
+func (c *Checker) check(ctx context.Context) {
+	c.checkMutex.Lock()
+	defer c.checkMutex.Unlock()
+
+	previous, _ := c.Registrations()
+	sources := make(map[vaa.ChainID]Source, len(c.chains))
+	for _, chain := range c.chains {
+		sources[chain.Source.ChainID()] = chain.Source
+	}
+
+	registrations := make([]*Registration, len(previous))
+	for i, prev := range previous {
+		chain := prev.ChainID.String()
+		registration := &Registration{
+			ChainID:        prev.ChainID,
+			EmitterChainID: prev.EmitterChainID,
+			Expected:       prev.Expected,
+		}
+
+		registered, err := chainreader.Query(ctx, func(ctx context.Context) (*vaa.Address, error) {
+			return sources[prev.ChainID].RegisteredEmitter(ctx, prev.EmitterChainID)
+		})
+		if err != nil {
+			c.logger.Warn("failed to read token bridge registration",
+				zap.String("chain", chain),
+				zap.Stringer("emitter_chain", prev.EmitterChainID),
+				zap.Error(err))
+			tokenBridgeRegistrationQueryErrors.WithLabelValues(chain).Inc()
+			registration.Error = err.Error()
+			// Keep the last known registration.
+			registration.Registered = prev.Registered
+			registration.Status = prev.Status
+			registrations[i] = registration
+			continue
+		}
+
+		registration.Registered = registered
+		registration.Status = registrationStatus(registered, prev.Expected)
+		registrations[i] = registration
+		c.publish(prev, registration)
+	}
+
+	c.snapshot.Store(snapshot{registrations: registrations, checkedAt: time.Now()})
+}
+
+// publish updates the metrics of a registration and notifies when it became missing or wrong.
+func (c *Checker) publish(previous *Registration, registration *Registration) {
+	chain := registration.ChainID.String()
+	emitterChain := registration.EmitterChainID.String()
+	if registration.Status == StatusOK {
+		tokenBridgeRegistrationMismatch.WithLabelValues(chain, emitterChain).Set(0)
+		if previous.Status == StatusMissing || previous.Status == StatusWrong {
+			c.logger.Info("token bridge registration fixed", zap.String("chain", chain), zap.String("emitter_chain", emitterChain))
+		}
+		return
+	}
+
+	tokenBridgeRegistrationMismatch.WithLabelValues(chain, emitterChain).Set(1)
+	if previous.Status == registration.Status && sameEmitter(previous.Registered, registration.Registered) {
+		return
+	}
+	registered := ""
+	if registration.Registered != nil {
+		registered = registration.Registered.String()
+	}
+	c.logger.Error("token bridge registration mismatch",
+		zap.String("chain", chain),
+		zap.String("emitter_chain", emitterChain),
+		zap.String("status", string(registration.Status)),
+		zap.Stringer("expected", registration.Expected),
+		zap.String("registered", registered))
+	if c.notifier != nil {
+		go func(status string, expected string) {
+			if err := c.notifier.TokenBridgeRegistrationMismatch(chain, emitterChain, status, expected, registered); err != nil {
+				c.logger.Error("failed to send notification", zap.Error(err))
+			}
+		}(string(registration.Status), registration.Expected.String())
+	}
+}
+
+// Registrations returns the most recent view of each registration, grouped by the chain they are registered on, and
+// the time of the last check, zero if the registrations weren't checked yet.
+func (c *Checker) Registrations() ([]*Registration, time.Time) {
+	s := c.snapshot.Load()
+	return s.registrations, s.checkedAt
+}
+
+func registrationStatus(registered *vaa.Address, expected vaa.Address) Status {
+	if registered == nil {
+		return StatusMissing
+	}
+	if *registered != expected {
+		return StatusWrong
+	}
+	return StatusOK
+}
+
+func sameEmitter(a *vaa.Address, b *vaa.Address) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
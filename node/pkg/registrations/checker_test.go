@@ -0,0 +1,122 @@
+package registrations
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+type staticSource struct {
+	chainID    vaa.ChainID
+	registered map[vaa.ChainID]vaa.Address
+	err        error
+}
+
+func (s *staticSource) ChainID() vaa.ChainID {
+	return s.chainID
+}
+
+func (s *staticSource) RegisteredEmitter(ctx context.Context, emitterChain vaa.ChainID) (*vaa.Address, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	emitter, ok := s.registered[emitterChain]
+	if !ok {
+		return nil, nil
+	}
+	return &emitter, nil
+}
+
+func TestRegistrationStatus(t *testing.T) {
+	expected := vaa.Address{1}
+	wrong := vaa.Address{2}
+
+	assert.Equal(t, StatusOK, registrationStatus(&expected, expected))
+	assert.Equal(t, StatusWrong, registrationStatus(&wrong, expected))
+	assert.Equal(t, StatusMissing, registrationStatus(nil, expected))
+}
+
+func TestCheckerCheck(t *testing.T) {
+	alphEmitter, ethEmitter, bscEmitter := vaa.Address{1}, vaa.Address{2}, vaa.Address{3}
+	alephium := &staticSource{
+		chainID:    vaa.ChainIDAlephium,
+		registered: map[vaa.ChainID]vaa.Address{vaa.ChainIDEthereum: ethEmitter, vaa.ChainIDBSC: bscEmitter},
+	}
+	ethereum := &staticSource{
+		chainID:    vaa.ChainIDEthereum,
+		registered: map[vaa.ChainID]vaa.Address{vaa.ChainIDAlephium: alphEmitter, vaa.ChainIDBSC: {4}},
+	}
+	bsc := &staticSource{
+		chainID:    vaa.ChainIDBSC,
+		registered: map[vaa.ChainID]vaa.Address{vaa.ChainIDAlephium: alphEmitter},
+	}
+	c := NewChecker(zap.NewNop(), []Chain{
+		{Source: alephium, Emitter: alphEmitter},
+		{Source: ethereum, Emitter: ethEmitter},
+		{Source: bsc, Emitter: bscEmitter},
+	}, nil, 0)
+
+	registrations, checkedAt := c.Registrations()
+	assert.Equal(t, 6, len(registrations))
+	assert.True(t, checkedAt.IsZero())
+	for _, r := range registrations {
+		assert.Equal(t, StatusUnknown, r.Status)
+	}
+
+	c.check(context.Background())
+	registrations, checkedAt = c.Registrations()
+	assert.False(t, checkedAt.IsZero())
+	statuses := map[[2]vaa.ChainID]Status{}
+	for _, r := range registrations {
+		statuses[[2]vaa.ChainID{r.ChainID, r.EmitterChainID}] = r.Status
+	}
+	assert.Equal(t, map[[2]vaa.ChainID]Status{
+		{vaa.ChainIDAlephium, vaa.ChainIDEthereum}: StatusOK,
+		{vaa.ChainIDAlephium, vaa.ChainIDBSC}:      StatusOK,
+		{vaa.ChainIDEthereum, vaa.ChainIDAlephium}: StatusOK,
+		{vaa.ChainIDEthereum, vaa.ChainIDBSC}:      StatusWrong,
+		{vaa.ChainIDBSC, vaa.ChainIDAlephium}:      StatusOK,
+		{vaa.ChainIDBSC, vaa.ChainIDEthereum}:      StatusMissing,
+	}, statuses)
+
+	// Failed queries keep the last known registration
+	ethereum.err = errors.New("unavailable")
+	c.check(context.Background())
+	registrations, _ = c.Registrations()
+	assert.Equal(t, vaa.ChainIDEthereum, registrations[3].ChainID)
+	assert.Equal(t, vaa.ChainIDBSC, registrations[3].EmitterChainID)
+	assert.Equal(t, "unavailable", registrations[3].Error)
+	assert.Equal(t, StatusWrong, registrations[3].Status)
+	assert.Equal(t, vaa.Address{4}, *registrations[3].Registered)
+
+	ethereum.err = nil
+	ethereum.registered[vaa.ChainIDBSC] = bscEmitter
+	c.check(context.Background())
+	registrations, _ = c.Registrations()
+	assert.Empty(t, registrations[3].Error)
+	assert.Equal(t, StatusOK, registrations[3].Status)
+}
+
+func TestCheckerCheckOnDemand(t *testing.T) {
+	alphEmitter, ethEmitter := vaa.Address{1}, vaa.Address{2}
+	c := NewChecker(zap.NewNop(), []Chain{
+		{Source: &staticSource{chainID: vaa.ChainIDAlephium, registered: map[vaa.ChainID]vaa.Address{vaa.ChainIDEthereum: ethEmitter}}, Emitter: alphEmitter},
+		{Source: &staticSource{chainID: vaa.ChainIDEthereum, registered: map[vaa.ChainID]vaa.Address{}}, Emitter: ethEmitter},
+	}, nil, 0)
+
+	registrations, checkedAt := c.Check(context.Background())
+	assert.False(t, checkedAt.IsZero())
+	assert.Equal(t, 2, len(registrations))
+	assert.Equal(t, StatusOK, registrations[0].Status)
+	assert.Equal(t, StatusMissing, registrations[1].Status)
+
+	// The result is kept for the next reads
+	stored, storedAt := c.Registrations()
+	assert.Equal(t, registrations, stored)
+	assert.Equal(t, checkedAt, storedAt)
+}
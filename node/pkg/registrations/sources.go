@@ -0,0 +1,54 @@
+package registrations
+
+import (
+	"context"
+
+	ethbind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/alephium/wormhole-fork/node/pkg/chainreader"
+	"github.com/alephium/wormhole-fork/node/pkg/vaa"
+)
+
+// evmSource reads the registrations of an EVM token bridge.
+type evmSource struct {
+	*chainreader.EvmClient
+}
+
+func NewEvmSource(client *chainreader.EvmClient) Source {
+	return &evmSource{client}
+}
+
+func (s *evmSource) RegisteredEmitter(ctx context.Context, emitterChain vaa.ChainID) (*vaa.Address, error) {
+	bridge, err := s.TokenBridge(ctx)
+	if err != nil {
+		return nil, err
+	}
+	registered, err := bridge.BridgeContracts(&ethbind.CallOpts{Context: ctx}, uint16(emitterChain))
+	if err != nil || registered == ([32]byte{}) {
+		return nil, err
+	}
+	emitter := vaa.Address(registered)
+	return &emitter, nil
+}
+
+// alephiumSource reads the registrations from the TokenBridgeForChain contracts of the Alephium token bridge.
+type alephiumSource struct {
+	*chainreader.AlephiumClient
+}
+
+func NewAlephiumSource(client *chainreader.AlephiumClient) Source {
+	return &alephiumSource{client}
+}
+
+func (s *alephiumSource) ChainID() vaa.ChainID {
+	return vaa.ChainIDAlephium
+}
+
+func (s *alephiumSource) RegisteredEmitter(ctx context.Context, emitterChain vaa.ChainID) (*vaa.Address, error) {
+	registered, err := s.GetRegisteredTokenBridge(ctx, s.TokenBridgeID, uint16(emitterChain), s.GroupIndex)
+	if err != nil || registered == nil {
+		return nil, err
+	}
+	emitter := vaa.Address(*registered)
+	return &emitter, nil
+}
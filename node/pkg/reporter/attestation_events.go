@@ -26,6 +26,8 @@ type lifecycleEventChannels struct {
 	// channel for each event
 	MessagePublicationC chan *MessagePublication
 	VAAQuorumC          chan *vaa.VAA
+	// VAAQuorumOverrunC is signaled when a VAA was dropped since VAAQuorumC was full.
+	VAAQuorumOverrunC chan struct{}
 }
 
 type AttestationEventReporter struct {
@@ -67,6 +69,7 @@ func (re *AttestationEventReporter) Subscribe() *activeSubscription {
 	channels := &lifecycleEventChannels{
 		MessagePublicationC: make(chan *MessagePublication, 50),
 		VAAQuorumC:          make(chan *vaa.VAA, 50),
+		VAAQuorumOverrunC:   make(chan struct{}, 1),
 	}
 	re.subs[clientId] = channels
 	sub := &activeSubscription{ClientId: clientId, Channels: channels}
@@ -91,7 +94,7 @@ func (re *AttestationEventReporter) ReportMessagePublication(msg *MessagePublica
 		case sub.MessagePublicationC <- msg:
 			re.logger.Debug("published MessagePublication to client", zap.Int("client", client))
 		default:
-			re.logger.Error("buffer overrun when attempting to publish message", zap.Int("client", client))
+			re.logger.Debug("buffer overrun when attempting to publish message", zap.Int("client", client))
 		}
 	}
 }
@@ -106,8 +109,13 @@ func (re *AttestationEventReporter) ReportVAAQuorum(msg *vaa.VAA) {
 		case sub.VAAQuorumC <- msg:
 			re.logger.Debug("published VAAQuorum to client", zap.Int("client", client))
 		default:
-			re.logger.Error("buffer overrun when attempting to publish message", zap.Int("client", client))
-
+			// Overruns are reported to the subscriber, which may be a slow remote client, logging them here would
+			// let it flood the logs.
+			re.logger.Debug("buffer overrun when attempting to publish VAAQuorum", zap.Int("client", client))
+			select {
+			case sub.VAAQuorumOverrunC <- struct{}{}:
+			default:
+			}
 		}
 	}
 }
@@ -0,0 +1,119 @@
+// Package shamir implements Shamir's secret sharing over GF(2^8), sharing each byte of the secret with an
+// independent random polynomial.
+package shamir
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+)
+
+// Share is a share of a secret. X is the non-zero point at which the polynomials were evaluated, and Y[i] the value
+// of the polynomial of the i-th byte of the secret at X.
+type Share struct {
+	X byte
+	Y []byte
+}
+
+// Split splits secret into n shares, any threshold of which can reconstruct the secret.
+func Split(secret []byte, n, threshold int) ([]Share, error) {
+	if len(secret) == 0 {
+		return nil, errors.New("empty secret")
+	}
+	if threshold < 2 {
+		return nil, errors.New("threshold must be at least 2")
+	}
+	if n < threshold {
+		return nil, errors.New("number of shares must be at least the threshold")
+	}
+	if n > 255 {
+		return nil, errors.New("number of shares must be at most 255")
+	}
+
+	shares := make([]Share, n)
+	for i := range shares {
+		shares[i] = Share{X: byte(i + 1), Y: make([]byte, len(secret))}
+	}
+
+	coefficients := make([]byte, threshold)
+	for b, s := range secret {
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, fmt.Errorf("failed to read random data: %w", err)
+		}
+		coefficients[0] = s
+		for i := range shares {
+			shares[i].Y[b] = evaluate(coefficients, shares[i].X)
+		}
+	}
+	for i := range coefficients {
+		coefficients[i] = 0
+	}
+	return shares, nil
+}
+
+// Combine reconstructs a secret from its shares. Combining fewer shares than the threshold returns a wrong secret
+// without any error, the caller needs to verify the result.
+func Combine(shares []Share) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, errors.New("at least 2 shares are required")
+	}
+	seen := make(map[byte]bool, len(shares))
+	for _, s := range shares {
+		if s.X == 0 {
+			return nil, errors.New("invalid share index 0")
+		}
+		if seen[s.X] {
+			return nil, fmt.Errorf("duplicate share %d", s.X)
+		}
+		seen[s.X] = true
+		if len(s.Y) == 0 || len(s.Y) != len(shares[0].Y) {
+			return nil, errors.New("shares have different lengths")
+		}
+	}
+
+	// Lagrange interpolation at x = 0. Addition and subtraction are both XOR in GF(2^8).
+	secret := make([]byte, len(shares[0].Y))
+	for i, si := range shares {
+		basis := byte(1)
+		for j, sj := range shares {
+			if i != j {
+				basis = mul(basis, div(sj.X, sj.X^si.X))
+			}
+		}
+		for b := range secret {
+			secret[b] ^= mul(si.Y[b], basis)
+		}
+	}
+	return secret, nil
+}
+
+// evaluate evaluates the polynomial with the given coefficients, lowest degree first, at x.
+func evaluate(coefficients []byte, x byte) byte {
+	var y byte
+	for i := len(coefficients) - 1; i >= 0; i-- {
+		y = mul(y, x) ^ coefficients[i]
+	}
+	return y
+}
+
+// mul multiplies in GF(2^8) with the AES reducing polynomial x^8 + x^4 + x^3 + x + 1, without data-dependent
+// branches on secret values.
+func mul(a, b byte) byte {
+	var p byte
+	for i := 0; i < 8; i++ {
+		p ^= a & -(b & 1)
+		a = (a << 1) ^ (0x1b & -(a >> 7))
+		b >>= 1
+	}
+	return p
+}
+
+// div divides a by b, which must not be 0.
+func div(a, b byte) byte {
+	// b^254 is the inverse of b, since the multiplicative group has 255 elements.
+	inv := b
+	for i := 0; i < 6; i++ {
+		inv = mul(mul(inv, inv), b)
+	}
+	return mul(a, mul(inv, inv))
+}
@@ -0,0 +1,73 @@
+package shamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGaloisField(t *testing.T) {
+	// Example from FIPS-197, section 4.2
+	assert.Equal(t, byte(0xc1), mul(0x57, 0x83))
+	for a := 1; a < 256; a++ {
+		assert.Equal(t, byte(1), mul(byte(a), div(1, byte(a))), "inverse of %d", a)
+		assert.Equal(t, byte(0), mul(byte(a), 0))
+	}
+}
+
+func TestSplitCombine(t *testing.T) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	require.NoError(t, err)
+
+	shares, err := Split(secret, 5, 3)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+
+	// Any 3 or more shares reconstruct the secret
+	for a := 0; a < 5; a++ {
+		for b := a + 1; b < 5; b++ {
+			for c := b + 1; c < 5; c++ {
+				combined, err := Combine([]Share{shares[c], shares[a], shares[b]})
+				require.NoError(t, err)
+				assert.Equal(t, secret, combined)
+			}
+		}
+	}
+	combined, err := Combine(shares)
+	require.NoError(t, err)
+	assert.Equal(t, secret, combined)
+
+	// 2 shares don't
+	combined, err = Combine(shares[:2])
+	require.NoError(t, err)
+	assert.False(t, bytes.Equal(secret, combined))
+}
+
+func TestSplitInvalidParameters(t *testing.T) {
+	_, err := Split(nil, 3, 2)
+	assert.Error(t, err)
+	_, err = Split([]byte{1}, 3, 1)
+	assert.Error(t, err)
+	_, err = Split([]byte{1}, 2, 3)
+	assert.Error(t, err)
+	_, err = Split([]byte{1}, 256, 3)
+	assert.Error(t, err)
+}
+
+func TestCombineInvalidShares(t *testing.T) {
+	shares, err := Split([]byte("secret"), 3, 2)
+	require.NoError(t, err)
+
+	_, err = Combine(shares[:1])
+	assert.Error(t, err)
+	_, err = Combine([]Share{shares[0], shares[0]})
+	assert.Error(t, err)
+	_, err = Combine([]Share{shares[0], {X: 0, Y: shares[1].Y}})
+	assert.Error(t, err)
+	_, err = Combine([]Share{shares[0], {X: shares[1].X, Y: shares[1].Y[1:]}})
+	assert.Error(t, err)
+}
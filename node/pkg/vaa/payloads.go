@@ -3,6 +3,8 @@ package vaa
 import (
 	"bytes"
 	"encoding/binary"
+	"encoding/hex"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -194,3 +196,247 @@ func (b BodyTokenBridgeUpdateRefundAddress) Serialize() []byte {
 	buf.Write(b.NewRefundAddress)
 	return buf.Bytes()
 }
+
+// Payload is a decoded governance or token bridge payload.
+type Payload interface {
+	Serialize() []byte
+}
+
+// decodeGovernanceHeader checks the module and action of a governance payload and returns the payload without
+// its header.
+func decodeGovernanceHeader(payload []byte, module []byte, action uint8) (*bytes.Reader, error) {
+	if len(payload) < 33 {
+		return nil, fmt.Errorf("governance payload too short")
+	}
+	if !bytes.Equal(payload[:32], module) {
+		return nil, fmt.Errorf("invalid module %s", hex.EncodeToString(payload[:32]))
+	}
+	if payload[32] != action {
+		return nil, fmt.Errorf("invalid action %d, expected %d", payload[32], action)
+	}
+	return bytes.NewReader(payload[33:]), nil
+}
+
+// decodeModule returns the module of a token bridge governance payload, which is left-padded with zeros.
+func decodeModule(payload []byte) (string, error) {
+	if len(payload) < 33 {
+		return "", fmt.Errorf("governance payload too short")
+	}
+	return string(bytes.TrimLeft(payload[:32], "\x00")), nil
+}
+
+// checkEnd returns an error if the payload has not been fully consumed.
+func checkEnd(r *bytes.Reader) error {
+	if r.Len() != 0 {
+		return fmt.Errorf("invalid governance payload length, %d trailing bytes", r.Len())
+	}
+	return nil
+}
+
+// DecodeBodyUpdateMessageFee decodes a core update message fee payload.
+func DecodeBodyUpdateMessageFee(payload []byte) (*BodyUpdateMessageFee, error) {
+	r, err := decodeGovernanceHeader(payload, CoreModule, 3)
+	if err != nil {
+		return nil, err
+	}
+	b := &BodyUpdateMessageFee{NewMessageFee: make([]byte, 32)}
+	if err := readFull(r, b.NewMessageFee); err != nil {
+		return nil, fmt.Errorf("failed to read message fee: %w", err)
+	}
+	return b, checkEnd(r)
+}
+
+// DecodeBodyTransferFee decodes a core transfer fee payload.
+func DecodeBodyTransferFee(payload []byte) (*BodyTransferFee, error) {
+	r, err := decodeGovernanceHeader(payload, CoreModule, 4)
+	if err != nil {
+		return nil, err
+	}
+	b := &BodyTransferFee{Amount: make([]byte, 32), Recipient: make([]byte, 32)}
+	if err := readFull(r, b.Amount); err != nil {
+		return nil, fmt.Errorf("failed to read amount: %w", err)
+	}
+	if err := readFull(r, b.Recipient); err != nil {
+		return nil, fmt.Errorf("failed to read recipient: %w", err)
+	}
+	return b, checkEnd(r)
+}
+
+// DecodeBodyContractUpgrade decodes a core contract upgrade payload.
+func DecodeBodyContractUpgrade(payload []byte) (*BodyContractUpgrade, error) {
+	r, err := decodeGovernanceHeader(payload, CoreModule, 1)
+	if err != nil {
+		return nil, err
+	}
+	b := &BodyContractUpgrade{Payload: make([]byte, r.Len())}
+	if err := readFull(r, b.Payload); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// DecodeBodyGuardianSetUpgrade decodes a core guardian set upgrade payload.
+func DecodeBodyGuardianSetUpgrade(payload []byte) (*BodyGuardianSetUpgrade, error) {
+	r, err := decodeGovernanceHeader(payload, CoreModule, 2)
+	if err != nil {
+		return nil, err
+	}
+	b := &BodyGuardianSetUpgrade{}
+	if err := binary.Read(r, binary.BigEndian, &b.NewIndex); err != nil {
+		return nil, fmt.Errorf("failed to read guardian set index: %w", err)
+	}
+	size, err := r.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read guardian set size: %w", err)
+	}
+	b.Keys = make([]common.Address, size)
+	for i := range b.Keys {
+		if err := readFull(r, b.Keys[i][:]); err != nil {
+			return nil, fmt.Errorf("failed to read guardian key %d: %w", i, err)
+		}
+	}
+	return b, checkEnd(r)
+}
+
+// DecodeBodyTokenBridgeRegisterChain decodes a register chain payload of any module.
+func DecodeBodyTokenBridgeRegisterChain(payload []byte) (*BodyTokenBridgeRegisterChain, error) {
+	module, err := decodeModule(payload)
+	if err != nil {
+		return nil, err
+	}
+	r, err := decodeGovernanceHeader(payload, payload[:32], 1)
+	if err != nil {
+		return nil, err
+	}
+	b := &BodyTokenBridgeRegisterChain{Module: module}
+	if err := binary.Read(r, binary.BigEndian, &b.ChainID); err != nil {
+		return nil, fmt.Errorf("failed to read chain id: %w", err)
+	}
+	if err := binary.Read(r, binary.BigEndian, &b.EmitterAddress); err != nil {
+		return nil, fmt.Errorf("failed to read emitter address: %w", err)
+	}
+	return b, checkEnd(r)
+}
+
+// DecodeBodyTokenBridgeUpgradeContract decodes a contract upgrade payload of any module.
+func DecodeBodyTokenBridgeUpgradeContract(payload []byte) (*BodyTokenBridgeUpgradeContract, error) {
+	module, err := decodeModule(payload)
+	if err != nil {
+		return nil, err
+	}
+	r, err := decodeGovernanceHeader(payload, payload[:32], 2)
+	if err != nil {
+		return nil, err
+	}
+	b := &BodyTokenBridgeUpgradeContract{Module: module, Payload: make([]byte, r.Len())}
+	if err := readFull(r, b.Payload); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// DecodeBodyTokenBridgeDestroyContracts decodes a token bridge destroy unexecuted sequence contracts payload.
+func DecodeBodyTokenBridgeDestroyContracts(payload []byte) (*BodyTokenBridgeDestroyContracts, error) {
+	r, err := decodeGovernanceHeader(payload, TokenBridgeModule, 0xf0)
+	if err != nil {
+		return nil, err
+	}
+	b := &BodyTokenBridgeDestroyContracts{}
+	if err := binary.Read(r, binary.BigEndian, &b.EmitterChain); err != nil {
+		return nil, fmt.Errorf("failed to read emitter chain: %w", err)
+	}
+	var size uint16
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, fmt.Errorf("failed to read number of sequences: %w", err)
+	}
+	if r.Len() != int(size)*8 {
+		return nil, fmt.Errorf("invalid governance payload length, expected %d sequences", size)
+	}
+	b.Sequences = make([]uint64, size)
+	if err := binary.Read(r, binary.BigEndian, &b.Sequences); err != nil {
+		return nil, fmt.Errorf("failed to read sequences: %w", err)
+	}
+	return b, nil
+}
+
+// DecodeBodyTokenBridgeUpdateMinimalConsistencyLevel decodes a token bridge update minimal consistency level payload.
+func DecodeBodyTokenBridgeUpdateMinimalConsistencyLevel(payload []byte) (*BodyTokenBridgeUpdateMinimalConsistencyLevel, error) {
+	r, err := decodeGovernanceHeader(payload, TokenBridgeModule, 0xf1)
+	if err != nil {
+		return nil, err
+	}
+	b := &BodyTokenBridgeUpdateMinimalConsistencyLevel{}
+	if b.NewConsistencyLevel, err = r.ReadByte(); err != nil {
+		return nil, fmt.Errorf("failed to read consistency level: %w", err)
+	}
+	return b, checkEnd(r)
+}
+
+// DecodeBodyTokenBridgeUpdateRefundAddress decodes a token bridge update refund address payload.
+func DecodeBodyTokenBridgeUpdateRefundAddress(payload []byte) (*BodyTokenBridgeUpdateRefundAddress, error) {
+	r, err := decodeGovernanceHeader(payload, TokenBridgeModule, 0xf2)
+	if err != nil {
+		return nil, err
+	}
+	var size uint16
+	if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+		return nil, fmt.Errorf("failed to read refund address length: %w", err)
+	}
+	b := &BodyTokenBridgeUpdateRefundAddress{NewRefundAddress: make([]byte, size)}
+	if err := readFull(r, b.NewRefundAddress); err != nil {
+		return nil, fmt.Errorf("failed to read refund address: %w", err)
+	}
+	return b, checkEnd(r)
+}
+
+// DecodePayload decodes a core or token bridge governance payload, or a token bridge message. The type of the
+// payload is inferred from its content: callers must check that the VAA was emitted by the governance emitter
+// or by a token bridge.
+//
+// Governance payloads are returned as one of the Body* types, token bridge messages as a *Transfer,
+// *TransferWithPayload or *AssetMeta.
+func DecodePayload(payload []byte) (Payload, error) {
+	if len(payload) == 0 {
+		return nil, fmt.Errorf("empty payload")
+	}
+
+	if len(payload) >= 33 && bytes.Equal(payload[:32], CoreModule) {
+		switch payload[32] {
+		case 1:
+			return DecodeBodyContractUpgrade(payload)
+		case 2:
+			return DecodeBodyGuardianSetUpgrade(payload)
+		case 3:
+			return DecodeBodyUpdateMessageFee(payload)
+		case 4:
+			return DecodeBodyTransferFee(payload)
+		}
+		return nil, fmt.Errorf("unknown core governance action %d", payload[32])
+	}
+
+	if len(payload) >= 33 && bytes.Equal(payload[:32], TokenBridgeModule) {
+		switch payload[32] {
+		case 1:
+			return DecodeBodyTokenBridgeRegisterChain(payload)
+		case 2:
+			return DecodeBodyTokenBridgeUpgradeContract(payload)
+		case 0xf0:
+			return DecodeBodyTokenBridgeDestroyContracts(payload)
+		case 0xf1:
+			return DecodeBodyTokenBridgeUpdateMinimalConsistencyLevel(payload)
+		case 0xf2:
+			return DecodeBodyTokenBridgeUpdateRefundAddress(payload)
+		}
+		return nil, fmt.Errorf("unknown token bridge governance action %d", payload[32])
+	}
+
+	switch payload[0] {
+	case PayloadIDTransfer:
+		return DecodeTransfer(payload)
+	case PayloadIDAssetMeta:
+		return DecodeAssetMeta(payload)
+	case PayloadIDTransferWithPayload:
+		return DecodeTransferWithPayload(payload)
+	}
+	return nil, fmt.Errorf("unknown payload id %d", payload[0])
+}
@@ -131,3 +131,51 @@ func TestBodyUpdateRefundAddressSerialize(t *testing.T) {
 	serialized := body.Serialize()
 	assert.Equal(t, hex.EncodeToString(serialized), expected)
 }
+
+func TestDecodeGovernancePayloads(t *testing.T) {
+	bodies := []Payload{
+		&BodyUpdateMessageFee{NewMessageFee: common.LeftPadBytes([]byte{1, 2}, 32)},
+		&BodyTransferFee{Amount: common.LeftPadBytes([]byte{3}, 32), Recipient: common.LeftPadBytes([]byte{4}, 32)},
+		&BodyContractUpgrade{Payload: common.LeftPadBytes([]byte{5}, 32)},
+		&BodyGuardianSetUpgrade{
+			Keys: []common.Address{
+				common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaed"),
+				common.HexToAddress("0x5aaeb6053f3e94c9b9a09f33669435e7ef1beaee"),
+			},
+			NewIndex: 2,
+		},
+		&BodyTokenBridgeRegisterChain{Module: "TokenBridge", ChainID: ChainIDAlephium, EmitterAddress: Address{1, 2, 3}},
+		&BodyTokenBridgeUpgradeContract{Module: "TokenBridge", Payload: []byte{6, 7, 8}},
+		&BodyTokenBridgeDestroyContracts{EmitterChain: ChainIDEthereum, Sequences: []uint64{1, 3, 10}},
+		&BodyTokenBridgeUpdateMinimalConsistencyLevel{NewConsistencyLevel: 10},
+		&BodyTokenBridgeUpdateRefundAddress{NewRefundAddress: []byte{9, 10, 11}},
+	}
+
+	for _, body := range bodies {
+		decoded, err := DecodePayload(body.Serialize())
+		assert.NoError(t, err)
+		assert.Equal(t, body, decoded)
+	}
+}
+
+func TestDecodeGovernancePayloadErrors(t *testing.T) {
+	payload := BodyTokenBridgeUpdateMinimalConsistencyLevel{NewConsistencyLevel: 10}.Serialize()
+
+	_, err := DecodeBodyTokenBridgeUpdateMinimalConsistencyLevel(payload[:len(payload)-1])
+	assert.Error(t, err)
+	_, err = DecodeBodyTokenBridgeUpdateMinimalConsistencyLevel(append(payload, 0))
+	assert.Error(t, err)
+	_, err = DecodeBodyTokenBridgeUpdateRefundAddress(payload)
+	assert.Error(t, err)
+	_, err = DecodeBodyUpdateMessageFee(payload)
+	assert.Error(t, err)
+
+	destroy := BodyTokenBridgeDestroyContracts{EmitterChain: ChainIDEthereum, Sequences: []uint64{1, 2}}.Serialize()
+	_, err = DecodeBodyTokenBridgeDestroyContracts(destroy[:len(destroy)-8])
+	assert.Error(t, err)
+
+	_, err = DecodePayload(append(append([]byte{}, CoreModule...), 0x10))
+	assert.Error(t, err)
+	_, err = DecodePayload([]byte{})
+	assert.Error(t, err)
+}
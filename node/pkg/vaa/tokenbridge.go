@@ -0,0 +1,240 @@
+package vaa
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Payload IDs of the token bridge messages.
+const (
+	PayloadIDTransfer            uint8 = 1
+	PayloadIDAssetMeta           uint8 = 2
+	PayloadIDTransferWithPayload uint8 = 3
+)
+
+type (
+	// Transfer is a token bridge transfer. Amounts are normalized to at most 8 decimals.
+	Transfer struct {
+		Amount        *big.Int
+		OriginAddress Address
+		OriginChain   ChainID
+		// Recipient on the target chain of the VAA, its length depends on the target chain.
+		TargetAddress []byte
+		// Fee paid to the relayer which redeems the transfer, included in Amount.
+		Fee *big.Int
+	}
+
+	// TransferWithPayload is a token bridge transfer carrying an arbitrary payload for the recipient contract,
+	// using the layout of the upstream Wormhole token bridge.
+	TransferWithPayload struct {
+		Amount        *big.Int
+		OriginAddress Address
+		OriginChain   ChainID
+		TargetAddress Address
+		TargetChain   ChainID
+		FromAddress   Address
+		Payload       []byte
+	}
+
+	// AssetMeta attests a token, so that it can be wrapped on other chains.
+	AssetMeta struct {
+		TokenAddress Address
+		TokenChain   ChainID
+		Decimals     uint8
+		Symbol       string
+		Name         string
+	}
+)
+
+func writeUint256(buf *bytes.Buffer, v *big.Int) {
+	if v.Sign() < 0 || v.BitLen() > 256 {
+		panic("value does not fit in a uint256")
+	}
+	buf.Write(common.LeftPadBytes(v.Bytes(), 32))
+}
+
+// writeBytes32 writes a string right-padded with zeros to 32 bytes.
+func writeBytes32(buf *bytes.Buffer, s string) {
+	if len(s) > 32 {
+		panic("string longer than 32 byte")
+	}
+	b := make([]byte, 32)
+	copy(b, s)
+	buf.Write(b)
+}
+
+func (t Transfer) Serialize() []byte {
+	if len(t.TargetAddress) > 0xffff {
+		panic("target address too long")
+	}
+
+	buf := new(bytes.Buffer)
+	MustWrite(buf, binary.BigEndian, PayloadIDTransfer)
+	writeUint256(buf, t.Amount)
+	buf.Write(t.OriginAddress[:])
+	MustWrite(buf, binary.BigEndian, t.OriginChain)
+	MustWrite(buf, binary.BigEndian, uint16(len(t.TargetAddress)))
+	buf.Write(t.TargetAddress)
+	writeUint256(buf, t.Fee)
+	return buf.Bytes()
+}
+
+func (t TransferWithPayload) Serialize() []byte {
+	buf := new(bytes.Buffer)
+	MustWrite(buf, binary.BigEndian, PayloadIDTransferWithPayload)
+	writeUint256(buf, t.Amount)
+	buf.Write(t.OriginAddress[:])
+	MustWrite(buf, binary.BigEndian, t.OriginChain)
+	buf.Write(t.TargetAddress[:])
+	MustWrite(buf, binary.BigEndian, t.TargetChain)
+	buf.Write(t.FromAddress[:])
+	buf.Write(t.Payload)
+	return buf.Bytes()
+}
+
+func (m AssetMeta) Serialize() []byte {
+	buf := new(bytes.Buffer)
+	MustWrite(buf, binary.BigEndian, PayloadIDAssetMeta)
+	buf.Write(m.TokenAddress[:])
+	MustWrite(buf, binary.BigEndian, m.TokenChain)
+	MustWrite(buf, binary.BigEndian, m.Decimals)
+	writeBytes32(buf, m.Symbol)
+	writeBytes32(buf, m.Name)
+	return buf.Bytes()
+}
+
+// DecodeTransfer decodes a token bridge transfer (payload ID 1).
+func DecodeTransfer(payload []byte) (*Transfer, error) {
+	r := bytes.NewReader(payload)
+	if err := readPayloadID(r, PayloadIDTransfer); err != nil {
+		return nil, err
+	}
+
+	t := &Transfer{}
+	var err error
+	if t.Amount, err = readUint256(r); err != nil {
+		return nil, fmt.Errorf("failed to read amount: %w", err)
+	}
+	if err := binary.Read(r, binary.BigEndian, &t.OriginAddress); err != nil {
+		return nil, fmt.Errorf("failed to read origin address: %w", err)
+	}
+	if err := binary.Read(r, binary.BigEndian, &t.OriginChain); err != nil {
+		return nil, fmt.Errorf("failed to read origin chain: %w", err)
+	}
+	var targetAddressLength uint16
+	if err := binary.Read(r, binary.BigEndian, &targetAddressLength); err != nil {
+		return nil, fmt.Errorf("failed to read target address length: %w", err)
+	}
+	t.TargetAddress = make([]byte, targetAddressLength)
+	if err := readFull(r, t.TargetAddress); err != nil {
+		return nil, fmt.Errorf("failed to read target address: %w", err)
+	}
+	if t.Fee, err = readUint256(r); err != nil {
+		return nil, fmt.Errorf("failed to read fee: %w", err)
+	}
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("invalid transfer length, %d trailing bytes", r.Len())
+	}
+	return t, nil
+}
+
+// DecodeTransferWithPayload decodes a token bridge transfer with payload (payload ID 3).
+func DecodeTransferWithPayload(payload []byte) (*TransferWithPayload, error) {
+	r := bytes.NewReader(payload)
+	if err := readPayloadID(r, PayloadIDTransferWithPayload); err != nil {
+		return nil, err
+	}
+
+	t := &TransferWithPayload{}
+	var err error
+	if t.Amount, err = readUint256(r); err != nil {
+		return nil, fmt.Errorf("failed to read amount: %w", err)
+	}
+	if err := binary.Read(r, binary.BigEndian, &t.OriginAddress); err != nil {
+		return nil, fmt.Errorf("failed to read origin address: %w", err)
+	}
+	if err := binary.Read(r, binary.BigEndian, &t.OriginChain); err != nil {
+		return nil, fmt.Errorf("failed to read origin chain: %w", err)
+	}
+	if err := binary.Read(r, binary.BigEndian, &t.TargetAddress); err != nil {
+		return nil, fmt.Errorf("failed to read target address: %w", err)
+	}
+	if err := binary.Read(r, binary.BigEndian, &t.TargetChain); err != nil {
+		return nil, fmt.Errorf("failed to read target chain: %w", err)
+	}
+	if err := binary.Read(r, binary.BigEndian, &t.FromAddress); err != nil {
+		return nil, fmt.Errorf("failed to read from address: %w", err)
+	}
+	t.Payload = make([]byte, r.Len())
+	if err := readFull(r, t.Payload); err != nil {
+		return nil, fmt.Errorf("failed to read payload: %w", err)
+	}
+	return t, nil
+}
+
+// DecodeAssetMeta decodes a token attestation (payload ID 2).
+func DecodeAssetMeta(payload []byte) (*AssetMeta, error) {
+	r := bytes.NewReader(payload)
+	if err := readPayloadID(r, PayloadIDAssetMeta); err != nil {
+		return nil, err
+	}
+
+	m := &AssetMeta{}
+	if err := binary.Read(r, binary.BigEndian, &m.TokenAddress); err != nil {
+		return nil, fmt.Errorf("failed to read token address: %w", err)
+	}
+	if err := binary.Read(r, binary.BigEndian, &m.TokenChain); err != nil {
+		return nil, fmt.Errorf("failed to read token chain: %w", err)
+	}
+	if err := binary.Read(r, binary.BigEndian, &m.Decimals); err != nil {
+		return nil, fmt.Errorf("failed to read decimals: %w", err)
+	}
+	var symbol, name [32]byte
+	if err := binary.Read(r, binary.BigEndian, &symbol); err != nil {
+		return nil, fmt.Errorf("failed to read symbol: %w", err)
+	}
+	if err := binary.Read(r, binary.BigEndian, &name); err != nil {
+		return nil, fmt.Errorf("failed to read name: %w", err)
+	}
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("invalid asset meta length, %d trailing bytes", r.Len())
+	}
+	m.Symbol = string(bytes.TrimRight(symbol[:], "\x00"))
+	m.Name = string(bytes.TrimRight(name[:], "\x00"))
+	return m, nil
+}
+
+func readPayloadID(r *bytes.Reader, expected uint8) error {
+	id, err := r.ReadByte()
+	if err != nil {
+		return fmt.Errorf("failed to read payload id: %w", err)
+	}
+	if id != expected {
+		return fmt.Errorf("invalid payload id %d, expected %d", id, expected)
+	}
+	return nil
+}
+
+func readUint256(r *bytes.Reader) (*big.Int, error) {
+	b := make([]byte, 32)
+	if err := readFull(r, b); err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// readFull reads exactly len(b) bytes.
+func readFull(r *bytes.Reader, b []byte) error {
+	if r.Len() < len(b) {
+		return fmt.Errorf("buffer too short, expected %d bytes, got %d", len(b), r.Len())
+	}
+	if len(b) == 0 {
+		return nil
+	}
+	_, err := r.Read(b)
+	return err
+}
@@ -0,0 +1,88 @@
+package vaa
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransferSerializeDecode(t *testing.T) {
+	transfer := &Transfer{
+		Amount:        big.NewInt(100000),
+		OriginAddress: Address{1, 2, 3},
+		OriginChain:   ChainIDEthereum,
+		TargetAddress: []byte{0x00, 0x0a, 0x0b, 0x0c},
+		Fee:           big.NewInt(10),
+	}
+	serialized := transfer.Serialize()
+	expected := "01" +
+		"00000000000000000000000000000000000000000000000000000000000186a0" +
+		"0102030000000000000000000000000000000000000000000000000000000000" +
+		"0002" +
+		"0004" + "000a0b0c" +
+		"000000000000000000000000000000000000000000000000000000000000000a"
+	assert.Equal(t, expected, hex.EncodeToString(serialized))
+
+	decoded, err := DecodeTransfer(serialized)
+	assert.NoError(t, err)
+	assert.Equal(t, transfer, decoded)
+
+	payload, err := DecodePayload(serialized)
+	assert.NoError(t, err)
+	assert.Equal(t, transfer, payload)
+
+	_, err = DecodeTransfer(serialized[:len(serialized)-1])
+	assert.Error(t, err)
+	_, err = DecodeTransfer(append(serialized, 0))
+	assert.Error(t, err)
+}
+
+func TestTransferWithPayloadSerializeDecode(t *testing.T) {
+	transfer := &TransferWithPayload{
+		Amount:        big.NewInt(42),
+		OriginAddress: Address{1},
+		OriginChain:   ChainIDAlephium,
+		TargetAddress: Address{2},
+		TargetChain:   ChainIDEthereum,
+		FromAddress:   Address{3},
+		Payload:       []byte("hello"),
+	}
+	serialized := transfer.Serialize()
+	assert.Equal(t, 1+32+32+2+32+2+32+5, len(serialized))
+
+	decoded, err := DecodeTransferWithPayload(serialized)
+	assert.NoError(t, err)
+	assert.Equal(t, transfer, decoded)
+
+	payload, err := DecodePayload(serialized)
+	assert.NoError(t, err)
+	assert.Equal(t, transfer, payload)
+
+	_, err = DecodeTransferWithPayload(serialized[:100])
+	assert.Error(t, err)
+}
+
+func TestAssetMetaSerializeDecode(t *testing.T) {
+	meta := &AssetMeta{
+		TokenAddress: Address{0xaa},
+		TokenChain:   ChainIDAlephium,
+		Decimals:     18,
+		Symbol:       "ALPH",
+		Name:         "Alephium",
+	}
+	serialized := meta.Serialize()
+	assert.Equal(t, 100, len(serialized))
+
+	decoded, err := DecodeAssetMeta(serialized)
+	assert.NoError(t, err)
+	assert.Equal(t, meta, decoded)
+
+	payload, err := DecodePayload(serialized)
+	assert.NoError(t, err)
+	assert.Equal(t, meta, payload)
+
+	_, err = DecodeTransfer(serialized)
+	assert.Error(t, err)
+}